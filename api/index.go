@@ -83,7 +83,7 @@ func initializeApp() error {
 }
 
 func setupDatabase() (*sql.DB, *gorm.DB, bool) {
-	dbURL := getDatabaseURL()
+	dbURL := database.WithSessionTimeZone(getDatabaseURL())
 
 	if dbURL == "" {
 		fmt.Println("⚠️ No database URL configured")
@@ -5,6 +5,9 @@ import (
 	"embed"
 	"fmt"
 	"log"
+	neturl "net/url"
+	"os"
+	"strings"
 
 	migrate "github.com/rubenv/sql-migrate"
 )
@@ -41,3 +44,32 @@ func DBMigrate(dbParam *sql.DB) error {
 	log.Printf("✅ Migration success, applied %d migrations!\n", n)
 	return nil
 }
+
+// WithSessionTimeZone menambahkan parameter timezone ke connection string.
+// SET TIME ZONE di migration hanya berlaku untuk session migration itu
+// sendiri; dengan parameter ini setiap koneksi di pool memakai timezone yang
+// sama (DB_TIMEZONE, default Asia/Jakarta) untuk NOW(), CURRENT_DATE dan
+// cast timestamptz ke date. Mendukung bentuk URL (postgres://...?a=b) dan
+// key=value (host=... dbname=...).
+func WithSessionTimeZone(dsn string) string {
+	if dsn == "" || strings.Contains(dsn, "timezone=") {
+		return dsn
+	}
+
+	timeZone := os.Getenv("DB_TIMEZONE")
+	if timeZone == "" {
+		timeZone = "Asia/Jakarta"
+	}
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		separator := "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+		return dsn + separator + "timezone=" + neturl.QueryEscape(timeZone)
+	}
+
+	// key=value: nilai di-quote supaya aman untuk spasi, ' dan \
+	quoted := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(timeZone)
+	return strings.TrimSpace(dsn) + " timezone='" + quoted + "'"
+}
@@ -0,0 +1,27 @@
+package database
+
+import "testing"
+
+func TestWithSessionTimeZone(t *testing.T) {
+	t.Setenv("DB_TIMEZONE", "")
+
+	tests := []struct {
+		name string
+		dsn  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"url", "postgres://u:p@host:5432/db", "postgres://u:p@host:5432/db?timezone=Asia%2FJakarta"},
+		{"url with query", "postgresql://u:p@host/db?sslmode=require", "postgresql://u:p@host/db?sslmode=require&timezone=Asia%2FJakarta"},
+		{"key value", "host=localhost dbname=portfolio sslmode=disable", "host=localhost dbname=portfolio sslmode=disable timezone='Asia/Jakarta'"},
+		{"key value trailing space", "host=localhost dbname=portfolio ", "host=localhost dbname=portfolio timezone='Asia/Jakarta'"},
+		{"already set", "host=localhost timezone=UTC", "host=localhost timezone=UTC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithSessionTimeZone(tt.dsn); got != tt.want {
+				t.Fatalf("WithSessionTimeZone(%q) = %q, want %q", tt.dsn, got, tt.want)
+			}
+		})
+	}
+}
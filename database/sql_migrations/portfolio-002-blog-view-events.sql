@@ -0,0 +1,33 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- BLOG VIEW EVENTS TABLE
+-- ============================
+-- visitor_hash adalah hash dari IP + User-Agent (dengan salt), IP asli tidak disimpan.
+-- window_start menandai awal jendela deduplikasi sehingga satu pengunjung
+-- hanya dihitung sekali per post per jendela waktu.
+
+CREATE TABLE blog_view_events (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    post_id         UUID NOT NULL REFERENCES portfolio_blog_posts(id) ON DELETE CASCADE,
+    visitor_hash    VARCHAR(64) NOT NULL,
+    window_start    TIMESTAMP WITH TIME ZONE NOT NULL,
+    viewed_at       TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (post_id, visitor_hash, window_start)
+);
+
+CREATE INDEX idx_blog_view_events_post_viewed ON blog_view_events (post_id, viewed_at);
+
+-- ============================
+-- BLOG DAILY VIEWS TABLE (Agregat harian)
+-- ============================
+
+CREATE TABLE blog_post_daily_views (
+    post_id         UUID NOT NULL REFERENCES portfolio_blog_posts(id) ON DELETE CASCADE,
+    view_date       DATE NOT NULL,
+    views           INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (post_id, view_date)
+);
+
+-- +migrate StatementEnd
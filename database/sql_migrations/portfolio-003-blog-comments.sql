@@ -0,0 +1,25 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- BLOG COMMENTS TABLE
+-- ============================
+
+CREATE TABLE blog_comments (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    post_id         UUID NOT NULL REFERENCES portfolio_blog_posts(id) ON DELETE CASCADE,
+    parent_id       UUID REFERENCES blog_comments(id) ON DELETE CASCADE,
+    author_name     VARCHAR(100) NOT NULL,
+    author_email    VARCHAR(150) NOT NULL,
+    content         TEXT NOT NULL,
+    status          VARCHAR(20) DEFAULT 'pending', -- pending, approved, spam
+    ip_address      INET,
+    user_agent      TEXT,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_blog_comments_post_status ON blog_comments (post_id, status);
+CREATE INDEX idx_blog_comments_status_created ON blog_comments (status, created_at);
+
+-- +migrate StatementEnd
@@ -0,0 +1,27 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- BLOG SERIES TABLE
+-- ============================
+
+CREATE TABLE blog_series (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title           VARCHAR(200) NOT NULL,
+    slug            VARCHAR(200) UNIQUE NOT NULL,
+    description     TEXT,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Satu post hanya bisa menjadi bagian dari satu series
+CREATE TABLE blog_series_posts (
+    series_id       UUID NOT NULL REFERENCES blog_series(id) ON DELETE CASCADE,
+    post_id         UUID NOT NULL UNIQUE REFERENCES portfolio_blog_posts(id) ON DELETE CASCADE,
+    position        INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (series_id, post_id)
+);
+
+CREATE INDEX idx_blog_series_posts_position ON blog_series_posts (series_id, position);
+
+-- +migrate StatementEnd
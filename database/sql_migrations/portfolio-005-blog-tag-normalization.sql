@@ -0,0 +1,35 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- NORMALISASI BLOG TAGS
+-- ============================
+-- Gabungkan tag duplikat yang hanya berbeda huruf besar/kecil atau spasi
+-- (misalnya "Go", "go", " go ", "go  lang" vs "go lang") ke tag tertua, lalu
+-- pasang unique index case-insensitive supaya duplikat tidak terbentuk lagi.
+-- Perbandingan memakai ekspresi yang sama dengan normalisasi nama di bawah
+-- (trim + spasi beruntun jadi satu), kalau tidak index unik bisa gagal.
+
+INSERT INTO blog_post_tags (post_id, tag_id)
+SELECT bpt.post_id, canonical.id
+FROM blog_post_tags bpt
+JOIN blog_tags dup ON dup.id = bpt.tag_id
+JOIN LATERAL (
+    SELECT t.id
+    FROM blog_tags t
+    WHERE regexp_replace(lower(btrim(t.name)), '\s+', ' ', 'g') = regexp_replace(lower(btrim(dup.name)), '\s+', ' ', 'g')
+    ORDER BY t.created_at ASC, t.id ASC
+    LIMIT 1
+) canonical ON canonical.id <> dup.id
+ON CONFLICT DO NOTHING;
+
+DELETE FROM blog_tags dup
+USING blog_tags keep
+WHERE regexp_replace(lower(btrim(dup.name)), '\s+', ' ', 'g') = regexp_replace(lower(btrim(keep.name)), '\s+', ' ', 'g')
+  AND (keep.created_at, keep.id) < (dup.created_at, dup.id);
+
+UPDATE blog_tags SET name = regexp_replace(btrim(name), '\s+', ' ', 'g');
+
+CREATE UNIQUE INDEX idx_blog_tags_name_lower ON blog_tags (lower(name));
+
+-- +migrate StatementEnd
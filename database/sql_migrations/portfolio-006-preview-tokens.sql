@@ -0,0 +1,25 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- PREVIEW TOKENS TABLE
+-- ============================
+-- Token untuk membagikan draft blog post / project ke reviewer.
+-- Yang disimpan hanya hash SHA-256 dari token, token asli hanya
+-- ditampilkan sekali saat dibuat.
+
+CREATE TABLE preview_tokens (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_hash      VARCHAR(64) UNIQUE NOT NULL,
+    resource_type   VARCHAR(20) NOT NULL, -- blog_post, project
+    resource_id     UUID NOT NULL,
+    note            VARCHAR(200),
+    expires_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at      TIMESTAMP WITH TIME ZONE,
+    last_used_at    TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_preview_tokens_resource ON preview_tokens (resource_type, resource_id);
+
+-- +migrate StatementEnd
@@ -0,0 +1,43 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- FULL-TEXT SEARCH
+-- ============================
+-- Kolom search_vector di-generate otomatis oleh Postgres. Judul memakai
+-- konfigurasi 'simple' (bobot A) supaya nama/istilah teknis tetap cocok
+-- persis, isi memakai 'indonesian' dan 'english' (bobot B/C) untuk stemming.
+
+ALTER TABLE portfolio_projects
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('indonesian', coalesce(title, '') || ' ' || coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')), 'C')
+    ) STORED;
+
+ALTER TABLE portfolio_blog_posts
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('indonesian', coalesce(title, '') || ' ' || coalesce(excerpt, '') || ' ' || coalesce(content, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(excerpt, '') || ' ' || coalesce(content, '')), 'C')
+    ) STORED;
+
+ALTER TABLE portfolio_experiences
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(company, '')), 'A') ||
+        setweight(to_tsvector('indonesian', coalesce(title, '') || ' ' || coalesce(location, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(location, '')), 'C')
+    ) STORED;
+
+ALTER TABLE portfolio_skills
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(category, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_portfolio_projects_search ON portfolio_projects USING GIN (search_vector);
+CREATE INDEX idx_portfolio_blog_posts_search ON portfolio_blog_posts USING GIN (search_vector);
+CREATE INDEX idx_portfolio_experiences_search ON portfolio_experiences USING GIN (search_vector);
+CREATE INDEX idx_portfolio_skills_search ON portfolio_skills USING GIN (search_vector);
+
+-- +migrate StatementEnd
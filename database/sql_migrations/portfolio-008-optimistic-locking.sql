@@ -0,0 +1,23 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- OPTIMISTIC LOCKING (VERSION COLUMN)
+-- ============================
+-- Setiap update menaikkan version dan hanya berhasil jika version di
+-- database masih sama dengan yang dibaca (UPDATE ... WHERE version = ?).
+-- Client mengirim version lewat header If-Match ("v3") untuk PUT/PATCH/DELETE.
+
+ALTER TABLE portfolio_projects     ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_experiences  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_skills       ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_certificates ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_education    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_testimonials ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_blog_posts   ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE blog_series            ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_sections     ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_social_links ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE portfolio_settings     ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate StatementEnd
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- CONTACT INBOX
+-- ============================
+-- contact_messages sudah ada sejak migration awal; kolom tambahan untuk
+-- mencatat balasan admin dan waktu perubahan status terakhir.
+
+ALTER TABLE contact_messages ADD COLUMN reply_message TEXT;
+ALTER TABLE contact_messages ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW();
+
+ALTER TABLE contact_messages ADD CONSTRAINT contact_messages_status_check
+    CHECK (status IN ('unread', 'read', 'replied', 'archived'));
+
+CREATE INDEX idx_contact_messages_status_created ON contact_messages (status, created_at);
+
+-- +migrate StatementEnd
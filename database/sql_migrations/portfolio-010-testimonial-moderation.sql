@@ -0,0 +1,19 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- TESTIMONIAL MODERATION
+-- ============================
+-- Testimoni dari form publik selalu masuk sebagai pending. Admin menyetujui
+-- atau menolak (dengan alasan); hanya yang approved tampil ke pengunjung.
+
+ALTER TABLE portfolio_testimonials ADD COLUMN email            VARCHAR(150);
+ALTER TABLE portfolio_testimonials ADD COLUMN source           VARCHAR(20) NOT NULL DEFAULT 'admin'; -- admin, public
+ALTER TABLE portfolio_testimonials ADD COLUMN rejection_reason TEXT;
+ALTER TABLE portfolio_testimonials ADD COLUMN moderated_at     TIMESTAMP WITH TIME ZONE;
+ALTER TABLE portfolio_testimonials ADD COLUMN ip_address       INET;
+ALTER TABLE portfolio_testimonials ADD COLUMN user_agent       TEXT;
+
+CREATE INDEX idx_portfolio_testimonials_status_order ON portfolio_testimonials (status, display_order);
+
+-- +migrate StatementEnd
@@ -0,0 +1,34 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- TESTIMONIAL INVITES
+-- ============================
+-- Link undangan sekali pakai untuk klien. Token mengisi otomatis nama/
+-- perusahaan klien dan mengizinkan satu submit testimoni yang ditandai
+-- verified. Seperti preview_tokens, yang disimpan hanya hash SHA-256.
+
+CREATE TABLE testimonial_invites (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_hash      VARCHAR(64) UNIQUE NOT NULL,
+    client_name     VARCHAR(100) NOT NULL,
+    client_company  VARCHAR(150),
+    client_email    VARCHAR(150),
+    project_id      UUID REFERENCES portfolio_projects(id) ON DELETE SET NULL,
+    note            VARCHAR(200),
+    expires_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at         TIMESTAMP WITH TIME ZONE,
+    revoked_at      TIMESTAMP WITH TIME ZONE,
+    testimonial_id  UUID REFERENCES portfolio_testimonials(id) ON DELETE SET NULL,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_testimonial_invites_project ON testimonial_invites (project_id);
+
+-- Testimoni bisa ditautkan ke project supaya tampil di halaman project
+ALTER TABLE portfolio_testimonials ADD COLUMN project_id  UUID REFERENCES portfolio_projects(id) ON DELETE SET NULL;
+ALTER TABLE portfolio_testimonials ADD COLUMN is_verified BOOLEAN NOT NULL DEFAULT false; -- true jika source = invite
+
+CREATE INDEX idx_portfolio_testimonials_project ON portfolio_testimonials (project_id, status, display_order);
+
+-- +migrate StatementEnd
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- TYPED SETTINGS
+-- ============================
+-- value tetap TEXT, tapi isinya divalidasi sesuai data_type. Setting json
+-- boleh punya json_schema. Setting baru default private; hanya yang
+-- is_public = true yang tampil di list publik dan snapshot portfolio.
+
+UPDATE portfolio_settings SET data_type = 'string' WHERE data_type IS NULL;
+
+ALTER TABLE portfolio_settings ALTER COLUMN data_type SET NOT NULL;
+ALTER TABLE portfolio_settings ADD CONSTRAINT portfolio_settings_data_type_check
+    CHECK (data_type IN ('string', 'number', 'boolean', 'json'));
+
+ALTER TABLE portfolio_settings ADD COLUMN is_public   BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE portfolio_settings ADD COLUMN json_schema JSONB;
+
+-- Setting bawaan dipakai frontend, jadi tetap publik
+UPDATE portfolio_settings SET is_public = true
+WHERE key IN ('site_title', 'site_description', 'contact_email', 'phone_number', 'location', 'cv_url', 'theme');
+
+-- +migrate StatementEnd
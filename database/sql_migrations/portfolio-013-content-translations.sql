@@ -0,0 +1,32 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- CONTENT TRANSLATIONS
+-- ============================
+-- Konten dasar di tabel masing-masing ditulis dalam default_locale. Terjemahan
+-- disimpan per (entity, locale, field) sehingga field yang belum
+-- diterjemahkan otomatis kembali ke konten dasar.
+
+CREATE TABLE content_translations (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type  VARCHAR(30) NOT NULL, -- project, blog_post, experience, education, section
+    entity_id    UUID NOT NULL,
+    locale       VARCHAR(10) NOT NULL,
+    field        VARCHAR(50) NOT NULL,
+    value        TEXT NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT content_translations_unique UNIQUE (entity_type, entity_id, locale, field)
+);
+
+CREATE INDEX idx_content_translations_locale ON content_translations (entity_type, locale);
+
+-- Locale konten: default_locale = bahasa konten dasar, supported_locales =
+-- locale yang boleh diminta lewat ?lang= atau Accept-Language
+INSERT INTO portfolio_settings (key, value, data_type, description, is_public) VALUES
+('default_locale', 'id', 'string', 'Bahasa konten dasar portfolio', true),
+('supported_locales', '["id","en"]', 'json', 'Bahasa yang tersedia untuk konten portfolio', true)
+ON CONFLICT (key) DO NOTHING;
+
+-- +migrate StatementEnd
@@ -0,0 +1,100 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- MONTH DATES (EXPERIENCES & EDUCATION)
+-- ============================
+-- start_year/end_year sebelumnya teks bebas VARCHAR(20) ("2021", "2021-06",
+-- "present"), dan current_job bisa bertentangan dengan end_year. Sekarang
+-- disimpan sebagai DATE (hari pertama bulannya) + date_precision, dengan
+-- is_ongoing sebagai satu-satunya penanda periode yang masih berjalan.
+
+ALTER TABLE portfolio_experiences
+    ADD COLUMN start_date     DATE,
+    ADD COLUMN end_date       DATE,
+    ADD COLUMN is_ongoing     BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN date_precision VARCHAR(10) NOT NULL DEFAULT 'month';
+
+ALTER TABLE portfolio_education
+    ADD COLUMN start_date     DATE,
+    ADD COLUMN end_date       DATE,
+    ADD COLUMN is_ongoing     BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN date_precision VARCHAR(10) NOT NULL DEFAULT 'month';
+
+-- Backfill: "YYYY-MM" -> presisi bulan, "YYYY" -> presisi tahun. Jika salah
+-- satu sisi hanya tahun, keduanya diturunkan ke presisi tahun. Nilai lain
+-- yang tidak bisa dibaca dibiarkan NULL untuk dirapikan manual lewat admin;
+-- kolom lama tidak di-drop tapi di-rename ke legacy_* supaya nilai aslinya
+-- tetap bisa dilihat, misalnya:
+--   SELECT id, legacy_start_year, legacy_end_year FROM portfolio_experiences
+--   WHERE (start_date IS NULL AND TRIM(COALESCE(legacy_start_year, '')) <> '')
+--      OR (end_date IS NULL AND NOT is_ongoing AND TRIM(COALESCE(legacy_end_year, '')) <> '');
+UPDATE portfolio_experiences SET
+    is_ongoing = COALESCE(current_job, FALSE) OR LOWER(TRIM(COALESCE(end_year, ''))) IN ('present', 'sekarang'),
+    date_precision = CASE
+        WHEN TRIM(start_year) ~ '^\d{4}$' OR TRIM(end_year) ~ '^\d{4}$' THEN 'year'
+        ELSE 'month'
+    END;
+
+UPDATE portfolio_education SET
+    is_ongoing = LOWER(TRIM(COALESCE(end_year, ''))) IN ('present', 'sekarang'),
+    date_precision = CASE
+        WHEN TRIM(start_year) ~ '^\d{4}$' OR TRIM(end_year) ~ '^\d{4}$' THEN 'year'
+        ELSE 'month'
+    END;
+
+UPDATE portfolio_experiences SET
+    start_date = CASE
+        WHEN TRIM(start_year) ~ '^\d{4}-(0[1-9]|1[0-2])$' AND date_precision = 'month' THEN TO_DATE(TRIM(start_year), 'YYYY-MM')
+        WHEN TRIM(start_year) ~ '^\d{4}(-(0[1-9]|1[0-2]))?$' THEN TO_DATE(LEFT(TRIM(start_year), 4), 'YYYY')
+    END,
+    -- is_ongoing menang jika current_job dan end_year bertentangan
+    end_date = CASE
+        WHEN is_ongoing THEN NULL
+        WHEN TRIM(end_year) ~ '^\d{4}-(0[1-9]|1[0-2])$' AND date_precision = 'month' THEN TO_DATE(TRIM(end_year), 'YYYY-MM')
+        WHEN TRIM(end_year) ~ '^\d{4}(-(0[1-9]|1[0-2]))?$' THEN TO_DATE(LEFT(TRIM(end_year), 4), 'YYYY')
+    END;
+
+UPDATE portfolio_education SET
+    start_date = CASE
+        WHEN TRIM(start_year) ~ '^\d{4}-(0[1-9]|1[0-2])$' AND date_precision = 'month' THEN TO_DATE(TRIM(start_year), 'YYYY-MM')
+        WHEN TRIM(start_year) ~ '^\d{4}(-(0[1-9]|1[0-2]))?$' THEN TO_DATE(LEFT(TRIM(start_year), 4), 'YYYY')
+    END,
+    end_date = CASE
+        WHEN is_ongoing THEN NULL
+        WHEN TRIM(end_year) ~ '^\d{4}-(0[1-9]|1[0-2])$' AND date_precision = 'month' THEN TO_DATE(TRIM(end_year), 'YYYY-MM')
+        WHEN TRIM(end_year) ~ '^\d{4}(-(0[1-9]|1[0-2]))?$' THEN TO_DATE(LEFT(TRIM(end_year), 4), 'YYYY')
+    END;
+
+-- Data lama dengan end < start tidak bisa lolos constraint; end dikosongkan
+-- (nilai aslinya masih ada di legacy_end_year)
+UPDATE portfolio_experiences SET end_date = NULL WHERE end_date < start_date;
+UPDATE portfolio_education SET end_date = NULL WHERE end_date < start_date;
+
+ALTER TABLE portfolio_experiences
+    ADD CONSTRAINT portfolio_experiences_date_precision_check CHECK (date_precision IN ('month', 'year')),
+    ADD CONSTRAINT portfolio_experiences_date_range_check CHECK (end_date IS NULL OR start_date IS NULL OR end_date >= start_date),
+    ADD CONSTRAINT portfolio_experiences_ongoing_check CHECK (NOT is_ongoing OR end_date IS NULL);
+
+ALTER TABLE portfolio_experiences RENAME COLUMN start_year TO legacy_start_year;
+ALTER TABLE portfolio_experiences RENAME COLUMN end_year TO legacy_end_year;
+ALTER TABLE portfolio_experiences RENAME COLUMN current_job TO legacy_current_job;
+
+ALTER TABLE portfolio_education
+    ADD CONSTRAINT portfolio_education_date_precision_check CHECK (date_precision IN ('month', 'year')),
+    ADD CONSTRAINT portfolio_education_date_range_check CHECK (end_date IS NULL OR start_date IS NULL OR end_date >= start_date),
+    ADD CONSTRAINT portfolio_education_ongoing_check CHECK (NOT is_ongoing OR end_date IS NULL);
+
+ALTER TABLE portfolio_education RENAME COLUMN start_year TO legacy_start_year;
+ALTER TABLE portfolio_education RENAME COLUMN end_year TO legacy_end_year;
+
+CREATE INDEX idx_portfolio_experiences_start_date ON portfolio_experiences (start_date DESC);
+CREATE INDEX idx_portfolio_education_start_date ON portfolio_education (start_date DESC);
+
+-- Timezone default untuk merender tanggal; pengunjung bisa menimpanya lewat
+-- ?tz= atau header X-Timezone
+INSERT INTO portfolio_settings (key, value, data_type, description, is_public) VALUES
+('default_timezone', 'Asia/Jakarta', 'string', 'Timezone default untuk menampilkan tanggal', true)
+ON CONFLICT (key) DO NOTHING;
+
+-- +migrate StatementEnd
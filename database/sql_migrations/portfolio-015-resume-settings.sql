@@ -0,0 +1,21 @@
+-- +migrate Up
+-- +migrate StatementBegin
+
+-- ============================
+-- GENERATED RESUME
+-- ============================
+-- Resume dibuat dari data portfolio (GET /api/v1/resume.pdf|.md|.html).
+-- Identitas yang tidak ada di tabel lain disimpan sebagai setting publik.
+
+INSERT INTO portfolio_settings (key, value, data_type, description, is_public) VALUES
+('resume_name', 'Muhammad Fathiir Farhansyah', 'string', 'Nama lengkap di resume', true),
+('resume_headline', 'Web Developer | Laravel Junior | FullStack', 'string', 'Jabatan/headline di bawah nama pada resume', true),
+('resume_summary', '', 'string', 'Ringkasan profil di awal resume (kosong = tidak ditampilkan)', true),
+('resume_template', 'classic', 'string', 'Template resume default: classic, modern, compact', true)
+ON CONFLICT (key) DO NOTHING;
+
+-- cv_url yang masih menunjuk ke PDF manual diarahkan ke resume yang di-generate
+UPDATE portfolio_settings SET value = '/api/v1/resume.pdf', updated_at = NOW(), version = version + 1
+WHERE key = 'cv_url' AND value = '/CV-Muhammad-Fathiir-Farhansyah.pdf';
+
+-- +migrate StatementEnd
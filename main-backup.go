@@ -100,7 +100,7 @@ func main() {
 
 func setupDatabase() (*sql.DB, *gorm.DB, bool) {
 	// Get database URL dengan force IPv4
-	dbURL := getDatabaseURL()
+	dbURL := database.WithSessionTimeZone(getDatabaseURL())
 
 	fmt.Println("\n🔌 Setting up database connection...")
 	fmt.Printf("   Connection URL: %s\n", maskPassword(dbURL))
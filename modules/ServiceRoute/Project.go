@@ -2,6 +2,7 @@ package serviceroute
 
 import (
 	projectservice "gintugas/modules/components/Project/service"
+	"gintugas/modules/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -30,7 +31,7 @@ func NewTagsHandler(tagsService projectservice.TagsService) *TagsHandler {
 func (h *ProjectHandler) CreateProjectWithImage(c *gin.Context) {
 	project, err := h.projectService.CreateProjekWithImageService(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -41,21 +42,22 @@ func (h *ProjectHandler) CreateProjectWithImage(c *gin.Context) {
 }
 
 func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
-	projects, err := h.projectService.GetAllProjekService(c)
+	projects, page, err := h.projectService.GetAllProjekService(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"data": projects,
+		"page": page,
 	})
 }
 
 func (h *ProjectHandler) GetAllTags(c *gin.Context) {
 	projects, err := h.projectService.GetAllTagsService(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -67,10 +69,11 @@ func (h *ProjectHandler) GetAllTags(c *gin.Context) {
 func (h *ProjectHandler) GetProject(c *gin.Context) {
 	project, err := h.projectService.GetProjekService(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, project.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"data": project,
 	})
@@ -79,10 +82,25 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	project, err := h.projectService.UpdateProjekService(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, project.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Project updated successfully",
+		"data":    project,
+	})
+}
+
+func (h *ProjectHandler) PatchProject(c *gin.Context) {
+	project, err := h.projectService.PatchProjekService(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, project.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Project updated successfully",
 		"data":    project,
@@ -92,7 +110,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	err := h.projectService.DeleteProjekService(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -104,9 +122,7 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 func (c *TagsHandler) CreateTags(ctx *gin.Context) {
 	Tags, err := c.tagsService.CreateTags(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusBadRequest, err)
 		return
 	}
 
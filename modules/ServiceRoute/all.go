@@ -1,7 +1,11 @@
 package serviceroute
 
 import (
+	"fmt"
+	"gintugas/modules/components/all/repo"
 	"gintugas/modules/components/all/service"
+	httpmiddleware "gintugas/modules/middleware"
+	"gintugas/modules/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -22,7 +26,7 @@ func NewSkillHandler(service service.SkillService) *SkillHandler {
 func (h *SkillHandler) Create(c *gin.Context) {
 	skill, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -35,10 +39,11 @@ func (h *SkillHandler) Create(c *gin.Context) {
 func (h *SkillHandler) GetByID(c *gin.Context) {
 	skill, err := h.service.GetByID(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, skill.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Skill retrieved successfully",
 		"data":    skill,
@@ -48,10 +53,25 @@ func (h *SkillHandler) GetByID(c *gin.Context) {
 func (h *SkillHandler) Update(c *gin.Context) {
 	skill, err := h.service.Update(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, skill.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Skill updated successfully",
+		"data":    skill,
+	})
+}
+
+func (h *SkillHandler) Patch(c *gin.Context) {
+	skill, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, skill.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Skill updated successfully",
 		"data":    skill,
@@ -60,7 +80,7 @@ func (h *SkillHandler) Update(c *gin.Context) {
 
 func (h *SkillHandler) Delete(c *gin.Context) {
 	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -70,22 +90,23 @@ func (h *SkillHandler) Delete(c *gin.Context) {
 }
 
 func (h *SkillHandler) GetAll(c *gin.Context) {
-	skills, err := h.service.GetAll(c)
+	skills, page, err := h.service.GetAll(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Skills retrieved successfully",
 		"data":    skills,
+		"page":    page,
 	})
 }
 
 func (h *SkillHandler) GetFeatured(c *gin.Context) {
 	skills, err := h.service.GetFeatured(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -98,7 +119,7 @@ func (h *SkillHandler) GetFeatured(c *gin.Context) {
 func (h *SkillHandler) GetByCategory(c *gin.Context) {
 	skills, err := h.service.GetByCategory(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -111,7 +132,7 @@ func (h *SkillHandler) GetByCategory(c *gin.Context) {
 func (h *SkillHandler) CreateWithIcon(c *gin.Context) {
 	response, err := h.service.CreateWithIcon(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -124,10 +145,11 @@ func (h *SkillHandler) CreateWithIcon(c *gin.Context) {
 func (h *SkillHandler) UpdateWithIcon(c *gin.Context) {
 	response, err := h.service.UpdateWithIcon(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, response.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"data":    response,
 		"message": "Skill updated successfully with icon upload",
@@ -149,7 +171,7 @@ func NewCertificateHandler(service service.CertificateService) *CertificateHandl
 func (h *CertificateHandler) Create(c *gin.Context) {
 	cert, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -162,10 +184,11 @@ func (h *CertificateHandler) Create(c *gin.Context) {
 func (h *CertificateHandler) GetByID(c *gin.Context) {
 	cert, err := h.service.GetByID(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, cert.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Certificate retrieved successfully",
 		"data":    cert,
@@ -175,10 +198,25 @@ func (h *CertificateHandler) GetByID(c *gin.Context) {
 func (h *CertificateHandler) Update(c *gin.Context) {
 	cert, err := h.service.Update(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, cert.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Certificate updated successfully",
+		"data":    cert,
+	})
+}
+
+func (h *CertificateHandler) Patch(c *gin.Context) {
+	cert, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, cert.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Certificate updated successfully",
 		"data":    cert,
@@ -187,7 +225,7 @@ func (h *CertificateHandler) Update(c *gin.Context) {
 
 func (h *CertificateHandler) Delete(c *gin.Context) {
 	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -197,22 +235,23 @@ func (h *CertificateHandler) Delete(c *gin.Context) {
 }
 
 func (h *CertificateHandler) GetAll(c *gin.Context) {
-	certs, err := h.service.GetAll(c)
+	certs, page, err := h.service.GetAll(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Certificates retrieved successfully",
 		"data":    certs,
+		"page":    page,
 	})
 }
 
 func (h *CertificateHandler) CreateWithImage(c *gin.Context) {
 	response, err := h.service.CreateWithImage(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -237,7 +276,7 @@ func NewEducationHandler(service service.EducationService) *EducationHandler {
 func (h *EducationHandler) CreateWithAchievements(c *gin.Context) {
 	edu, err := h.service.CreateWithAchievements(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -250,10 +289,11 @@ func (h *EducationHandler) CreateWithAchievements(c *gin.Context) {
 func (h *EducationHandler) GetByIDWithAchievements(c *gin.Context) {
 	edu, err := h.service.GetByIDWithAchievements(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, edu.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Education retrieved successfully",
 		"data":    edu,
@@ -263,10 +303,25 @@ func (h *EducationHandler) GetByIDWithAchievements(c *gin.Context) {
 func (h *EducationHandler) UpdateWithAchievements(c *gin.Context) {
 	edu, err := h.service.UpdateWithAchievements(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, edu.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Education updated successfully",
+		"data":    edu,
+	})
+}
+
+func (h *EducationHandler) PatchWithAchievements(c *gin.Context) {
+	edu, err := h.service.PatchWithAchievements(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, edu.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Education updated successfully",
 		"data":    edu,
@@ -275,7 +330,7 @@ func (h *EducationHandler) UpdateWithAchievements(c *gin.Context) {
 
 func (h *EducationHandler) DeleteWithAchievements(c *gin.Context) {
 	if err := h.service.DeleteWithAchievements(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -285,15 +340,16 @@ func (h *EducationHandler) DeleteWithAchievements(c *gin.Context) {
 }
 
 func (h *EducationHandler) GetAllWithAchievements(c *gin.Context) {
-	educations, err := h.service.GetAllWithAchievements(c)
+	educations, page, err := h.service.GetAllWithAchievements(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Educations retrieved successfully",
 		"data":    educations,
+		"page":    page,
 	})
 }
 
@@ -312,7 +368,7 @@ func NewTestimonialHandler(service service.TestimonialService) *TestimonialHandl
 func (h *TestimonialHandler) Create(c *gin.Context) {
 	test, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -325,10 +381,11 @@ func (h *TestimonialHandler) Create(c *gin.Context) {
 func (h *TestimonialHandler) GetByID(c *gin.Context) {
 	test, err := h.service.GetByID(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, test.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Testimonial retrieved successfully",
 		"data":    test,
@@ -338,10 +395,25 @@ func (h *TestimonialHandler) GetByID(c *gin.Context) {
 func (h *TestimonialHandler) Update(c *gin.Context) {
 	test, err := h.service.Update(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, test.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Testimonial updated successfully",
+		"data":    test,
+	})
+}
+
+func (h *TestimonialHandler) Patch(c *gin.Context) {
+	test, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, test.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Testimonial updated successfully",
 		"data":    test,
@@ -350,7 +422,7 @@ func (h *TestimonialHandler) Update(c *gin.Context) {
 
 func (h *TestimonialHandler) Delete(c *gin.Context) {
 	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -360,22 +432,23 @@ func (h *TestimonialHandler) Delete(c *gin.Context) {
 }
 
 func (h *TestimonialHandler) GetAll(c *gin.Context) {
-	testimonials, err := h.service.GetAll(c)
+	testimonials, page, err := h.service.GetAll(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Testimonials retrieved successfully",
 		"data":    testimonials,
+		"page":    page,
 	})
 }
 
 func (h *TestimonialHandler) GetFeatured(c *gin.Context) {
 	testimonials, err := h.service.GetFeatured(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -388,7 +461,7 @@ func (h *TestimonialHandler) GetFeatured(c *gin.Context) {
 func (h *TestimonialHandler) GetByStatus(c *gin.Context) {
 	testimonials, err := h.service.GetByStatus(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -398,6 +471,110 @@ func (h *TestimonialHandler) GetByStatus(c *gin.Context) {
 	})
 }
 
+func (h *TestimonialHandler) Submit(c *gin.Context) {
+	submission, err := h.service.Submit(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Testimonial submitted and awaiting moderation",
+		"data":    submission,
+	})
+}
+
+func (h *TestimonialHandler) Moderate(c *gin.Context) {
+	testimonial, err := h.service.Moderate(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, testimonial.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Testimonial status updated successfully",
+		"data":    testimonial,
+	})
+}
+
+func (h *TestimonialHandler) GetByProject(c *gin.Context) {
+	testimonials, err := h.service.GetByProject(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Project testimonials retrieved successfully",
+		"data":    testimonials,
+	})
+}
+
+func (h *TestimonialHandler) CreateInvite(c *gin.Context) {
+	invite, err := h.service.CreateInvite(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Testimonial invite created successfully",
+		"data":    invite,
+	})
+}
+
+func (h *TestimonialHandler) GetInvites(c *gin.Context) {
+	invites, err := h.service.GetInvites(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Testimonial invites retrieved successfully",
+		"data":    invites,
+	})
+}
+
+func (h *TestimonialHandler) RevokeInvite(c *gin.Context) {
+	if err := h.service.RevokeInvite(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Testimonial invite revoked successfully",
+	})
+}
+
+func (h *TestimonialHandler) GetInvitePrefill(c *gin.Context) {
+	prefill, err := h.service.GetInvitePrefill(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Testimonial invite retrieved successfully",
+		"data":    prefill,
+	})
+}
+
+func (h *TestimonialHandler) SubmitWithInvite(c *gin.Context) {
+	submission, err := h.service.SubmitWithInvite(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Testimonial submitted and awaiting moderation",
+		"data":    submission,
+	})
+}
+
 // ============================
 // BLOG HANDLER
 // ============================
@@ -413,7 +590,7 @@ func NewBlogHandler(service service.BlogService) *BlogHandler {
 func (h *BlogHandler) CreateWithTags(c *gin.Context) {
 	post, err := h.service.CreateWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -426,10 +603,11 @@ func (h *BlogHandler) CreateWithTags(c *gin.Context) {
 func (h *BlogHandler) GetByIDWithTags(c *gin.Context) {
 	post, err := h.service.GetByIDWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, post.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Blog post retrieved successfully",
 		"data":    post,
@@ -439,10 +617,11 @@ func (h *BlogHandler) GetByIDWithTags(c *gin.Context) {
 func (h *BlogHandler) GetBySlugWithTags(c *gin.Context) {
 	post, err := h.service.GetBySlugWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respondError(c, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, post.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Blog post retrieved successfully",
 		"data":    post,
@@ -452,10 +631,25 @@ func (h *BlogHandler) GetBySlugWithTags(c *gin.Context) {
 func (h *BlogHandler) UpdateWithTags(c *gin.Context) {
 	post, err := h.service.UpdateWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, post.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog post updated successfully",
+		"data":    post,
+	})
+}
+
+func (h *BlogHandler) PatchWithTags(c *gin.Context) {
+	post, err := h.service.PatchWithTags(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(c, post.Version)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Blog post updated successfully",
 		"data":    post,
@@ -464,7 +658,7 @@ func (h *BlogHandler) UpdateWithTags(c *gin.Context) {
 
 func (h *BlogHandler) DeleteWithTags(c *gin.Context) {
 	if err := h.service.DeleteWithTags(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -474,35 +668,37 @@ func (h *BlogHandler) DeleteWithTags(c *gin.Context) {
 }
 
 func (h *BlogHandler) GetAllWithTags(c *gin.Context) {
-	posts, err := h.service.GetAllWithTags(c)
+	posts, page, err := h.service.GetAllWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Blog posts retrieved successfully",
 		"data":    posts,
+		"page":    page,
 	})
 }
 
 func (h *BlogHandler) GetPublishedWithTags(c *gin.Context) {
-	posts, err := h.service.GetPublishedWithTags(c)
+	posts, page, err := h.service.GetPublishedWithTags(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Published blog posts retrieved successfully",
 		"data":    posts,
+		"page":    page,
 	})
 }
 
 func (h *BlogHandler) GetAllTags(c *gin.Context) {
 	tags, err := h.service.GetAllTags(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -512,149 +708,925 @@ func (h *BlogHandler) GetAllTags(c *gin.Context) {
 	})
 }
 
+func (h *BlogHandler) GetPublishedByTag(c *gin.Context) {
+	result, err := h.service.GetPublishedByTag(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tag posts retrieved successfully",
+		"data":    result,
+	})
+}
+
+func (h *BlogHandler) RenameTag(c *gin.Context) {
+	tag, err := h.service.RenameTag(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tag renamed successfully",
+		"data":    tag,
+	})
+}
+
+func (h *BlogHandler) MergeTags(c *gin.Context) {
+	tag, err := h.service.MergeTags(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tags merged successfully",
+		"data":    tag,
+	})
+}
+
+func (h *BlogHandler) DeleteTag(c *gin.Context) {
+	if err := h.service.DeleteTag(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tag deleted successfully",
+	})
+}
+
+func (h *BlogHandler) GetDailyViews(c *gin.Context) {
+	stats, err := h.service.GetDailyViews(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog post views retrieved successfully",
+		"data":    stats,
+	})
+}
+
 // ============================
-// SECTIONS HANDLER
+// BLOG SERIES HANDLER
 // ============================
 
-type SectionHandler struct {
-	service service.SectionService
+type BlogSeriesHandler struct {
+	service service.BlogSeriesService
 }
 
-func NewSectionHandler(service service.SectionService) *SectionHandler {
-	return &SectionHandler{service: service}
+func NewBlogSeriesHandler(service service.BlogSeriesService) *BlogSeriesHandler {
+	return &BlogSeriesHandler{service: service}
 }
 
-func (h *SectionHandler) Create(c *gin.Context) {
-	section, err := h.service.Create(c)
+func (h *BlogSeriesHandler) Create(c *gin.Context) {
+	series, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
-		"message": "Section created successfully",
-		"data":    section,
+		"message": "Blog series created successfully",
+		"data":    series,
 	})
 }
 
-func (h *SectionHandler) Delete(c *gin.Context) {
+func (h *BlogSeriesHandler) GetByID(c *gin.Context) {
+	series, err := h.service.GetByID(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, series.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog series retrieved successfully",
+		"data":    series,
+	})
+}
+
+func (h *BlogSeriesHandler) Update(c *gin.Context) {
+	series, err := h.service.Update(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, series.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog series updated successfully",
+		"data":    series,
+	})
+}
+
+func (h *BlogSeriesHandler) Patch(c *gin.Context) {
+	series, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, series.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog series updated successfully",
+		"data":    series,
+	})
+}
+
+func (h *BlogSeriesHandler) Delete(c *gin.Context) {
 	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Section deleted successfully",
+		"message": "Blog series deleted successfully",
 	})
 }
 
-func (h *SectionHandler) GetAll(c *gin.Context) {
-	sections, err := h.service.GetAll(c)
+func (h *BlogSeriesHandler) GetAll(c *gin.Context) {
+	series, err := h.service.GetAll(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Sections retrieved successfully",
-		"data":    sections,
+		"message": "Blog series retrieved successfully",
+		"data":    series,
+	})
+}
+
+func (h *BlogSeriesHandler) SetPosts(c *gin.Context) {
+	series, err := h.service.SetPosts(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blog series posts updated successfully",
+		"data":    series,
 	})
 }
 
 // ============================
-// SOCIAL LINKS HANDLER
+// BLOG COMMENTS HANDLER
 // ============================
 
-type SocialLinkHandler struct {
-	service service.SocialLinkService
+type BlogCommentHandler struct {
+	service service.BlogCommentService
 }
 
-func NewSocialLinkHandler(service service.SocialLinkService) *SocialLinkHandler {
-	return &SocialLinkHandler{service: service}
+func NewBlogCommentHandler(service service.BlogCommentService) *BlogCommentHandler {
+	return &BlogCommentHandler{service: service}
 }
 
-func (h *SocialLinkHandler) Create(c *gin.Context) {
-	link, err := h.service.Create(c)
+func (h *BlogCommentHandler) Create(c *gin.Context) {
+	comment, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
-		"message": "Social link created successfully",
-		"data":    link,
+		"message": "Comment submitted and awaiting moderation",
+		"data":    comment,
 	})
 }
 
-func (h *SocialLinkHandler) Delete(c *gin.Context) {
-	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+func (h *BlogCommentHandler) GetApprovedByPost(c *gin.Context) {
+	comments, err := h.service.GetApprovedByPost(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Social link deleted successfully",
+		"message": "Comments retrieved successfully",
+		"data":    comments,
 	})
 }
 
-func (h *SocialLinkHandler) GetAll(c *gin.Context) {
-	links, err := h.service.GetAll(c)
+func (h *BlogCommentHandler) GetByStatus(c *gin.Context) {
+	comments, err := h.service.GetByStatus(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Social links retrieved successfully",
-		"data":    links,
+		"message": "Comments by status retrieved successfully",
+		"data":    comments,
+	})
+}
+
+func (h *BlogCommentHandler) UpdateStatus(c *gin.Context) {
+	comment, err := h.service.UpdateStatus(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment status updated successfully",
+		"data":    comment,
+	})
+}
+
+func (h *BlogCommentHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment deleted successfully",
 	})
 }
 
 // ============================
-// SETTINGS HANDLER
+// PREVIEW TOKENS HANDLER
 // ============================
 
-type SettingHandler struct {
-	service service.SettingService
+type PreviewTokenHandler struct {
+	service service.PreviewTokenService
 }
 
-func NewSettingHandler(service service.SettingService) *SettingHandler {
-	return &SettingHandler{service: service}
+func NewPreviewTokenHandler(service service.PreviewTokenService) *PreviewTokenHandler {
+	return &PreviewTokenHandler{service: service}
 }
 
-func (h *SettingHandler) Create(c *gin.Context) {
-	setting, err := h.service.Create(c)
+func (h *PreviewTokenHandler) Create(c *gin.Context) {
+	token, err := h.service.Create(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
-		"message": "Setting created successfully",
-		"data":    setting,
+		"message": "Preview token created successfully",
+		"data":    token,
 	})
 }
 
-func (h *SettingHandler) Delete(c *gin.Context) {
-	if err := h.service.Delete(c); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+func (h *PreviewTokenHandler) GetAll(c *gin.Context) {
+	tokens, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Setting deleted successfully",
+		"message": "Preview tokens retrieved successfully",
+		"data":    tokens,
 	})
 }
 
-func (h *SettingHandler) GetAll(c *gin.Context) {
-	settings, err := h.service.GetAll(c)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+func (h *PreviewTokenHandler) Revoke(c *gin.Context) {
+	if err := h.service.Revoke(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Settings retrieved successfully",
-		"data":    settings,
+		"message": "Preview token revoked successfully",
+	})
+}
+
+// ============================
+// SEARCH HANDLER
+// ============================
+
+type SearchHandler struct {
+	service service.SearchService
+}
+
+func NewSearchHandler(service service.SearchService) *SearchHandler {
+	return &SearchHandler{service: service}
+}
+
+func (h *SearchHandler) Search(c *gin.Context) {
+	result, err := h.service.Search(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Search results retrieved successfully",
+		"data":    result,
+	})
+}
+
+// ============================
+// PORTFOLIO SNAPSHOT HANDLER
+// ============================
+
+type PortfolioSnapshotHandler struct {
+	service service.PortfolioSnapshotService
+}
+
+func NewPortfolioSnapshotHandler(service service.PortfolioSnapshotService) *PortfolioSnapshotHandler {
+	return &PortfolioSnapshotHandler{service: service}
+}
+
+func (h *PortfolioSnapshotHandler) GetSnapshot(c *gin.Context) {
+	snapshot, err := h.service.GetSnapshot(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Portfolio retrieved successfully",
+		"data":    snapshot,
+	})
+}
+
+// ============================
+// RESUME HANDLER
+// ============================
+
+type ResumeHandler struct {
+	service service.ResumeService
+}
+
+func NewResumeHandler(service service.ResumeService) *ResumeHandler {
+	return &ResumeHandler{service: service}
+}
+
+func (h *ResumeHandler) GetPDF(c *gin.Context) {
+	h.render(c, service.ResumeFormatPDF)
+}
+
+func (h *ResumeHandler) GetMarkdown(c *gin.Context) {
+	h.render(c, service.ResumeFormatMarkdown)
+}
+
+func (h *ResumeHandler) GetHTML(c *gin.Context) {
+	h.render(c, service.ResumeFormatHTML)
+}
+
+// GetJSON mengirim resume dalam format standar JSON Resume (jsonresume.org)
+func (h *ResumeHandler) GetJSON(c *gin.Context) {
+	h.render(c, service.ResumeFormatJSON)
+}
+
+// render mengirim resume inline; ?download=true memaksa dialog simpan file
+func (h *ResumeHandler) render(c *gin.Context, format string) {
+	resume, err := h.service.Render(c, format)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	disposition := "inline"
+	if c.Query("download") == "true" {
+		disposition = "attachment"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, resume.Filename))
+	httpmiddleware.SetLastModified(c, resume.UpdatedAt)
+	c.Data(http.StatusOK, resume.ContentType, resume.Body)
+}
+
+func (h *ResumeHandler) GetTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Resume templates retrieved successfully",
+		"data":    h.service.GetTemplates(),
+	})
+}
+
+// Import meng-upsert data portfolio dari dokumen JSON Resume;
+// ?dry_run=true hanya mengembalikan laporan perubahan tanpa menyimpan
+func (h *ResumeHandler) Import(c *gin.Context) {
+	report, err := h.service.Import(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	message := "Resume imported successfully"
+	if report.DryRun {
+		message = "Resume import dry run completed"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+		"data":    report,
+	})
+}
+
+// ============================
+// READ CACHE STATS HANDLER
+// ============================
+
+func GetReadCacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Read cache stats retrieved successfully",
+		"data":    repo.GetReadCacheStats(),
+	})
+}
+
+// ============================
+// SECTIONS HANDLER
+// ============================
+
+type SectionHandler struct {
+	service service.SectionService
+}
+
+func NewSectionHandler(service service.SectionService) *SectionHandler {
+	return &SectionHandler{service: service}
+}
+
+func (h *SectionHandler) Create(c *gin.Context) {
+	section, err := h.service.Create(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Section created successfully",
+		"data":    section,
+	})
+}
+
+func (h *SectionHandler) GetByID(c *gin.Context) {
+	section, err := h.service.GetByID(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, section.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Section retrieved successfully",
+		"data":    section,
+	})
+}
+
+func (h *SectionHandler) Update(c *gin.Context) {
+	section, err := h.service.Update(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, section.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Section updated successfully",
+		"data":    section,
+	})
+}
+
+func (h *SectionHandler) Patch(c *gin.Context) {
+	section, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, section.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Section updated successfully",
+		"data":    section,
+	})
+}
+
+func (h *SectionHandler) SetActive(c *gin.Context) {
+	section, err := h.service.SetActive(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, section.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Section activation updated successfully",
+		"data":    section,
+	})
+}
+
+func (h *SectionHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Section deleted successfully",
+	})
+}
+
+func (h *SectionHandler) GetAll(c *gin.Context) {
+	sections, page, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Sections retrieved successfully",
+		"data":    sections,
+		"page":    page,
+	})
+}
+
+// ============================
+// SOCIAL LINKS HANDLER
+// ============================
+
+type SocialLinkHandler struct {
+	service service.SocialLinkService
+}
+
+func NewSocialLinkHandler(service service.SocialLinkService) *SocialLinkHandler {
+	return &SocialLinkHandler{service: service}
+}
+
+func (h *SocialLinkHandler) Create(c *gin.Context) {
+	link, err := h.service.Create(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Social link created successfully",
+		"data":    link,
+	})
+}
+
+func (h *SocialLinkHandler) GetByID(c *gin.Context) {
+	link, err := h.service.GetByID(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, link.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social link retrieved successfully",
+		"data":    link,
+	})
+}
+
+func (h *SocialLinkHandler) Update(c *gin.Context) {
+	link, err := h.service.Update(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, link.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social link updated successfully",
+		"data":    link,
+	})
+}
+
+func (h *SocialLinkHandler) Patch(c *gin.Context) {
+	link, err := h.service.Patch(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, link.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social link updated successfully",
+		"data":    link,
+	})
+}
+
+func (h *SocialLinkHandler) SetActive(c *gin.Context) {
+	link, err := h.service.SetActive(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, link.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social link activation updated successfully",
+		"data":    link,
+	})
+}
+
+func (h *SocialLinkHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social link deleted successfully",
+	})
+}
+
+func (h *SocialLinkHandler) GetAll(c *gin.Context) {
+	links, page, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Social links retrieved successfully",
+		"data":    links,
+		"page":    page,
+	})
+}
+
+// ============================
+// SETTINGS HANDLER
+// ============================
+
+type SettingHandler struct {
+	service service.SettingService
+}
+
+func NewSettingHandler(service service.SettingService) *SettingHandler {
+	return &SettingHandler{service: service}
+}
+
+func (h *SettingHandler) Create(c *gin.Context) {
+	setting, err := h.service.Create(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Setting created successfully",
+		"data":    setting,
+	})
+}
+
+func (h *SettingHandler) GetByKey(c *gin.Context) {
+	setting, err := h.service.GetByKey(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	utils.SetResourceVersion(c, setting.Version)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Setting retrieved successfully",
+		"data":    setting,
+	})
+}
+
+func (h *SettingHandler) Upsert(c *gin.Context) {
+	setting, created, err := h.service.Upsert(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	status, message := http.StatusOK, "Setting updated successfully"
+	if created {
+		status, message = http.StatusCreated, "Setting created successfully"
+	}
+
+	utils.SetResourceVersion(c, setting.Version)
+	c.JSON(status, gin.H{
+		"message": message,
+		"data":    setting,
+	})
+}
+
+func (h *SettingHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Setting deleted successfully",
+	})
+}
+
+func (h *SettingHandler) GetAll(c *gin.Context) {
+	settings, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Settings retrieved successfully",
+		"data":    settings,
+	})
+}
+
+// ============================
+// CONTENT TRANSLATIONS HANDLER
+// ============================
+
+type TranslationHandler struct {
+	service service.TranslationService
+}
+
+func NewTranslationHandler(service service.TranslationService) *TranslationHandler {
+	return &TranslationHandler{service: service}
+}
+
+func (h *TranslationHandler) GetAll(c *gin.Context) {
+	translations, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Translations retrieved successfully",
+		"data":    translations,
+	})
+}
+
+func (h *TranslationHandler) GetEntity(c *gin.Context) {
+	translation, err := h.service.GetEntity(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Translation retrieved successfully",
+		"data":    translation,
+	})
+}
+
+func (h *TranslationHandler) Upsert(c *gin.Context) {
+	translation, err := h.service.Upsert(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Translation saved successfully",
+		"data":    translation,
+	})
+}
+
+func (h *TranslationHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Translation deleted successfully",
+	})
+}
+
+func (h *TranslationHandler) GetMissing(c *gin.Context) {
+	report, err := h.service.GetMissing(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Missing translations retrieved successfully",
+		"data":    report,
+	})
+}
+
+// ============================
+// REORDER HANDLER
+// ============================
+
+type ReorderHandler struct {
+	service service.ReorderService
+}
+
+func NewReorderHandler(service service.ReorderService) *ReorderHandler {
+	return &ReorderHandler{service: service}
+}
+
+// Reorder membuat handler POST /{resource}/reorder untuk satu resource
+func (h *ReorderHandler) Reorder(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ids, err := h.service.Reorder(c, resource)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Display order updated successfully",
+			"data":    gin.H{"resource": resource, "ids": ids},
+		})
+	}
+}
+
+// ============================
+// CONTACT HANDLER
+// ============================
+
+type ContactHandler struct {
+	service service.ContactService
+}
+
+func NewContactHandler(service service.ContactService) *ContactHandler {
+	return &ContactHandler{service: service}
+}
+
+func (h *ContactHandler) Submit(c *gin.Context) {
+	submission, err := h.service.Submit(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Message sent successfully",
+		"data":    submission,
+	})
+}
+
+func (h *ContactHandler) GetAll(c *gin.Context) {
+	messages, page, err := h.service.GetAll(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact messages retrieved successfully",
+		"data":    messages,
+		"page":    page,
+	})
+}
+
+func (h *ContactHandler) GetByID(c *gin.Context) {
+	message, err := h.service.GetByID(c)
+	if err != nil {
+		respondError(c, http.StatusNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact message retrieved successfully",
+		"data":    message,
+	})
+}
+
+func (h *ContactHandler) CountByStatus(c *gin.Context) {
+	counts, err := h.service.CountByStatus(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact message counts retrieved successfully",
+		"data":    counts,
+	})
+}
+
+func (h *ContactHandler) UpdateStatus(c *gin.Context) {
+	message, err := h.service.UpdateStatus(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact message status updated successfully",
+		"data":    message,
+	})
+}
+
+func (h *ContactHandler) Reply(c *gin.Context) {
+	message, err := h.service.Reply(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact reply recorded successfully",
+		"data":    message,
+	})
+}
+
+func (h *ContactHandler) Delete(c *gin.Context) {
+	if err := h.service.Delete(c); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Contact message deleted successfully",
 	})
 }
@@ -0,0 +1,14 @@
+package serviceroute
+
+import (
+	"gintugas/modules/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondError meneruskan error ke middleware ErrorHandler (problem+json).
+// status dipakai untuk error biasa; AppError dan error database memakai
+// status hasil pemetaan utils.AsAppError.
+func respondError(c *gin.Context, status int, err error) {
+	_ = c.Error(utils.AsAppError(err, status))
+}
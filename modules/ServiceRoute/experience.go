@@ -2,6 +2,7 @@ package serviceroute
 
 import (
 	"gintugas/modules/components/experiences/service"
+	"gintugas/modules/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -24,9 +25,7 @@ func NewGormExpeHandler(expeService service.ExperiencesService) *GormExpeHandler
 func (c *GormExpeHandler) CreateExperiencesWithRelations(ctx *gin.Context) {
 	experience, err := c.expeService.CreateExperienceWithRelations(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusBadRequest, err)
 		return
 	}
 
@@ -39,12 +38,11 @@ func (c *GormExpeHandler) CreateExperiencesWithRelations(ctx *gin.Context) {
 func (c *GormExpeHandler) GetExperiencesByIDWithRelations(ctx *gin.Context) {
 	experience, err := c.expeService.GetExperienceByIDWithRelations(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusNotFound, err)
 		return
 	}
 
+	utils.SetResourceVersion(ctx, experience.Version)
 	ctx.JSON(http.StatusOK, gin.H{
 		"message":    "Experience with relations retrieved successfully",
 		"experience": experience,
@@ -54,12 +52,25 @@ func (c *GormExpeHandler) GetExperiencesByIDWithRelations(ctx *gin.Context) {
 func (c *GormExpeHandler) UpdateExperiencesWithRelations(ctx *gin.Context) {
 	experience, err := c.expeService.UpdateExperienceWithRelations(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusBadRequest, err)
 		return
 	}
 
+	utils.SetResourceVersion(ctx, experience.Version)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    "Experience with relations updated successfully",
+		"experience": experience,
+	})
+}
+
+func (c *GormExpeHandler) PatchExperiencesWithRelations(ctx *gin.Context) {
+	experience, err := c.expeService.PatchExperienceWithRelations(ctx)
+	if err != nil {
+		respondError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	utils.SetResourceVersion(ctx, experience.Version)
 	ctx.JSON(http.StatusOK, gin.H{
 		"message":    "Experience with relations updated successfully",
 		"experience": experience,
@@ -68,9 +79,7 @@ func (c *GormExpeHandler) UpdateExperiencesWithRelations(ctx *gin.Context) {
 
 func (c *GormExpeHandler) DeleteExperiencesWithRelations(ctx *gin.Context) {
 	if err := c.expeService.DeleteExperienceWithRelations(ctx); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusBadRequest, err)
 		return
 	}
 
@@ -80,16 +89,15 @@ func (c *GormExpeHandler) DeleteExperiencesWithRelations(ctx *gin.Context) {
 }
 
 func (c *GormExpeHandler) GetAllExperiencesWithRelations(ctx *gin.Context) {
-	experiences, err := c.expeService.GetAllExperiencesWithRelations(ctx)
+	experiences, page, err := c.expeService.GetAllExperiencesWithRelations(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondError(ctx, http.StatusBadRequest, err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
-		"message":     "All experiences with relations retrieved successfully",
-		"experiences": experiences,
+		"message": "All experiences with relations retrieved successfully",
+		"data":    experiences,
+		"page":    page,
 	})
 }
@@ -28,9 +28,7 @@ func GetAllUsersRouter(db *sql.DB) gin.HandlerFunc {
 
 		users, err := usersSrv.GetAllUsersService(ctx)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			respondError(ctx, http.StatusBadRequest, err)
 			return
 		}
 
@@ -61,9 +59,7 @@ func GetUsersRouter(db *sql.DB) gin.HandlerFunc {
 
 		users, err := usersSrv.GetUserService(ctx)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			respondError(ctx, http.StatusBadRequest, err)
 			return
 		}
 
@@ -96,9 +92,7 @@ func UpdateUsersRouter(db *sql.DB) gin.HandlerFunc {
 
 		users, err := usersSrv.UpdateUserService(ctx)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			respondError(ctx, http.StatusBadRequest, err)
 			return
 		}
 
@@ -129,9 +123,7 @@ func DeleteUsersRouter(db *sql.DB) gin.HandlerFunc {
 
 		err := usersSrv.DeleteUserService(ctx)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			respondError(ctx, http.StatusBadRequest, err)
 			return
 		}
 
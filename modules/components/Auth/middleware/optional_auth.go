@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"gintugas/modules/components/Auth/blacklist"
+	utils "gintugas/modules/components/Auth/util"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OptionalAuth - membaca token jika ada tanpa menolak request anonim.
+// Dipakai oleh endpoint publik yang perilakunya berbeda untuk admin
+// (misalnya preview admin tidak dihitung sebagai view).
+func OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+			c.Next()
+			return
+		}
+
+		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+		if blacklist.GetInstance().IsBlacklisted(tokenString) {
+			c.Next()
+			return
+		}
+
+		claims, err := utils.ValidateToken(tokenString)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		userID, _ := claims["user_id"].(string)
+		role, _ := claims["role"].(string)
+		if userID != "" && role != "" {
+			c.Set("user_id", userID)
+			c.Set("username", claims["username"])
+			c.Set("user_role", role)
+		}
+
+		c.Next()
+	}
+}
+
+// IsAdmin mengecek apakah request berasal dari user dengan role admin
+func IsAdmin(c *gin.Context) bool {
+	role, exists := c.Get("user_role")
+	if !exists {
+		return false
+	}
+	userRole, ok := role.(string)
+	return ok && userRole == "admin"
+}
@@ -16,31 +16,37 @@ type Project struct {
 	DisplayOrder int       `json:"display_order" gorm:"column:display_order;type:integer;not null;default:0"`
 	IsFeatured   bool      `json:"is_featured" gorm:"column:is_featured;type:boolean;default:false"`
 	Status       string    `json:"status" gorm:"column:status;type:varchar(20);default:'published'"`
+	Version      int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt    time.Time `json:"created_at" gorm:"column:created_at"`
 	UpdatedAt    time.Time `json:"updated_at" gorm:"column:updated_at"`
 
 	// Relations
 	Tags []ProjectTag `json:"tags,omitempty" gorm:"many2many:project_tag_relations;"`
+
+	// Diisi true jika project diakses lewat preview token
+	Preview bool `json:"preview,omitempty" gorm:"-"`
 }
 
 type ProjectForm struct {
 	Title        string `form:"title" binding:"required"`
 	Description  string `form:"description" binding:"required"`
-	CodeURL      string `form:"code_url" binding:"required"`
-	DemoURL      string `form:"demo_url"`
-	Status       string `form:"status"`
+	CodeURL      string `form:"code_url" binding:"required,httpurl"`
+	DemoURL      string `form:"demo_url" binding:"omitempty,httpurl"`
+	Status       string `form:"status" binding:"omitempty,oneof=draft published archived"`
 	DisplayOrder int    `form:"display_order"`
 	IsFeatured   bool   `form:"is_featured"`
 }
 
-type ProjectUpdateForm struct {
-	Title        string `form:"title"`
-	Description  string `form:"description"`
-	DemoURL      string `form:"demo_url"`
-	CodeURL      string `form:"code_url"`
-	DisplayOrder int    `form:"display_order"`
-	IsFeatured   bool   `form:"is_featured"`
-	Status       string `form:"status"`
+// ProjectRequest adalah representasi JSON project untuk PATCH (JSON Merge Patch).
+// Gambar tetap diganti lewat PUT multipart.
+type ProjectRequest struct {
+	Title        string `json:"title" binding:"required"`
+	Description  string `json:"description" binding:"required"`
+	DemoURL      string `json:"demo_url" binding:"omitempty,httpurl"`
+	CodeURL      string `json:"code_url" binding:"required,httpurl"`
+	DisplayOrder int    `json:"display_order"`
+	IsFeatured   bool   `json:"is_featured"`
+	Status       string `json:"status" binding:"omitempty,oneof=draft published archived"`
 }
 
 type ProjectTag struct {
@@ -50,6 +56,11 @@ type ProjectTag struct {
 	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
 }
 
+type TagRequest struct {
+	Name  string `json:"name" binding:"required,max=50"`
+	Color string `json:"color" binding:"omitempty,hexcolor"`
+}
+
 type TagResponse struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
@@ -1,10 +1,11 @@
 package projectrepo
 
 import (
+	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	. "gintugas/modules/components/Project/model"
+	"gintugas/modules/utils"
 	"strings"
 
 	"github.com/google/uuid"
@@ -16,10 +17,13 @@ type Repository interface {
 	GetAllProjekRepository() ([]Project, error)
 	GetProjekRepository(id uuid.UUID) (Project, error)
 	UpdateProjekRepository(projek Project) (Project, error)
-	DeleteProjekRepository(id uuid.UUID) error
+	DeleteProjekRepository(id uuid.UUID, version int) error
 	GetProjekWithTagsRepository(id uuid.UUID) (Project, error)
 	GetAllProjekWithTagsRepository() ([]Project, error)
+	ListProjekRepository(q *utils.ListQuery, withTags bool) ([]Project, utils.PageInfo, error)
 	GetAllTagsRepository() (result []ProjectTag, err error)
+	ReorderProjekRepository(ids []uuid.UUID) error
+	WithContext(ctx context.Context) Repository
 }
 
 type TagsRepository interface {
@@ -27,7 +31,8 @@ type TagsRepository interface {
 }
 
 type repository struct {
-	db *sql.DB
+	db  *sql.DB
+	ctx context.Context
 }
 
 type tagsRepository struct {
@@ -41,12 +46,18 @@ func NewTagsRepository(db *gorm.DB) TagsRepository {
 }
 
 func NewRepository(db *sql.DB) Repository {
-	return &repository{db: db}
+	return &repository{db: db, ctx: context.Background()}
+}
+
+// WithContext mengikat semua query ke ctx, sehingga query ikut dibatalkan
+// saat request selesai atau timeout
+func (r *repository) WithContext(ctx context.Context) Repository {
+	return &repository{db: r.db, ctx: ctx}
 }
 
 func (r *repository) GetAllTagsRepository() (result []ProjectTag, err error) {
 	query := "SELECT id, name, color FROM project_tags ORDER BY id"
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(r.ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -70,10 +81,10 @@ func (r *repository) CreateProjekRepository(projek Project) (Project, error) {
 		INSERT INTO portfolio_projects 
 		(title, description, image_url, demo_url, code_url, display_order, is_featured, status) 
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
-		RETURNING id, created_at, updated_at
+		RETURNING id, version, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(
+	err := r.db.QueryRowContext(r.ctx,
 		query,
 		projek.Title,
 		projek.Description,
@@ -83,7 +94,7 @@ func (r *repository) CreateProjekRepository(projek Project) (Project, error) {
 		projek.DisplayOrder,
 		projek.IsFeatured,
 		projek.Status,
-	).Scan(&projek.ID, &projek.CreatedAt, &projek.UpdatedAt)
+	).Scan(&projek.ID, &projek.Version, &projek.CreatedAt, &projek.UpdatedAt)
 
 	if err != nil {
 		return Project{}, err
@@ -95,12 +106,12 @@ func (r *repository) CreateProjekRepository(projek Project) (Project, error) {
 func (r *repository) GetAllProjekRepository() ([]Project, error) {
 	query := `
 		SELECT id, title, description, image_url, demo_url, code_url, 
-		       display_order, is_featured, status, created_at, updated_at
+		       display_order, is_featured, status, version, created_at, updated_at
 		FROM portfolio_projects 
 		ORDER BY display_order ASC
 	`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(r.ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +130,7 @@ func (r *repository) GetAllProjekRepository() ([]Project, error) {
 			&project.DisplayOrder,
 			&project.IsFeatured,
 			&project.Status,
+			&project.Version,
 			&project.CreatedAt,
 			&project.UpdatedAt,
 		)
@@ -134,13 +146,13 @@ func (r *repository) GetAllProjekRepository() ([]Project, error) {
 func (r *repository) GetProjekRepository(id uuid.UUID) (Project, error) {
 	query := `
 		SELECT id, title, description, image_url, demo_url, code_url, 
-		       display_order, is_featured, status, created_at, updated_at
+		       display_order, is_featured, status, version, created_at, updated_at
 		FROM portfolio_projects 
 		WHERE id = $1
 	`
 
 	var project Project
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(r.ctx, query, id).Scan(
 		&project.ID,
 		&project.Title,
 		&project.Description,
@@ -150,13 +162,14 @@ func (r *repository) GetProjekRepository(id uuid.UUID) (Project, error) {
 		&project.DisplayOrder,
 		&project.IsFeatured,
 		&project.Status,
+		&project.Version,
 		&project.CreatedAt,
 		&project.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return Project{}, errors.New("project not found")
+			return Project{}, utils.NotFound("project")
 		}
 		return Project{}, err
 	}
@@ -165,16 +178,17 @@ func (r *repository) GetProjekRepository(id uuid.UUID) (Project, error) {
 }
 
 func (r *repository) UpdateProjekRepository(projek Project) (Project, error) {
+	// Update hanya berhasil jika version belum berubah sejak project dibaca
 	query := `
 		UPDATE portfolio_projects 
 		SET title = $1, description = $2, image_url = $3, demo_url = $4, 
 		    code_url = $5, display_order = $6, is_featured = $7, status = $8,
-			updated_at = NOW()
-		WHERE id = $9
-		RETURNING updated_at
+			version = version + 1, updated_at = NOW()
+		WHERE id = $9 AND version = $10
+		RETURNING version, updated_at
 	`
 
-	err := r.db.QueryRow(
+	err := r.db.QueryRowContext(r.ctx,
 		query,
 		projek.Title,
 		projek.Description,
@@ -185,8 +199,12 @@ func (r *repository) UpdateProjekRepository(projek Project) (Project, error) {
 		projek.IsFeatured,
 		projek.Status,
 		projek.ID,
-	).Scan(&projek.UpdatedAt)
+		projek.Version,
+	).Scan(&projek.Version, &projek.UpdatedAt)
 
+	if err == sql.ErrNoRows {
+		return Project{}, utils.PreconditionFailed()
+	}
 	if err != nil {
 		return Project{}, err
 	}
@@ -194,10 +212,11 @@ func (r *repository) UpdateProjekRepository(projek Project) (Project, error) {
 	return projek, nil
 }
 
-func (r *repository) DeleteProjekRepository(id uuid.UUID) error {
-	query := `DELETE FROM portfolio_projects WHERE id = $1`
+func (r *repository) DeleteProjekRepository(id uuid.UUID, version int) error {
+	// Sama seperti update: hanya terhapus jika version belum berubah
+	query := `DELETE FROM portfolio_projects WHERE id = $1 AND version = $2`
 
-	result, err := r.db.Exec(query, id)
+	result, err := r.db.ExecContext(r.ctx, query, id, version)
 	if err != nil {
 		return err
 	}
@@ -208,12 +227,58 @@ func (r *repository) DeleteProjekRepository(id uuid.UUID) error {
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("project not found")
+		return utils.PreconditionFailed()
 	}
 
 	return nil
 }
 
+// ReorderProjekRepository mengunci semua project, memastikan daftar ID lengkap,
+// lalu menulis display_order baru dalam satu transaksi
+func (r *repository) ReorderProjekRepository(ids []uuid.UUID) error {
+	tx, err := r.db.BeginTx(r.ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`SELECT id FROM portfolio_projects ORDER BY display_order ASC FOR UPDATE`)
+	if err != nil {
+		return err
+	}
+
+	var current []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		current = append(current, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if err := utils.CheckReorderSet(current, ids); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE portfolio_projects
+		SET display_order = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2
+	`
+	for i, id := range ids {
+		if _, err := tx.Exec(query, i+1, id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *repository) GetProjekWithTagsRepository(id uuid.UUID) (Project, error) {
 	// First get project
 	project, err := r.GetProjekRepository(id)
@@ -229,7 +294,7 @@ func (r *repository) GetProjekWithTagsRepository(id uuid.UUID) (Project, error)
 		WHERE ptr.project_id = $1
 	`
 
-	tagRows, err := r.db.Query(tagsQuery, id)
+	tagRows, err := r.db.QueryContext(r.ctx, tagsQuery, id)
 	if err != nil {
 		return project, err
 	}
@@ -253,12 +318,12 @@ func (r *repository) GetAllProjekWithTagsRepository() ([]Project, error) {
 	// Query untuk mendapatkan semua projects
 	projectQuery := `
 		SELECT id, title, description, image_url, demo_url, code_url, 
-		       display_order, is_featured, status, created_at, updated_at
+		       display_order, is_featured, status, version, created_at, updated_at
 		FROM portfolio_projects 
 		ORDER BY display_order ASC
 	`
 
-	projectRows, err := r.db.Query(projectQuery)
+	projectRows, err := r.db.QueryContext(r.ctx, projectQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +345,7 @@ func (r *repository) GetAllProjekWithTagsRepository() ([]Project, error) {
 			&project.DisplayOrder,
 			&project.IsFeatured,
 			&project.Status,
+			&project.Version,
 			&project.CreatedAt,
 			&project.UpdatedAt,
 		)
@@ -322,6 +388,96 @@ func (r *repository) GetAllProjekWithTagsRepository() ([]Project, error) {
 	return projects, nil
 }
 
+var ProjectListSpec = utils.ListSpec{
+	DefaultSort: "display_order",
+	Fields: map[string]utils.ListField{
+		"title":         {Column: "title", Type: utils.FieldText, Sortable: true},
+		"status":        {Column: "status", Type: utils.FieldText, Filterable: true},
+		"is_featured":   {Column: "is_featured", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+		"updated_at":    {Column: "updated_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *repository) ListProjekRepository(q *utils.ListQuery, withTags bool) ([]Project, utils.PageInfo, error) {
+	// Hitung total sesuai filter (tanpa cursor/limit)
+	countQuery := "SELECT COUNT(*) FROM portfolio_projects"
+	filterWhere, filterArgs := q.FilterSQL(1)
+	if filterWhere != "" {
+		countQuery += " WHERE " + filterWhere
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(r.ctx, countQuery, filterArgs...).Scan(&total); err != nil {
+		return nil, utils.PageInfo{}, err
+	}
+
+	where, tail, args := q.SQL(1)
+	query := `
+		SELECT id, title, description, image_url, demo_url, code_url, 
+		       display_order, is_featured, status, version, created_at, updated_at
+		FROM portfolio_projects
+	`
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " " + tail
+
+	rows, err := r.db.QueryContext(r.ctx, query, args...)
+	if err != nil {
+		return nil, utils.PageInfo{}, err
+	}
+	defer rows.Close()
+
+	var projects []Project
+	for rows.Next() {
+		var project Project
+		err := rows.Scan(
+			&project.ID,
+			&project.Title,
+			&project.Description,
+			&project.ImageURL,
+			&project.DemoURL,
+			&project.CodeURL,
+			&project.DisplayOrder,
+			&project.IsFeatured,
+			&project.Status,
+			&project.Version,
+			&project.CreatedAt,
+			&project.UpdatedAt,
+		)
+		if err != nil {
+			return nil, utils.PageInfo{}, err
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, utils.PageInfo{}, err
+	}
+
+	projects, page := utils.BuildPage(projects, q, total)
+
+	if withTags && len(projects) > 0 {
+		projectIDs := make([]uuid.UUID, len(projects))
+		for i := range projects {
+			projectIDs[i] = projects[i].ID
+			projects[i].Tags = []ProjectTag{}
+		}
+
+		tags, err := r.getTagsForMultipleProjects(projectIDs)
+		if err == nil {
+			for i := range projects {
+				if projectTags, ok := tags[projects[i].ID]; ok {
+					projects[i].Tags = projectTags
+				}
+			}
+		}
+	}
+
+	return projects, page, nil
+}
+
 // Helper function untuk mendapatkan tags untuk multiple projects
 func (r *repository) getTagsForMultipleProjects(projectIDs []uuid.UUID) (map[uuid.UUID][]ProjectTag, error) {
 	// Convert UUID slice to string slice untuk query
@@ -351,7 +507,7 @@ func (r *repository) getTagsForMultipleProjects(projectIDs []uuid.UUID) (map[uui
 		ORDER BY ptr.project_id, pt.name
 	`, strings.Join(placeholders, ","))
 
-	rows, err := r.db.Query(query, params...)
+	rows, err := r.db.QueryContext(r.ctx, query, params...)
 	if err != nil {
 		return nil, err
 	}
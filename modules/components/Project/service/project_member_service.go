@@ -2,6 +2,7 @@ package projectservice
 
 import (
 	repository "gintugas/modules/components/Project/repository"
+	"gintugas/modules/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -30,37 +31,37 @@ func (s *ProjectMemberService) AddTag(ctx *gin.Context) {
 
 	// Validasi UUID
 	if _, err := uuid.Parse(projectID); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID format"})
+		_ = ctx.Error(utils.InvalidID("project"))
 		return
 	}
 
 	var req AddTagRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		_ = ctx.Error(err)
 		return
 	}
 
 	// Validasi tag ID
 	if _, err := uuid.Parse(req.TagID); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID format"})
+		_ = ctx.Error(utils.InvalidID("tag"))
 		return
 	}
 
 	// Cek apakah tag sudah ada
 	exists, err := s.MemberRepo.IsProjectTag(projectID, req.TagID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = ctx.Error(utils.AsAppError(err, http.StatusInternalServerError))
 		return
 	}
 
 	if exists {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Tag already added to this project"})
+		_ = ctx.Error(utils.ConflictKey("project_tag_exists"))
 		return
 	}
 
 	// Tambahkan tag
 	if err := s.MemberRepo.AddTag(projectID, req.TagID); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = ctx.Error(utils.AsAppError(err, http.StatusInternalServerError))
 		return
 	}
 
@@ -85,17 +86,17 @@ func (s *ProjectMemberService) RemoveTag(ctx *gin.Context) {
 
 	// Validasi UUID
 	if _, err := uuid.Parse(projectID); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID format"})
+		_ = ctx.Error(utils.InvalidID("project"))
 		return
 	}
 
 	if _, err := uuid.Parse(tagID); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID format"})
+		_ = ctx.Error(utils.InvalidID("tag"))
 		return
 	}
 
 	if err := s.MemberRepo.RemoveTag(projectID, tagID); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = ctx.Error(utils.AsAppError(err, http.StatusInternalServerError))
 		return
 	}
 
@@ -119,13 +120,13 @@ func (s *ProjectMemberService) GetProjectTags(ctx *gin.Context) {
 
 	// Validasi UUID
 	if _, err := uuid.Parse(projectID); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID format"})
+		_ = ctx.Error(utils.InvalidID("project"))
 		return
 	}
 
 	tags, err := s.MemberRepo.GetProjectTags(projectID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = ctx.Error(utils.AsAppError(err, http.StatusInternalServerError))
 		return
 	}
 
@@ -1,9 +1,9 @@
 package projectservice
 
 import (
-	"errors"
 	"fmt"
 
+	authmiddleware "gintugas/modules/components/Auth/middleware"
 	. "gintugas/modules/components/Project/model"
 	. "gintugas/modules/components/Project/repository"
 	"gintugas/modules/utils"
@@ -19,13 +19,19 @@ import (
 
 type Service interface {
 	GetAllTagsService(ctx *gin.Context) (result []ProjectTag, err error)
-	GetAllProjekService(ctx *gin.Context) ([]Project, error)
+	GetAllProjekService(ctx *gin.Context) ([]Project, *utils.PageInfo, error)
 	GetProjekService(ctx *gin.Context) (Project, error)
 	UpdateProjekService(ctx *gin.Context) (Project, error)
+	PatchProjekService(ctx *gin.Context) (Project, error)
 	DeleteProjekService(ctx *gin.Context) error
 	CreateProjekWithImageService(ctx *gin.Context) (Project, error)
 }
 
+// PreviewAuthorizer mengecek preview token untuk draft project
+type PreviewAuthorizer interface {
+	Authorize(ctx *gin.Context, resourceType string, resourceID uuid.UUID) bool
+}
+
 type TagsService interface {
 	CreateTags(ctx *gin.Context) (*TagResponse, error)
 }
@@ -34,10 +40,12 @@ type projectService struct {
 	repository    Repository
 	uploadPath    string
 	uploadService UploadServiceWrapper
+	previews      PreviewAuthorizer
+	localizer     utils.Localizer
 }
 
 // NewService untuk development (local storage)
-func NewService(repository Repository, uploadPath string) Service {
+func NewService(repository Repository, uploadPath string, previews PreviewAuthorizer, localizer utils.Localizer) Service {
 	if err := os.MkdirAll(uploadPath, 0755); err != nil {
 		fmt.Printf("Warning: gagal membuat folder upload: %v\n", err)
 	}
@@ -66,10 +74,12 @@ func NewService(repository Repository, uploadPath string) Service {
 		repository:    repository,
 		uploadPath:    uploadPath,
 		uploadService: uploadService,
+		previews:      previews,
+		localizer:     localizer,
 	}
 }
 
-func NewServiceWithUpload(repository Repository, uploadService UploadServiceWrapper, folder string) Service {
+func NewServiceWithUpload(repository Repository, uploadService UploadServiceWrapper, folder string, previews PreviewAuthorizer, localizer utils.Localizer) Service {
 	uploadPath := getUploadPath()
 	localPath := filepath.Join(uploadPath, folder)
 
@@ -81,6 +91,8 @@ func NewServiceWithUpload(repository Repository, uploadService UploadServiceWrap
 		repository:    repository,
 		uploadPath:    localPath,
 		uploadService: uploadService,
+		previews:      previews,
+		localizer:     localizer,
 	}
 }
 
@@ -133,7 +145,7 @@ func (s *projectService) validateFile(file *multipart.FileHeader) error {
 	// Ukuran file 10MB
 	maxSize := int64(10 * 1024 * 1024)
 	if file.Size > maxSize {
-		return errors.New("ukuran file maksimal 10MB")
+		return utils.BadRequestKey("file_too_large", "max", "10")
 	}
 
 	allowedExts := []string{".jpg", ".jpeg", ".png", ".webp", ".gif", ".svg"}
@@ -148,7 +160,7 @@ func (s *projectService) validateFile(file *multipart.FileHeader) error {
 	}
 
 	if !valid {
-		return fmt.Errorf("tipe file tidak diizinkan. File yang diizinkan: %s", strings.Join(allowedExts, ", "))
+		return utils.BadRequestKey("file_type", "param", strings.Join(allowedExts, ", "))
 	}
 
 	return nil
@@ -158,9 +170,9 @@ func (s *projectService) CreateProjekWithImageService(ctx *gin.Context) (Project
 	var form ProjectForm
 
 	// Bind form data
-	if err := ctx.ShouldBind(&form); err != nil {
+	if err := utils.Bind(ctx, &form); err != nil {
 		fmt.Printf("❌ Bind form error: %v\n", err)
-		return Project{}, fmt.Errorf("gagal binding data: %v", err)
+		return Project{}, err
 	}
 
 	fmt.Printf("📝 Form data received:\n")
@@ -169,7 +181,7 @@ func (s *projectService) CreateProjekWithImageService(ctx *gin.Context) (Project
 
 	// Validasi required fields
 	if form.Title == "" {
-		return Project{}, errors.New("judul projek harus diisi")
+		return Project{}, utils.RequireFields("title", form.Title)
 	}
 
 	// Handle file upload
@@ -194,7 +206,7 @@ func (s *projectService) CreateProjekWithImageService(ctx *gin.Context) (Project
 		imageURL, err = s.uploadService.UploadFile(file, "projects")
 		if err != nil {
 			fmt.Printf("❌ Upload failed: %v\n", err)
-			return Project{}, fmt.Errorf("gagal mengupload file: %v", err)
+			return Project{}, fmt.Errorf("gagal mengupload file: %w", err)
 		}
 
 		fmt.Printf("✅ Image uploaded successfully: %s\n", imageURL)
@@ -239,7 +251,7 @@ func (s *projectService) CreateProjekWithImageService(ctx *gin.Context) (Project
 			fmt.Printf("🧹 Cleaning up uploaded file: %s\n", imageURL)
 			s.uploadService.DeleteFile(imageURL)
 		}
-		return Project{}, fmt.Errorf("gagal menyimpan data projek: %v", err)
+		return Project{}, fmt.Errorf("gagal menyimpan data projek: %w", err)
 	}
 
 	fmt.Printf("✅ Project created successfully with ID: %s\n", result.ID)
@@ -251,38 +263,73 @@ func (s *projectService) CreateProjekWithImageService(ctx *gin.Context) (Project
 func (s *projectService) GetAllTagsService(ctx *gin.Context) (result []ProjectTag, err error) {
 	Tags, err := s.repository.GetAllTagsRepository()
 	if err != nil {
-		return nil, errors.New("gagal mengambil data Tags: " + err.Error())
+		return nil, fmt.Errorf("gagal mengambil data Tags: %w", err)
 	}
 
 	return Tags, nil
 }
 
-func (s *projectService) GetAllProjekService(ctx *gin.Context) ([]Project, error) {
+func (s *projectService) GetAllProjekService(ctx *gin.Context) ([]Project, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, ProjectListSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Status tidak dipaksa published: admin UI memakai daftar ini tanpa token,
+	// situs publik memfilter sendiri dengan filter[status]=published
+
 	// Check query parameter for with_tags
-	withTags := ctx.Query("with_tags")
+	withTags := ctx.Query("with_tags") == "true"
 
-	if withTags == "true" {
-		return s.repository.GetAllProjekWithTagsRepository()
+	projects, page, err := s.repository.ListProjekRepository(q, withTags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableProject)
+	for i := range projects {
+		LocalizeProject(translations, &projects[i])
 	}
 
-	return s.repository.GetAllProjekRepository()
+	return projects, &page, nil
 }
 
 func (s *projectService) GetProjekService(ctx *gin.Context) (Project, error) {
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return Project{}, errors.New("ID projek tidak valid")
+		return Project{}, utils.InvalidID("project")
 	}
 
 	// Check query parameter for with_tags
 	withTags := ctx.Query("with_tags")
 
+	var project Project
 	if withTags == "true" {
-		return s.repository.GetProjekWithTagsRepository(id)
+		project, err = s.repository.GetProjekWithTagsRepository(id)
+	} else {
+		project, err = s.repository.GetProjekRepository(id)
+	}
+	if err != nil {
+		return Project{}, err
+	}
+
+	// Project yang belum published hanya untuk admin atau pemegang preview token
+	if project.Status != "published" && !authmiddleware.IsAdmin(ctx) {
+		if !s.previews.Authorize(ctx, "project", project.ID) {
+			return Project{}, utils.NotFound("project")
+		}
+		project.Preview = true
 	}
 
-	return s.repository.GetProjekRepository(id)
+	LocalizeProject(utils.Localize(s.localizer, ctx, utils.TranslatableProject), &project)
+	return project, nil
+}
+
+// LocalizeProject juga dipakai snapshot portfolio
+func LocalizeProject(translations utils.Translations, project *Project) {
+	translations.Apply(project.ID, "title", &project.Title)
+	translations.Apply(project.ID, "description", &project.Description)
 }
 
 // Service dengan struct binding
@@ -290,13 +337,16 @@ func (s *projectService) UpdateProjekService(ctx *gin.Context) (Project, error)
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return Project{}, errors.New("ID projek tidak valid")
+		return Project{}, utils.InvalidID("project")
 	}
 
 	// Check if project exists
 	existingProject, err := s.repository.GetProjekRepository(id)
 	if err != nil {
-		return Project{}, errors.New("projek tidak ditemukan")
+		return Project{}, utils.NotFound("project")
+	}
+	if err := utils.CheckIfMatch(ctx, existingProject.Version); err != nil {
+		return Project{}, err
 	}
 
 	// Handle file upload
@@ -314,7 +364,7 @@ func (s *projectService) UpdateProjekService(ctx *gin.Context) (Project, error)
 		// Upload file baru
 		newImageURL, err := s.uploadService.UploadFile(file, "projects")
 		if err != nil {
-			return Project{}, fmt.Errorf("gagal mengupload file baru: %v", err)
+			return Project{}, fmt.Errorf("gagal mengupload file baru: %w", err)
 		}
 
 		// Hapus file lama jika ada
@@ -326,35 +376,25 @@ func (s *projectService) UpdateProjekService(ctx *gin.Context) (Project, error)
 		fmt.Printf("🔄 Updated image to: %s\n", imageURL)
 	}
 
-	// Bind form data
-	var form ProjectUpdateForm
-	if err := ctx.ShouldBind(&form); err != nil {
+	// PUT mengganti seluruh field; gambar tetap jika tidak ada file baru
+	var form ProjectForm
+	if err := utils.Bind(ctx, &form); err != nil {
 		// Cleanup file baru jika binding gagal
 		if file != nil && imageURL != existingProject.ImageURL {
 			s.uploadService.DeleteFile(imageURL)
 		}
-		return Project{}, fmt.Errorf("gagal binding data: %v", err)
+		return Project{}, err
 	}
 
-	// Update fields yang ada nilainya
-	if form.Title != "" {
-		existingProject.Title = form.Title
-	}
-	if form.Description != "" {
-		existingProject.Description = form.Description
-	}
-	if form.DemoURL != "" {
-		existingProject.DemoURL = form.DemoURL
-	}
-	if form.CodeURL != "" {
-		existingProject.CodeURL = form.CodeURL
-	}
-	if form.DisplayOrder != 0 {
-		existingProject.DisplayOrder = form.DisplayOrder
-	}
+	existingProject.Title = form.Title
+	existingProject.Description = form.Description
+	existingProject.DemoURL = form.DemoURL
+	existingProject.CodeURL = form.CodeURL
+	existingProject.DisplayOrder = form.DisplayOrder
 	existingProject.IsFeatured = form.IsFeatured
-	if form.Status != "" {
-		existingProject.Status = form.Status
+	existingProject.Status = form.Status
+	if existingProject.Status == "" {
+		existingProject.Status = "published"
 	}
 
 	// Update image URL
@@ -367,7 +407,54 @@ func (s *projectService) UpdateProjekService(ctx *gin.Context) (Project, error)
 		if file != nil && imageURL != existingProject.ImageURL {
 			s.uploadService.DeleteFile(imageURL)
 		}
-		return Project{}, fmt.Errorf("gagal mengupdate projek: %v", err)
+		return Project{}, fmt.Errorf("gagal mengupdate projek: %w", err)
+	}
+
+	return result, nil
+}
+
+// PatchProjekService menerapkan JSON Merge Patch pada field project (tanpa gambar)
+func (s *projectService) PatchProjekService(ctx *gin.Context) (Project, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return Project{}, utils.InvalidID("project")
+	}
+
+	existingProject, err := s.repository.GetProjekRepository(id)
+	if err != nil {
+		return Project{}, utils.NotFound("project")
+	}
+	if err := utils.CheckIfMatch(ctx, existingProject.Version); err != nil {
+		return Project{}, err
+	}
+
+	req := ProjectRequest{
+		Title:        existingProject.Title,
+		Description:  existingProject.Description,
+		DemoURL:      existingProject.DemoURL,
+		CodeURL:      existingProject.CodeURL,
+		DisplayOrder: existingProject.DisplayOrder,
+		IsFeatured:   existingProject.IsFeatured,
+		Status:       existingProject.Status,
+	}
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return Project{}, err
+	}
+
+	existingProject.Title = req.Title
+	existingProject.Description = req.Description
+	existingProject.DemoURL = req.DemoURL
+	existingProject.CodeURL = req.CodeURL
+	existingProject.DisplayOrder = req.DisplayOrder
+	existingProject.IsFeatured = req.IsFeatured
+	existingProject.Status = req.Status
+	if existingProject.Status == "" {
+		existingProject.Status = "published"
+	}
+
+	result, err := s.repository.UpdateProjekRepository(existingProject)
+	if err != nil {
+		return Project{}, fmt.Errorf("gagal mengupdate projek: %w", err)
 	}
 
 	return result, nil
@@ -377,13 +464,21 @@ func (s *projectService) DeleteProjekService(ctx *gin.Context) error {
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return errors.New("ID projek tidak valid")
+		return utils.InvalidID("project")
 	}
 
 	// Check if project exists
 	existingProject, err := s.repository.GetProjekRepository(id)
 	if err != nil {
-		return errors.New("projek tidak ditemukan")
+		return utils.NotFound("project")
+	}
+	if err := utils.CheckIfMatch(ctx, existingProject.Version); err != nil {
+		return err
+	}
+
+	// Delete dari database dulu supaya image tidak hilang saat delete gagal 412
+	if err := s.repository.DeleteProjekRepository(id, existingProject.Version); err != nil {
+		return err
 	}
 
 	// Hapus file image jika ada
@@ -391,13 +486,12 @@ func (s *projectService) DeleteProjekService(ctx *gin.Context) error {
 		s.uploadService.DeleteFile(existingProject.ImageURL)
 	}
 
-	// Delete dari database
-	return s.repository.DeleteProjekRepository(id)
+	return nil
 }
 
 func (s *tagsService) CreateTags(ctx *gin.Context) (*TagResponse, error) {
-	var reqcomments TagResponse
-	if err := ctx.ShouldBindJSON(&reqcomments); err != nil {
+	var reqcomments TagRequest
+	if err := utils.BindJSON(ctx, &reqcomments); err != nil {
 		return nil, err
 	}
 
@@ -46,7 +46,7 @@ func (s *SupabaseUploadWrapper) ValidateFile(file *multipart.FileHeader, maxSize
 	// Ukuran file
 	maxSize := maxSizeMB * 1024 * 1024
 	if file.Size > maxSize {
-		return fmt.Errorf("ukuran file maksimal %dMB", maxSizeMB)
+		return utils.BadRequestKey("file_too_large", "max", fmt.Sprint(maxSizeMB))
 	}
 
 	// Extension
@@ -57,7 +57,7 @@ func (s *SupabaseUploadWrapper) ValidateFile(file *multipart.FileHeader, maxSize
 		}
 	}
 
-	return fmt.Errorf("tipe file tidak diizinkan. File yang diizinkan: %s", strings.Join(allowedExts, ", "))
+	return utils.BadRequestKey("file_type", "param", strings.Join(allowedExts, ", "))
 }
 
 // LocalUploadWrapper adalah wrapper untuk Local Upload Service
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"encoding/json"
+	"gintugas/modules/utils"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +21,7 @@ type Skill struct {
 	Category     string    `json:"category" gorm:"type:varchar(50)"` // programming, framework, tool
 	DisplayOrder int       `json:"display_order" gorm:"type:integer;default:0"`
 	IsFeatured   bool      `json:"is_featured" gorm:"type:boolean;default:false"`
+	Version      int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt    time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt    time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -43,15 +47,6 @@ type SkillRequest struct {
 	IsFeatured   bool   `json:"is_featured"`
 }
 
-type SkillUpdateRequest struct {
-	Name         string `json:"name"`
-	Value        int    `json:"value" binding:"omitempty,min=0,max=100"`
-	IconURL      string `json:"icon_url"`
-	Category     string `json:"category"`
-	DisplayOrder int    `json:"display_order"`
-	IsFeatured   bool   `json:"is_featured"`
-}
-
 type SkillResponse struct {
 	ID           uuid.UUID `json:"id"`
 	Name         string    `json:"name"`
@@ -60,6 +55,7 @@ type SkillResponse struct {
 	Category     string    `json:"category"`
 	DisplayOrder int       `json:"display_order"`
 	IsFeatured   bool      `json:"is_featured"`
+	Version      int       `json:"version"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -76,6 +72,7 @@ type Certificate struct {
 	Issuer        string    `json:"issuer" gorm:"type:varchar(150)"`
 	CredentialURL string    `json:"credential_url" gorm:"type:varchar(500)"`
 	DisplayOrder  int       `json:"display_order" gorm:"type:integer;default:0"`
+	Version       int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt     time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
 
@@ -87,7 +84,7 @@ type CertificateForm struct {
 	Name          string `form:"name" binding:"required"`
 	IssueDate     string `form:"issue_date"` // Pakai string untuk form-data
 	Issuer        string `form:"issuer"`
-	CredentialURL string `form:"credential_url"`
+	CredentialURL string `form:"credential_url" binding:"omitempty,httpurl"`
 	DisplayOrder  int    `form:"display_order"`
 }
 
@@ -96,16 +93,7 @@ type CertificateRequest struct {
 	ImageURL      string    `json:"image_url" binding:"required"`
 	IssueDate     time.Time `json:"issue_date"`
 	Issuer        string    `json:"issuer"`
-	CredentialURL string    `json:"credential_url"`
-	DisplayOrder  int       `json:"display_order"`
-}
-
-type CertificateUpdateRequest struct {
-	Name          string    `json:"name"`
-	ImageURL      string    `json:"image_url"`
-	IssueDate     time.Time `json:"issue_date"`
-	Issuer        string    `json:"issuer"`
-	CredentialURL string    `json:"credential_url"`
+	CredentialURL string    `json:"credential_url" binding:"omitempty,httpurl"`
 	DisplayOrder  int       `json:"display_order"`
 }
 
@@ -117,6 +105,7 @@ type CertificateResponse struct {
 	Issuer        string    `json:"issuer"`
 	CredentialURL string    `json:"credential_url"`
 	DisplayOrder  int       `json:"display_order"`
+	Version       int       `json:"version"`
 	CreatedAt     time.Time `json:"created_at"`
 }
 
@@ -125,23 +114,30 @@ type CertificateResponse struct {
 // ============================
 
 type Education struct {
-	ID           uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	School       string                 `json:"school" gorm:"type:varchar(200);not null"`
-	Major        string                 `json:"major" gorm:"type:varchar(200);not null"`
-	StartYear    string                 `json:"start_year" gorm:"type:varchar(20)"`
-	EndYear      string                 `json:"end_year" gorm:"type:varchar(20)"`
-	Description  string                 `json:"description" gorm:"type:text"`
-	Degree       string                 `json:"degree" gorm:"type:varchar(100)"` // S1, S2, SMA
-	DisplayOrder int                    `json:"display_order" gorm:"type:integer;default:0"`
-	Achievements []EducationAchievement `json:"achievements" gorm:"foreignKey:EducationID;references:ID"`
-	CreatedAt    time.Time              `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
-	UpdatedAt    time.Time              `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+	ID            uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	School        string                 `json:"school" gorm:"type:varchar(200);not null"`
+	Major         string                 `json:"major" gorm:"type:varchar(200);not null"`
+	StartDate     *time.Time             `json:"start_date" gorm:"type:date"`
+	EndDate       *time.Time             `json:"end_date" gorm:"type:date"` // nil jika is_ongoing
+	IsOngoing     bool                   `json:"is_ongoing" gorm:"type:boolean;not null;default:false"`
+	DatePrecision string                 `json:"date_precision" gorm:"type:varchar(10);not null;default:'month'"` // month, year
+	Description   string                 `json:"description" gorm:"type:text"`
+	Degree        string                 `json:"degree" gorm:"type:varchar(100)"` // S1, S2, SMA
+	DisplayOrder  int                    `json:"display_order" gorm:"type:integer;default:0"`
+	Achievements  []EducationAchievement `json:"achievements" gorm:"foreignKey:EducationID;references:ID"`
+	Version       int                    `json:"version" gorm:"not null;default:1"`
+	CreatedAt     time.Time              `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time              `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
 
 func (Education) TableName() string {
 	return "portfolio_education"
 }
 
+func (e Education) DateRange() utils.DateRange {
+	return utils.DateRange{Start: e.StartDate, End: e.EndDate, Ongoing: e.IsOngoing, Precision: e.DatePrecision}
+}
+
 type EducationAchievement struct {
 	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	EducationID  uuid.UUID `json:"education_id" gorm:"type:uuid;not null"`
@@ -162,23 +158,13 @@ type AchievementRequest struct {
 type EducationRequest struct {
 	School       string               `json:"school" binding:"required"`
 	Major        string               `json:"major" binding:"required"`
-	StartYear    string               `json:"start_year"`
-	EndYear      string               `json:"end_year"`
-	Description  string               `json:"description"`
-	Degree       string               `json:"degree"`
-	DisplayOrder int                  `json:"display_order"`
-	Achievements []AchievementRequest `json:"achievements"`
-}
-
-type EducationUpdateRequest struct {
-	School       string               `json:"school"`
-	Major        string               `json:"major"`
-	StartYear    string               `json:"start_year"`
-	EndYear      string               `json:"end_year"`
+	StartDate    string               `json:"start_date" binding:"omitempty,year"` // YYYY-MM atau YYYY
+	EndDate      string               `json:"end_date" binding:"omitempty,year_end,yearrange=StartDate"`
+	IsOngoing    bool                 `json:"is_ongoing"`
 	Description  string               `json:"description"`
 	Degree       string               `json:"degree"`
 	DisplayOrder int                  `json:"display_order"`
-	Achievements []AchievementRequest `json:"achievements"`
+	Achievements []AchievementRequest `json:"achievements" binding:"omitempty,dive"`
 }
 
 type AchievementResponse struct {
@@ -190,17 +176,21 @@ type AchievementResponse struct {
 }
 
 type EducationResponse struct {
-	ID           uuid.UUID             `json:"id"`
-	School       string                `json:"school"`
-	Major        string                `json:"major"`
-	StartYear    string                `json:"start_year"`
-	EndYear      string                `json:"end_year"`
-	Description  string                `json:"description"`
-	Degree       string                `json:"degree"`
-	DisplayOrder int                   `json:"display_order"`
-	Achievements []AchievementResponse `json:"achievements"`
-	CreatedAt    time.Time             `json:"created_at"`
-	UpdatedAt    time.Time             `json:"updated_at"`
+	ID            uuid.UUID             `json:"id"`
+	School        string                `json:"school"`
+	Major         string                `json:"major"`
+	StartDate     string                `json:"start_date"`
+	EndDate       *string               `json:"end_date"`
+	IsOngoing     bool                  `json:"is_ongoing"`
+	DatePrecision string                `json:"date_precision"`
+	Period        utils.Period          `json:"period"`
+	Description   string                `json:"description"`
+	Degree        string                `json:"degree"`
+	DisplayOrder  int                   `json:"display_order"`
+	Achievements  []AchievementResponse `json:"achievements"`
+	Version       int                   `json:"version"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
 }
 
 // ============================
@@ -217,7 +207,21 @@ type Testimonial struct {
 	IsFeatured   bool      `json:"is_featured" gorm:"type:boolean;default:false"`
 	DisplayOrder int       `json:"display_order" gorm:"type:integer;default:0"`
 	Status       string    `json:"status" gorm:"type:varchar(20);default:'approved'"` // pending, approved, rejected
+	Version      int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt    time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+
+	// Data submit publik dan moderasi
+	Email           string     `json:"email" gorm:"type:varchar(150)"`
+	Source          string     `json:"source" gorm:"type:varchar(20);not null;default:'admin'"` // admin, public, invite
+	RejectionReason string     `json:"rejection_reason" gorm:"type:text"`
+	ModeratedAt     *time.Time `json:"moderated_at" gorm:"type:timestamptz"`
+	IPAddress       *string    `json:"-" gorm:"type:inet"`
+	UserAgent       string     `json:"-" gorm:"type:text"`
+
+	// ProjectID menautkan testimoni ke project; IsVerified true jika dikirim
+	// lewat invite link klien
+	ProjectID  *uuid.UUID `json:"project_id" gorm:"type:uuid"`
+	IsVerified bool       `json:"is_verified" gorm:"not null;default:false"`
 }
 
 func (Testimonial) TableName() string {
@@ -225,38 +229,127 @@ func (Testimonial) TableName() string {
 }
 
 type TestimonialRequest struct {
-	Name         string `json:"name" binding:"required"`
-	Title        string `json:"title" binding:"required"`
-	Message      string `json:"message" binding:"required"`
-	AvatarURL    string `json:"avatar_url"`
-	Rating       int    `json:"rating" binding:"required,min=1,max=5"`
-	IsFeatured   bool   `json:"is_featured"`
-	DisplayOrder int    `json:"display_order"`
-	Status       string `json:"status"`
+	Name         string     `json:"name" binding:"required"`
+	Title        string     `json:"title" binding:"required"`
+	Message      string     `json:"message" binding:"required"`
+	AvatarURL    string     `json:"avatar_url"`
+	Rating       int        `json:"rating" binding:"required,min=1,max=5"`
+	IsFeatured   bool       `json:"is_featured"`
+	DisplayOrder int        `json:"display_order"`
+	Status       string     `json:"status" binding:"omitempty,oneof=pending approved rejected"`
+	ProjectID    *uuid.UUID `json:"project_id"`
 }
 
-type TestimonialUpdateRequest struct {
-	Name         string `json:"name"`
-	Title        string `json:"title"`
-	Message      string `json:"message"`
-	AvatarURL    string `json:"avatar_url"`
-	Rating       int    `json:"rating" binding:"omitempty,min=1,max=5"`
-	IsFeatured   bool   `json:"is_featured"`
-	DisplayOrder int    `json:"display_order"`
-	Status       string `json:"status"`
+type TestimonialResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Name         string     `json:"name"`
+	Title        string     `json:"title"`
+	Message      string     `json:"message"`
+	AvatarURL    string     `json:"avatar_url"`
+	Rating       int        `json:"rating"`
+	IsFeatured   bool       `json:"is_featured"`
+	DisplayOrder int        `json:"display_order"`
+	Status       string     `json:"status"`
+	ProjectID    *uuid.UUID `json:"project_id"`
+	IsVerified   bool       `json:"is_verified"`
+	Version      int        `json:"version"`
+	CreatedAt    time.Time  `json:"created_at"`
+
+	// Hanya untuk admin; dikosongkan pada response publik
+	Email           string     `json:"email,omitempty"`
+	Source          string     `json:"source,omitempty"`
+	RejectionReason string     `json:"rejection_reason,omitempty"`
+	ModeratedAt     *time.Time `json:"moderated_at,omitempty"`
 }
 
-type TestimonialResponse struct {
-	ID           uuid.UUID `json:"id"`
-	Name         string    `json:"name"`
-	Title        string    `json:"title"`
-	Message      string    `json:"message"`
-	AvatarURL    string    `json:"avatar_url"`
-	Rating       int       `json:"rating"`
-	IsFeatured   bool      `json:"is_featured"`
-	DisplayOrder int       `json:"display_order"`
-	Status       string    `json:"status"`
-	CreatedAt    time.Time `json:"created_at"`
+// TestimonialSubmissionForm untuk form publik (JSON atau multipart dengan avatar)
+type TestimonialSubmissionForm struct {
+	Name    string `json:"name" form:"name" binding:"required,max=100"`
+	Title   string `json:"title" form:"title" binding:"required,max=150"`
+	Message string `json:"message" form:"message" binding:"required,max=2000"`
+	Rating  int    `json:"rating" form:"rating" binding:"required,min=1,max=5"`
+	Email   string `json:"email" form:"email" binding:"omitempty,email,max=150"`
+}
+
+type TestimonialModerationRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending approved rejected"`
+	Reason string `json:"reason" binding:"required_if=Status rejected,max=500"`
+}
+
+type TestimonialSubmissionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ============================
+// TESTIMONIAL INVITES MODEL
+// ============================
+
+type TestimonialInvite struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TokenHash     string     `json:"-" gorm:"type:varchar(64);unique;not null"`
+	ClientName    string     `json:"client_name" gorm:"type:varchar(100);not null"`
+	ClientCompany string     `json:"client_company" gorm:"type:varchar(150)"`
+	ClientEmail   string     `json:"client_email" gorm:"type:varchar(150)"`
+	ProjectID     *uuid.UUID `json:"project_id" gorm:"type:uuid"`
+	Note          string     `json:"note" gorm:"type:varchar(200)"`
+	ExpiresAt     time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt        *time.Time `json:"used_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	TestimonialID *uuid.UUID `json:"testimonial_id" gorm:"type:uuid"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (TestimonialInvite) TableName() string {
+	return "testimonial_invites"
+}
+
+type TestimonialInviteRequest struct {
+	ClientName     string     `json:"client_name" binding:"required,max=100"`
+	ClientCompany  string     `json:"client_company" binding:"max=150"`
+	ClientEmail    string     `json:"client_email" binding:"omitempty,email,max=150"`
+	ProjectID      *uuid.UUID `json:"project_id"`
+	ExpiresInHours int        `json:"expires_in_hours" binding:"omitempty,min=1,max=2160"`
+	Note           string     `json:"note" binding:"max=200"`
+}
+
+type TestimonialInviteResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Token         string     `json:"token,omitempty"` // hanya diisi saat invite dibuat
+	ClientName    string     `json:"client_name"`
+	ClientCompany string     `json:"client_company"`
+	ClientEmail   string     `json:"client_email"`
+	ProjectID     *uuid.UUID `json:"project_id"`
+	Note          string     `json:"note"`
+	Status        string     `json:"status"` // active, used, revoked, expired
+	ExpiresAt     time.Time  `json:"expires_at"`
+	UsedAt        *time.Time `json:"used_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	TestimonialID *uuid.UUID `json:"testimonial_id"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// TestimonialInvitePrefill adalah data yang boleh dilihat klien pemegang token
+type TestimonialInvitePrefill struct {
+	ClientName    string                    `json:"client_name"`
+	ClientCompany string                    `json:"client_company"`
+	Project       *TestimonialInviteProject `json:"project,omitempty"`
+	ExpiresAt     time.Time                 `json:"expires_at"`
+}
+
+type TestimonialInviteProject struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+}
+
+// TestimonialInviteSubmissionForm: nama dan title boleh kosong, diisi dari invite
+type TestimonialInviteSubmissionForm struct {
+	Name    string `json:"name" form:"name" binding:"max=100"`
+	Title   string `json:"title" form:"title" binding:"max=150"`
+	Message string `json:"message" form:"message" binding:"required,max=2000"`
+	Rating  int    `json:"rating" form:"rating" binding:"required,min=1,max=5"`
+	Email   string `json:"email" form:"email" binding:"omitempty,email,max=150"`
 }
 
 // ============================
@@ -274,6 +367,7 @@ type BlogPost struct {
 	Status        string    `json:"status" gorm:"type:varchar(20);default:'draft'"` // draft, published, archived
 	ViewCount     int       `json:"view_count" gorm:"type:integer;default:0"`
 	Tags          []BlogTag `json:"tags" gorm:"many2many:blog_post_tags;joinForeignKey:PostID;joinReferences:TagID"`
+	Version       int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt     time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt     time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -310,21 +404,10 @@ type BlogPostRequest struct {
 	Title         string       `json:"title" binding:"required"`
 	Content       string       `json:"content"`
 	Excerpt       string       `json:"excerpt"`
-	Slug          string       `json:"slug" binding:"required"`
+	Slug          string       `json:"slug" binding:"required,slug"`
 	FeaturedImage string       `json:"featured_image"`
 	PublishDate   time.Time    `json:"publish_date"`
-	Status        string       `json:"status"`
-	Tags          []TagRequest `json:"tags"`
-}
-
-type BlogPostUpdateRequest struct {
-	Title         string       `json:"title"`
-	Content       string       `json:"content"`
-	Excerpt       string       `json:"excerpt"`
-	Slug          string       `json:"slug"`
-	FeaturedImage string       `json:"featured_image"`
-	PublishDate   time.Time    `json:"publish_date"`
-	Status        string       `json:"status"`
+	Status        string       `json:"status" binding:"omitempty,oneof=draft published archived"`
 	Tags          []TagRequest `json:"tags"`
 }
 
@@ -334,19 +417,501 @@ type TagResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// TagUsage hasil agregasi jumlah post per tag
+type TagUsage struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	PostCount      int       `json:"post_count"`
+	PublishedCount int       `json:"published_count"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type TagRenameRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type TagMergeRequest struct {
+	SourceIDs []string `json:"source_ids" binding:"required,min=1,dive,uuid"`
+	TargetID  string   `json:"target_id" binding:"required,uuid"`
+}
+
+type TagPostsResponse struct {
+	Tag   TagResponse        `json:"tag"`
+	Posts []BlogPostResponse `json:"posts"`
+}
+
+// NormalizeTagName merapikan nama tag: trim dan spasi ganda dijadikan satu.
+// Perbandingan antar tag dilakukan case-insensitive.
+func NormalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
 type BlogPostResponse struct {
-	ID            uuid.UUID     `json:"id"`
-	Title         string        `json:"title"`
-	Content       string        `json:"content"`
-	Excerpt       string        `json:"excerpt"`
-	Slug          string        `json:"slug"`
-	FeaturedImage string        `json:"featured_image"`
-	PublishDate   time.Time     `json:"publish_date"`
-	Status        string        `json:"status"`
-	ViewCount     int           `json:"view_count"`
-	Tags          []TagResponse `json:"tags"`
-	CreatedAt     time.Time     `json:"created_at"`
-	UpdatedAt     time.Time     `json:"updated_at"`
+	ID            uuid.UUID             `json:"id"`
+	Title         string                `json:"title"`
+	Content       string                `json:"content"`
+	Excerpt       string                `json:"excerpt"`
+	Slug          string                `json:"slug"`
+	FeaturedImage string                `json:"featured_image"`
+	PublishDate   time.Time             `json:"publish_date"`
+	Status        string                `json:"status"`
+	ViewCount     int                   `json:"view_count"`
+	Tags          []TagResponse         `json:"tags"`
+	Series        *BlogSeriesNavigation `json:"series,omitempty"`
+	Related       []RelatedPostResponse `json:"related,omitempty"`
+	Preview       bool                  `json:"preview,omitempty"`
+	Version       int                   `json:"version"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+}
+
+// ============================
+// BLOG SERIES MODELS
+// ============================
+
+type BlogSeries struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Title       string           `json:"title" gorm:"type:varchar(200);not null"`
+	Slug        string           `json:"slug" gorm:"type:varchar(200);unique;not null"`
+	Description string           `json:"description" gorm:"type:text"`
+	Posts       []BlogSeriesPost `json:"posts" gorm:"foreignKey:SeriesID;references:ID"`
+	Version     int              `json:"version" gorm:"not null;default:1"`
+	CreatedAt   time.Time        `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time        `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (BlogSeries) TableName() string {
+	return "blog_series"
+}
+
+type BlogSeriesPost struct {
+	SeriesID uuid.UUID `json:"series_id" gorm:"type:uuid;primaryKey"`
+	PostID   uuid.UUID `json:"post_id" gorm:"type:uuid;primaryKey"`
+	Position int       `json:"position" gorm:"type:integer;default:0"`
+	Post     BlogPost  `json:"post" gorm:"foreignKey:PostID;references:ID"`
+}
+
+func (BlogSeriesPost) TableName() string {
+	return "blog_series_posts"
+}
+
+type BlogSeriesRequest struct {
+	Title       string `json:"title" binding:"required"`
+	Slug        string `json:"slug" binding:"required,slug"`
+	Description string `json:"description"`
+}
+
+type BlogSeriesPostsRequest struct {
+	PostIDs []string `json:"post_ids" binding:"required,dive,uuid"`
+}
+
+type SeriesPostSummary struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Slug     string    `json:"slug"`
+	Status   string    `json:"status"`
+	Position int       `json:"position"`
+}
+
+type BlogSeriesResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	Title       string              `json:"title"`
+	Slug        string              `json:"slug"`
+	Description string              `json:"description"`
+	Posts       []SeriesPostSummary `json:"posts"`
+	Version     int                 `json:"version"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// BlogSeriesNavigation ditempel ke BlogPostResponse untuk "next in series"
+type BlogSeriesNavigation struct {
+	ID       uuid.UUID          `json:"id"`
+	Title    string             `json:"title"`
+	Slug     string             `json:"slug"`
+	Position int                `json:"position"` // 1-based
+	Total    int                `json:"total"`
+	Prev     *SeriesPostSummary `json:"prev"`
+	Next     *SeriesPostSummary `json:"next"`
+}
+
+type RelatedPostResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Title         string    `json:"title"`
+	Slug          string    `json:"slug"`
+	Excerpt       string    `json:"excerpt"`
+	FeaturedImage string    `json:"featured_image"`
+	PublishDate   time.Time `json:"publish_date"`
+	Score         float64   `json:"score"`
+}
+
+// ============================
+// BLOG VIEW ANALYTICS MODELS
+// ============================
+
+type BlogViewEvent struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PostID      uuid.UUID `json:"post_id" gorm:"type:uuid;not null"`
+	VisitorHash string    `json:"-" gorm:"type:varchar(64);not null"`
+	WindowStart time.Time `json:"window_start" gorm:"not null"`
+	ViewedAt    time.Time `json:"viewed_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (BlogViewEvent) TableName() string {
+	return "blog_view_events"
+}
+
+type BlogDailyView struct {
+	PostID   uuid.UUID `json:"post_id" gorm:"type:uuid;primaryKey"`
+	ViewDate time.Time `json:"view_date" gorm:"type:date;primaryKey"`
+	Views    int       `json:"views" gorm:"type:integer;default:0"`
+}
+
+func (BlogDailyView) TableName() string {
+	return "blog_post_daily_views"
+}
+
+type DailyViewPoint struct {
+	Date  string `json:"date"` // YYYY-MM-DD
+	Views int    `json:"views"`
+}
+
+type BlogViewStatsResponse struct {
+	PostID     uuid.UUID        `json:"post_id"`
+	TotalViews int              `json:"total_views"`
+	From       string           `json:"from"`
+	To         string           `json:"to"`
+	Series     []DailyViewPoint `json:"series"`
+}
+
+// ============================
+// BLOG COMMENTS MODEL
+// ============================
+
+type BlogComment struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PostID      uuid.UUID  `json:"post_id" gorm:"type:uuid;not null"`
+	ParentID    *uuid.UUID `json:"parent_id" gorm:"type:uuid"`
+	AuthorName  string     `json:"author_name" gorm:"type:varchar(100);not null"`
+	AuthorEmail string     `json:"author_email" gorm:"type:varchar(150);not null"`
+	Content     string     `json:"content" gorm:"type:text;not null"`
+	Status      string     `json:"status" gorm:"type:varchar(20);default:'pending'"` // pending, approved, spam
+	IPAddress   *string    `json:"ip_address" gorm:"type:inet"`
+	UserAgent   string     `json:"user_agent" gorm:"type:text"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (BlogComment) TableName() string {
+	return "blog_comments"
+}
+
+type BlogCommentRequest struct {
+	ParentID    string `json:"parent_id" binding:"omitempty,uuid"`
+	AuthorName  string `json:"author_name" binding:"required,max=100"`
+	AuthorEmail string `json:"author_email" binding:"required,email,max=150"`
+	Content     string `json:"content" binding:"required,max=5000"`
+}
+
+type BlogCommentStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending approved spam"`
+}
+
+// BlogCommentResponse untuk endpoint publik (tanpa email)
+type BlogCommentResponse struct {
+	ID         uuid.UUID             `json:"id"`
+	PostID     uuid.UUID             `json:"post_id"`
+	ParentID   *uuid.UUID            `json:"parent_id"`
+	AuthorName string                `json:"author_name"`
+	Content    string                `json:"content"`
+	Replies    []BlogCommentResponse `json:"replies"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
+
+// BlogCommentAdminResponse untuk antrian moderasi
+type BlogCommentAdminResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	PostID      uuid.UUID  `json:"post_id"`
+	ParentID    *uuid.UUID `json:"parent_id"`
+	AuthorName  string     `json:"author_name"`
+	AuthorEmail string     `json:"author_email"`
+	Content     string     `json:"content"`
+	Status      string     `json:"status"`
+	IPAddress   string     `json:"ip_address"`
+	UserAgent   string     `json:"user_agent"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ============================
+// PREVIEW TOKENS MODEL
+// ============================
+
+const (
+	PreviewResourceBlogPost = "blog_post"
+	PreviewResourceProject  = "project"
+)
+
+type PreviewToken struct {
+	ID           uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TokenHash    string     `json:"-" gorm:"type:varchar(64);unique;not null"`
+	ResourceType string     `json:"resource_type" gorm:"type:varchar(20);not null"`
+	ResourceID   uuid.UUID  `json:"resource_id" gorm:"type:uuid;not null"`
+	Note         string     `json:"note" gorm:"type:varchar(200)"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	LastUsedAt   *time.Time `json:"last_used_at"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (PreviewToken) TableName() string {
+	return "preview_tokens"
+}
+
+type PreviewTokenRequest struct {
+	ResourceType   string `json:"resource_type" binding:"required,oneof=blog_post project"`
+	ResourceID     string `json:"resource_id" binding:"required,uuid"`
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+	Note           string `json:"note" binding:"max=200"`
+}
+
+type PreviewTokenResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Token        string     `json:"token,omitempty"` // hanya diisi saat token dibuat
+	ResourceType string     `json:"resource_type"`
+	ResourceID   uuid.UUID  `json:"resource_id"`
+	Note         string     `json:"note"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	LastUsedAt   *time.Time `json:"last_used_at"`
+	Active       bool       `json:"active"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ============================
+// SEARCH MODEL
+// ============================
+
+const (
+	SearchTypeProject    = "project"
+	SearchTypeBlogPost   = "blog_post"
+	SearchTypeExperience = "experience"
+	SearchTypeSkill      = "skill"
+)
+
+type SearchResult struct {
+	Type    string    `json:"type"`
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Slug    string    `json:"slug,omitempty"`
+	Snippet string    `json:"snippet"` // potongan teks dengan <mark>...</mark>
+	Rank    float64   `json:"rank"`
+}
+
+type SearchFacet struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+	Facets  []SearchFacet  `json:"facets"`
+	Total   int64          `json:"total"`
+	Limit   int            `json:"limit"`
+	Offset  int            `json:"offset"`
+}
+
+// ============================
+// PORTFOLIO SNAPSHOT MODEL
+// ============================
+
+type PortfolioSectionSnapshot struct {
+	SectionID    string      `json:"section_id"`
+	Label        string      `json:"label"`
+	DisplayOrder int         `json:"display_order"`
+	Data         interface{} `json:"data"`
+	Error        string      `json:"error,omitempty"`
+}
+
+type PortfolioSnapshotResponse struct {
+	Sections    []PortfolioSectionSnapshot `json:"sections"`
+	SocialLinks []SocialLinkResponse       `json:"social_links"`
+	Settings    map[string]interface{}     `json:"settings"`
+	Errors      map[string]string          `json:"errors,omitempty"`
+}
+
+// ============================
+// RESUME MODEL
+// ============================
+// Resume adalah gabungan data portfolio yang sudah dilokalkan, siap dirender
+// ke PDF, Markdown atau HTML.
+
+type Resume struct {
+	Name         string              `json:"name"`
+	Headline     string              `json:"headline"`
+	Summary      string              `json:"summary"`
+	Email        string              `json:"email"`
+	Phone        string              `json:"phone"`
+	Location     string              `json:"location"`
+	Links        []ResumeLink        `json:"links"`
+	Experiences  []ResumeExperience  `json:"experiences"`
+	Education    []ResumeEducation   `json:"education"`
+	Skills       []ResumeSkillGroup  `json:"skills"`
+	Certificates []ResumeCertificate `json:"certificates"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+type ResumeLink struct {
+	Platform string `json:"platform"`
+	URL      string `json:"url"`
+}
+
+type ResumeExperience struct {
+	Title      string       `json:"title"`
+	Company    string       `json:"company"`
+	Location   string       `json:"location"`
+	Period     utils.Period `json:"period"`
+	Highlights []string     `json:"highlights"`
+	Skills     []string     `json:"skills"`
+}
+
+type ResumeEducation struct {
+	School       string       `json:"school"`
+	Major        string       `json:"major"`
+	Degree       string       `json:"degree"`
+	Period       utils.Period `json:"period"`
+	Description  string       `json:"description"`
+	Achievements []string     `json:"achievements"`
+}
+
+type ResumeSkillGroup struct {
+	Category string   `json:"category"`
+	Skills   []string `json:"skills"`
+}
+
+type ResumeCertificate struct {
+	Name   string `json:"name"`
+	Issuer string `json:"issuer"`
+	Date   string `json:"date"`
+	URL    string `json:"url"`
+}
+
+type ResumeTemplateResponse struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+// ============================
+// JSON RESUME MODEL
+// ============================
+// Format standar JSON Resume (https://jsonresume.org/schema, v1.0.0) untuk
+// export GET /resume.json dan import admin. Tag binding dipakai saat import;
+// field yang tidak dikirim (string kosong / array null) tidak mengubah data.
+// work.keywords, education.summary dan education.highlights bukan bagian
+// schema resmi, tapi diizinkan karena schema JSON Resume tidak menutup
+// additional properties.
+
+type JSONResume struct {
+	Schema       string                  `json:"$schema,omitempty"`
+	Basics       JSONResumeBasics        `json:"basics"`
+	Work         []JSONResumeWork        `json:"work" binding:"dive"`
+	Education    []JSONResumeEducation   `json:"education" binding:"dive"`
+	Skills       []JSONResumeSkill       `json:"skills" binding:"dive"`
+	Certificates []JSONResumeCertificate `json:"certificates" binding:"dive"`
+	Meta         *JSONResumeMeta         `json:"meta,omitempty"`
+}
+
+type JSONResumeBasics struct {
+	Name     string              `json:"name,omitempty" binding:"max=200"`
+	Label    string              `json:"label,omitempty" binding:"max=200"`
+	Email    string              `json:"email,omitempty" binding:"omitempty,email"`
+	Phone    string              `json:"phone,omitempty" binding:"max=50"`
+	Summary  string              `json:"summary,omitempty"`
+	Location *JSONResumeLocation `json:"location,omitempty"`
+	Profiles []JSONResumeProfile `json:"profiles" binding:"dive"`
+}
+
+type JSONResumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type JSONResumeProfile struct {
+	Network  string `json:"network" binding:"required,max=50"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url" binding:"required,max=500"`
+}
+
+type JSONResumeWork struct {
+	Name       string   `json:"name" binding:"required,max=150"`
+	Position   string   `json:"position" binding:"required,max=200"`
+	Location   string   `json:"location,omitempty" binding:"max=200"`
+	StartDate  string   `json:"startDate" binding:"required"` // YYYY-MM-DD, YYYY-MM atau YYYY
+	EndDate    string   `json:"endDate,omitempty"`            // kosong = masih berjalan
+	Highlights []string `json:"highlights" binding:"omitempty,dive,required"`
+	Keywords   []string `json:"keywords" binding:"omitempty,dive,required,max=100"`
+}
+
+type JSONResumeEducation struct {
+	Institution string   `json:"institution" binding:"required,max=200"`
+	Area        string   `json:"area" binding:"required,max=200"`
+	StudyType   string   `json:"studyType,omitempty" binding:"max=100"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Highlights  []string `json:"highlights" binding:"omitempty,dive,required"`
+}
+
+type JSONResumeSkill struct {
+	Name     string   `json:"name" binding:"required,max=100"`
+	Level    string   `json:"level,omitempty"` // Beginner..Master atau angka 0-100
+	Keywords []string `json:"keywords" binding:"omitempty,dive,required,max=50"`
+}
+
+type JSONResumeCertificate struct {
+	Name   string `json:"name" binding:"required,max=200"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty" binding:"max=150"`
+	URL    string `json:"url,omitempty" binding:"omitempty,httpurl,max=500"`
+}
+
+type JSONResumeMeta struct {
+	Canonical    string `json:"canonical,omitempty"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// ResumeImportReport hasil import (atau dry run) per entry dokumen
+type ResumeImportReport struct {
+	DryRun  bool                         `json:"dry_run"`
+	Summary map[string]ResumeImportCount `json:"summary"`
+	Changes []ResumeImportChange         `json:"changes"`
+}
+
+type ResumeImportCount struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+type ResumeImportChange struct {
+	Entity string                    `json:"entity"` // experience, education, skill, certificate, social_link, setting
+	Action string                    `json:"action"` // create, update, unchanged
+	Key    string                    `json:"key"`
+	ID     *uuid.UUID                `json:"id,omitempty"`
+	Fields []ResumeImportFieldChange `json:"fields,omitempty"`
+}
+
+type ResumeImportFieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
 }
 
 // ============================
@@ -358,7 +923,8 @@ type Section struct {
 	SectionID    string    `json:"section_id" gorm:"type:varchar(50);unique;not null"`
 	Label        string    `json:"label" gorm:"type:varchar(100);not null"`
 	DisplayOrder int       `json:"display_order" gorm:"type:integer;default:0"`
-	IsActive     bool      `json:"is_active" gorm:"type:boolean;default:true"`
+	IsActive     bool      `json:"is_active" gorm:"type:boolean;not null"`
+	Version      int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt    time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt    time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -367,11 +933,12 @@ func (Section) TableName() string {
 	return "portfolio_sections"
 }
 
+// SectionRequest: is_active yang tidak dikirim dianggap true
 type SectionRequest struct {
-	SectionID    string `json:"section_id" binding:"required"`
-	Label        string `json:"label" binding:"required"`
+	SectionID    string `json:"section_id" binding:"required,slug,max=50"`
+	Label        string `json:"label" binding:"required,max=100"`
 	DisplayOrder int    `json:"display_order"`
-	IsActive     bool   `json:"is_active"`
+	IsActive     *bool  `json:"is_active"`
 }
 
 type SectionResponse struct {
@@ -380,10 +947,16 @@ type SectionResponse struct {
 	Label        string    `json:"label"`
 	DisplayOrder int       `json:"display_order"`
 	IsActive     bool      `json:"is_active"`
+	Version      int       `json:"version"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// ActivationRequest untuk PUT /{resource}/:id/active
+type ActivationRequest struct {
+	IsActive *bool `json:"is_active" binding:"required"`
+}
+
 // ============================
 // SOCIAL LINKS MODEL
 // ============================
@@ -394,7 +967,8 @@ type SocialLink struct {
 	URL          string    `json:"url" gorm:"type:varchar(500);not null"`
 	IconName     string    `json:"icon_name" gorm:"type:varchar(50)"`
 	DisplayOrder int       `json:"display_order" gorm:"type:integer;default:0"`
-	IsActive     bool      `json:"is_active" gorm:"type:boolean;default:true"`
+	IsActive     bool      `json:"is_active" gorm:"type:boolean;not null"`
+	Version      int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt    time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt    time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -403,12 +977,14 @@ func (SocialLink) TableName() string {
 	return "portfolio_social_links"
 }
 
+// SocialLinkRequest: is_active yang tidak dikirim dianggap true. URL
+// divalidasi sesuai platform (lihat checkSocialLinkURL).
 type SocialLinkRequest struct {
-	Platform     string `json:"platform" binding:"required"`
-	URL          string `json:"url" binding:"required"`
-	IconName     string `json:"icon_name"`
+	Platform     string `json:"platform" binding:"required,max=50"`
+	URL          string `json:"url" binding:"required,max=500"`
+	IconName     string `json:"icon_name" binding:"max=50"`
 	DisplayOrder int    `json:"display_order"`
-	IsActive     bool   `json:"is_active"`
+	IsActive     *bool  `json:"is_active"`
 }
 
 type SocialLinkResponse struct {
@@ -418,6 +994,7 @@ type SocialLinkResponse struct {
 	IconName     string    `json:"icon_name"`
 	DisplayOrder int       `json:"display_order"`
 	IsActive     bool      `json:"is_active"`
+	Version      int       `json:"version"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -426,12 +1003,24 @@ type SocialLinkResponse struct {
 // SETTINGS MODEL
 // ============================
 
+const (
+	SettingTypeString  = "string"
+	SettingTypeNumber  = "number"
+	SettingTypeBoolean = "boolean"
+	SettingTypeJSON    = "json"
+)
+
+// Setting menyimpan Value dalam bentuk teks ter-normalisasi: string apa
+// adanya, number/boolean/json sebagai literal JSON
 type Setting struct {
 	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	Key         string    `json:"key" gorm:"type:varchar(100);unique;not null"`
 	Value       string    `json:"value" gorm:"type:text"`
-	DataType    string    `json:"data_type" gorm:"type:varchar(20);default:'string'"`
+	DataType    string    `json:"data_type" gorm:"type:varchar(20);not null;default:'string'"` // string, number, boolean, json
 	Description string    `json:"description" gorm:"type:text"`
+	IsPublic    bool      `json:"is_public" gorm:"not null;default:false"`
+	JSONSchema  *string   `json:"json_schema" gorm:"column:json_schema;type:jsonb"`
+	Version     int       `json:"version" gorm:"not null;default:1"`
 	CreatedAt   time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -440,19 +1029,153 @@ func (Setting) TableName() string {
 	return "portfolio_settings"
 }
 
+// SettingRequest untuk POST /settings. Value berupa nilai JSON sesuai
+// data_type (misalnya 12, true, {"a": 1}); string seperti "12" atau "true"
+// juga diterima untuk number/boolean.
 type SettingRequest struct {
-	Key         string `json:"key" binding:"required"`
-	Value       string `json:"value"`
-	DataType    string `json:"data_type"`
-	Description string `json:"description"`
+	Key         string          `json:"key" binding:"required,max=100"`
+	Value       json.RawMessage `json:"value"`
+	DataType    string          `json:"data_type" binding:"omitempty,oneof=string number boolean json"`
+	Description string          `json:"description"`
+	IsPublic    bool            `json:"is_public"`
+	JSONSchema  json.RawMessage `json:"json_schema"`
+}
+
+// SettingUpsertRequest untuk PUT /settings/:key. data_type yang kosong
+// mengikuti setting yang sudah ada (atau string untuk setting baru).
+type SettingUpsertRequest struct {
+	Value       json.RawMessage `json:"value" binding:"required"`
+	DataType    string          `json:"data_type" binding:"omitempty,oneof=string number boolean json"`
+	Description string          `json:"description"`
+	IsPublic    bool            `json:"is_public"`
+	JSONSchema  json.RawMessage `json:"json_schema"`
 }
 
 type SettingResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Key         string    `json:"key"`
-	Value       string    `json:"value"`
-	DataType    string    `json:"data_type"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID       `json:"id"`
+	Key         string          `json:"key"`
+	Value       interface{}     `json:"value"`
+	DataType    string          `json:"data_type"`
+	Description string          `json:"description"`
+	IsPublic    bool            `json:"is_public"`
+	JSONSchema  json.RawMessage `json:"json_schema,omitempty"`
+	Version     int             `json:"version"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// ============================
+// CONTACT MESSAGES MODEL
+// ============================
+
+type ContactMessage struct {
+	ID           uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name         string     `json:"name" gorm:"type:varchar(100);not null"`
+	Email        string     `json:"email" gorm:"type:varchar(150);not null"`
+	Subject      string     `json:"subject" gorm:"type:varchar(200)"`
+	Message      string     `json:"message" gorm:"type:text;not null"`
+	IPAddress    *string    `json:"ip_address" gorm:"type:inet"`
+	UserAgent    string     `json:"user_agent" gorm:"type:text"`
+	Status       string     `json:"status" gorm:"type:varchar(20);default:'unread'"` // unread, read, replied, archived
+	ReplyMessage string     `json:"reply_message" gorm:"type:text"`
+	RepliedAt    *time.Time `json:"replied_at" gorm:"type:timestamptz"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (ContactMessage) TableName() string {
+	return "contact_messages"
+}
+
+type ContactMessageRequest struct {
+	Name    string `json:"name" binding:"required,max=100"`
+	Email   string `json:"email" binding:"required,email,max=150"`
+	Subject string `json:"subject" binding:"omitempty,max=200"`
+	Message string `json:"message" binding:"required,max=5000"`
+}
+
+// Status replied hanya bisa di-set lewat endpoint reply
+type ContactStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=unread read archived"`
+}
+
+type ContactReplyRequest struct {
+	Message string `json:"message" binding:"required,max=10000"`
+}
+
+// ContactSubmissionResponse untuk pengirim publik (tanpa IP dan status inbox)
+type ContactSubmissionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ContactMessageResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Name         string     `json:"name"`
+	Email        string     `json:"email"`
+	Subject      string     `json:"subject"`
+	Message      string     `json:"message"`
+	IPAddress    string     `json:"ip_address"`
+	UserAgent    string     `json:"user_agent"`
+	Status       string     `json:"status"`
+	ReplyMessage string     `json:"reply_message,omitempty"`
+	RepliedAt    *time.Time `json:"replied_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type ContactStatusCount struct {
+	Status string `json:"status"`
+	Total  int64  `json:"total"`
+}
+
+// ============================
+// CONTENT TRANSLATIONS MODEL
+// ============================
+
+type ContentTranslation struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	EntityType string    `json:"entity_type" gorm:"type:varchar(30);not null"`
+	EntityID   uuid.UUID `json:"entity_id" gorm:"type:uuid;not null"`
+	Locale     string    `json:"locale" gorm:"type:varchar(10);not null"`
+	Field      string    `json:"field" gorm:"type:varchar(50);not null"`
+	Value      string    `json:"value" gorm:"type:text;not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (ContentTranslation) TableName() string {
+	return "content_translations"
+}
+
+// TranslationRequest untuk PUT /translations/:entity_type/:entity_id/:locale.
+// Field dengan value kosong menghapus terjemahannya.
+type TranslationRequest struct {
+	Fields map[string]string `json:"fields" binding:"required"`
+}
+
+// TranslationResponse mengelompokkan semua field terjemahan satu entity
+// untuk satu locale
+type TranslationResponse struct {
+	EntityType string            `json:"entity_type"`
+	EntityID   uuid.UUID         `json:"entity_id"`
+	Locale     string            `json:"locale"`
+	Fields     map[string]string `json:"fields"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+type MissingTranslation struct {
+	EntityType    string    `json:"entity_type"`
+	EntityID      uuid.UUID `json:"entity_id"`
+	Label         string    `json:"label"`
+	MissingFields []string  `json:"missing_fields"`
+}
+
+type MissingTranslationReport struct {
+	Locale        string               `json:"locale"`
+	DefaultLocale string               `json:"default_locale"`
+	Checked       int                  `json:"checked"`        // jumlah entity yang diperiksa
+	Incomplete    int                  `json:"incomplete"`     // entity dengan minimal satu field belum diterjemahkan
+	MissingFields int                  `json:"missing_fields"` // total field yang belum diterjemahkan
+	Items         []MissingTranslation `json:"items"`
 }
@@ -1,10 +1,14 @@
 package repo
 
 import (
+	"context"
 	model "gintugas/modules/components/all/models"
+	"gintugas/modules/utils"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ============================
@@ -15,10 +19,13 @@ type SkillRepository interface {
 	Create(skill *model.Skill) error
 	GetByID(id uuid.UUID) (*model.Skill, error)
 	Update(skill *model.Skill) error
-	Delete(id uuid.UUID) error
+	Delete(id uuid.UUID, version int) error
 	GetAll() ([]model.Skill, error)
+	List(q *utils.ListQuery) ([]model.Skill, utils.PageInfo, error)
 	GetFeatured() ([]model.Skill, error)
 	GetByCategory(category string) ([]model.Skill, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) SkillRepository
 }
 
 type skillRepository struct {
@@ -29,6 +36,12 @@ func NewSkillRepository(db *gorm.DB) SkillRepository {
 	return &skillRepository{db: db}
 }
 
+// WithContext mengikat semua query ke ctx, sehingga query ikut dibatalkan
+// saat request selesai atau timeout
+func (r *skillRepository) WithContext(ctx context.Context) SkillRepository {
+	return &skillRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *skillRepository) Create(skill *model.Skill) error {
 	return r.db.Create(skill).Error
 }
@@ -40,11 +53,11 @@ func (r *skillRepository) GetByID(id uuid.UUID) (*model.Skill, error) {
 }
 
 func (r *skillRepository) Update(skill *model.Skill) error {
-	return r.db.Save(skill).Error
+	return utils.SaveVersioned(r.db, skill, &skill.Version)
 }
 
-func (r *skillRepository) Delete(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&model.Skill{}).Error
+func (r *skillRepository) Delete(id uuid.UUID, version int) error {
+	return utils.DeleteVersioned(r.db, &model.Skill{}, id, version)
 }
 
 func (r *skillRepository) GetAll() ([]model.Skill, error) {
@@ -53,6 +66,26 @@ func (r *skillRepository) GetAll() ([]model.Skill, error) {
 	return skills, err
 }
 
+var SkillListSpec = utils.ListSpec{
+	DefaultSort: "display_order,-created_at",
+	Fields: map[string]utils.ListField{
+		"name":          {Column: "name", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"category":      {Column: "category", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"value":         {Column: "value", Type: utils.FieldInt, Sortable: true},
+		"is_featured":   {Column: "is_featured", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *skillRepository) List(q *utils.ListQuery) ([]model.Skill, utils.PageInfo, error) {
+	return utils.FindPage[model.Skill](r.db, q)
+}
+
+func (r *skillRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Skill{}, ids)
+}
+
 func (r *skillRepository) GetFeatured() ([]model.Skill, error) {
 	var skills []model.Skill
 	err := r.db.Where("is_featured = ?", true).Order("display_order ASC").Find(&skills).Error
@@ -73,8 +106,11 @@ type CertificateRepository interface {
 	Create(cert *model.Certificate) error
 	GetByID(id uuid.UUID) (*model.Certificate, error)
 	Update(cert *model.Certificate) error
-	Delete(id uuid.UUID) error
+	Delete(id uuid.UUID, version int) error
 	GetAll() ([]model.Certificate, error)
+	List(q *utils.ListQuery) ([]model.Certificate, utils.PageInfo, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) CertificateRepository
 }
 
 type certificateRepository struct {
@@ -85,6 +121,10 @@ func NewCertificateRepository(db *gorm.DB) CertificateRepository {
 	return &certificateRepository{db: db}
 }
 
+func (r *certificateRepository) WithContext(ctx context.Context) CertificateRepository {
+	return &certificateRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *certificateRepository) Create(cert *model.Certificate) error {
 	return r.db.Create(cert).Error
 }
@@ -96,11 +136,11 @@ func (r *certificateRepository) GetByID(id uuid.UUID) (*model.Certificate, error
 }
 
 func (r *certificateRepository) Update(cert *model.Certificate) error {
-	return r.db.Save(cert).Error
+	return utils.SaveVersioned(r.db, cert, &cert.Version)
 }
 
-func (r *certificateRepository) Delete(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&model.Certificate{}).Error
+func (r *certificateRepository) Delete(id uuid.UUID, version int) error {
+	return utils.DeleteVersioned(r.db, &model.Certificate{}, id, version)
 }
 
 func (r *certificateRepository) GetAll() ([]model.Certificate, error) {
@@ -109,6 +149,25 @@ func (r *certificateRepository) GetAll() ([]model.Certificate, error) {
 	return certs, err
 }
 
+var CertificateListSpec = utils.ListSpec{
+	DefaultSort: "display_order,-created_at",
+	Fields: map[string]utils.ListField{
+		"name":          {Column: "name", Type: utils.FieldText, Sortable: true},
+		"issuer":        {Column: "issuer", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"issue_date":    {Column: "issue_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *certificateRepository) List(q *utils.ListQuery) ([]model.Certificate, utils.PageInfo, error) {
+	return utils.FindPage[model.Certificate](r.db, q)
+}
+
+func (r *certificateRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Certificate{}, ids)
+}
+
 // ============================
 // EDUCATION REPOSITORY
 // ============================
@@ -117,8 +176,11 @@ type EducationRepository interface {
 	CreateWithAchievements(edu *model.Education) error
 	GetByIDWithAchievements(id uuid.UUID) (*model.Education, error)
 	UpdateWithAchievements(edu *model.Education) error
-	DeleteWithAchievements(id uuid.UUID) error
+	DeleteWithAchievements(id uuid.UUID, version int) error
 	GetAllWithAchievements() ([]model.Education, error)
+	ListWithAchievements(q *utils.ListQuery) ([]model.Education, utils.PageInfo, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) EducationRepository
 }
 
 type educationRepository struct {
@@ -129,6 +191,10 @@ func NewEducationRepository(db *gorm.DB) EducationRepository {
 	return &educationRepository{db: db}
 }
 
+func (r *educationRepository) WithContext(ctx context.Context) EducationRepository {
+	return &educationRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *educationRepository) CreateWithAchievements(edu *model.Education) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(edu).Error; err != nil {
@@ -163,7 +229,7 @@ func (r *educationRepository) GetByIDWithAchievements(id uuid.UUID) (*model.Educ
 
 func (r *educationRepository) UpdateWithAchievements(edu *model.Education) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(edu).Error; err != nil {
+		if err := utils.SaveVersioned(tx, edu, &edu.Version); err != nil {
 			return err
 		}
 
@@ -185,12 +251,12 @@ func (r *educationRepository) UpdateWithAchievements(edu *model.Education) error
 	})
 }
 
-func (r *educationRepository) DeleteWithAchievements(id uuid.UUID) error {
+func (r *educationRepository) DeleteWithAchievements(id uuid.UUID, version int) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Where("education_id = ?", id).Delete(&model.EducationAchievement{}).Error; err != nil {
 			return err
 		}
-		return tx.Where("id = ?", id).Delete(&model.Education{}).Error
+		return utils.DeleteVersioned(tx, &model.Education{}, id, version)
 	})
 }
 
@@ -205,6 +271,31 @@ func (r *educationRepository) GetAllWithAchievements() ([]model.Education, error
 	return educations, err
 }
 
+var EducationListSpec = utils.ListSpec{
+	DefaultSort: "display_order,-created_at",
+	Fields: map[string]utils.ListField{
+		"school":        {Column: "school", Type: utils.FieldText, Sortable: true},
+		"degree":        {Column: "degree", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"start_date":    {Column: "start_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"end_date":      {Column: "end_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"is_ongoing":    {Column: "is_ongoing", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *educationRepository) ListWithAchievements(q *utils.ListQuery) ([]model.Education, utils.PageInfo, error) {
+	return utils.FindPage[model.Education](r.db, q, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Achievements", func(db *gorm.DB) *gorm.DB {
+			return db.Order("education_achievements.display_order ASC")
+		})
+	})
+}
+
+func (r *educationRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Education{}, ids)
+}
+
 // ============================
 // TESTIMONIALS REPOSITORY
 // ============================
@@ -213,10 +304,14 @@ type TestimonialRepository interface {
 	Create(test *model.Testimonial) error
 	GetByID(id uuid.UUID) (*model.Testimonial, error)
 	Update(test *model.Testimonial) error
-	Delete(id uuid.UUID) error
+	Delete(id uuid.UUID, version int) error
 	GetAll() ([]model.Testimonial, error)
+	List(q *utils.ListQuery) ([]model.Testimonial, utils.PageInfo, error)
 	GetFeatured() ([]model.Testimonial, error)
 	GetByStatus(status string) ([]model.Testimonial, error)
+	GetByProject(projectID uuid.UUID, status string) ([]model.Testimonial, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) TestimonialRepository
 }
 
 type testimonialRepository struct {
@@ -227,6 +322,10 @@ func NewTestimonialRepository(db *gorm.DB) TestimonialRepository {
 	return &testimonialRepository{db: db}
 }
 
+func (r *testimonialRepository) WithContext(ctx context.Context) TestimonialRepository {
+	return &testimonialRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *testimonialRepository) Create(test *model.Testimonial) error {
 	return r.db.Create(test).Error
 }
@@ -238,11 +337,11 @@ func (r *testimonialRepository) GetByID(id uuid.UUID) (*model.Testimonial, error
 }
 
 func (r *testimonialRepository) Update(test *model.Testimonial) error {
-	return r.db.Save(test).Error
+	return utils.SaveVersioned(r.db, test, &test.Version)
 }
 
-func (r *testimonialRepository) Delete(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&model.Testimonial{}).Error
+func (r *testimonialRepository) Delete(id uuid.UUID, version int) error {
+	return utils.DeleteVersioned(r.db, &model.Testimonial{}, id, version)
 }
 
 func (r *testimonialRepository) GetAll() ([]model.Testimonial, error) {
@@ -251,6 +350,26 @@ func (r *testimonialRepository) GetAll() ([]model.Testimonial, error) {
 	return testimonials, err
 }
 
+var TestimonialListSpec = utils.ListSpec{
+	DefaultSort: "display_order,-created_at",
+	Fields: map[string]utils.ListField{
+		"name":          {Column: "name", Type: utils.FieldText, Sortable: true},
+		"rating":        {Column: "rating", Type: utils.FieldInt, Sortable: true, Filterable: true},
+		"is_featured":   {Column: "is_featured", Type: utils.FieldBool, Filterable: true},
+		"status":        {Column: "status", Type: utils.FieldText, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *testimonialRepository) List(q *utils.ListQuery) ([]model.Testimonial, utils.PageInfo, error) {
+	return utils.FindPage[model.Testimonial](r.db, q)
+}
+
+func (r *testimonialRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Testimonial{}, ids)
+}
+
 func (r *testimonialRepository) GetFeatured() ([]model.Testimonial, error) {
 	var testimonials []model.Testimonial
 	err := r.db.Where("is_featured = ?", true).Order("display_order ASC").Find(&testimonials).Error
@@ -263,6 +382,101 @@ func (r *testimonialRepository) GetByStatus(status string) ([]model.Testimonial,
 	return testimonials, err
 }
 
+// GetByProject mengambil testimoni milik project; status kosong = semua status
+func (r *testimonialRepository) GetByProject(projectID uuid.UUID, status string) ([]model.Testimonial, error) {
+	var testimonials []model.Testimonial
+	query := r.db.Where("project_id = ?", projectID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("display_order ASC, created_at DESC").Find(&testimonials).Error
+	return testimonials, err
+}
+
+// ============================
+// TESTIMONIAL INVITES REPOSITORY
+// ============================
+
+type TestimonialInviteRepository interface {
+	Create(invite *model.TestimonialInvite) error
+	GetAll(projectID *uuid.UUID) ([]model.TestimonialInvite, error)
+	FindActive(tokenHash string) (*model.TestimonialInvite, error)
+	Claim(id uuid.UUID) error
+	Release(id uuid.UUID) error
+	AttachTestimonial(id, testimonialID uuid.UUID) error
+	Revoke(id uuid.UUID) error
+}
+
+type testimonialInviteRepository struct {
+	db *gorm.DB
+}
+
+func NewTestimonialInviteRepository(db *gorm.DB) TestimonialInviteRepository {
+	return &testimonialInviteRepository{db: db}
+}
+
+func (r *testimonialInviteRepository) Create(invite *model.TestimonialInvite) error {
+	return r.db.Create(invite).Error
+}
+
+func (r *testimonialInviteRepository) GetAll(projectID *uuid.UUID) ([]model.TestimonialInvite, error) {
+	var invites []model.TestimonialInvite
+	query := r.db.Order("created_at DESC")
+	if projectID != nil {
+		query = query.Where("project_id = ?", *projectID)
+	}
+	err := query.Find(&invites).Error
+	return invites, err
+}
+
+// FindActive mencari invite yang belum dipakai, belum dicabut dan belum kedaluwarsa
+func (r *testimonialInviteRepository) FindActive(tokenHash string) (*model.TestimonialInvite, error) {
+	var invite model.TestimonialInvite
+	err := r.db.Where("token_hash = ?", tokenHash).
+		Where("used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", time.Now()).
+		First(&invite).Error
+	return &invite, err
+}
+
+// Claim menandai invite terpakai secara atomik. Jika dua submit datang
+// bersamaan, hanya satu yang mendapat RowsAffected = 1.
+func (r *testimonialInviteRepository) Claim(id uuid.UUID) error {
+	result := r.db.Model(&model.TestimonialInvite{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", id, time.Now()).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Release membatalkan Claim saat testimoni gagal disimpan
+func (r *testimonialInviteRepository) Release(id uuid.UUID) error {
+	return r.db.Model(&model.TestimonialInvite{}).
+		Where("id = ? AND testimonial_id IS NULL", id).
+		Update("used_at", nil).Error
+}
+
+func (r *testimonialInviteRepository) AttachTestimonial(id, testimonialID uuid.UUID) error {
+	return r.db.Model(&model.TestimonialInvite{}).Where("id = ?", id).Update("testimonial_id", testimonialID).Error
+}
+
+func (r *testimonialInviteRepository) Revoke(id uuid.UUID) error {
+	result := r.db.Model(&model.TestimonialInvite{}).
+		Where("id = ? AND revoked_at IS NULL AND used_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // ============================
 // BLOG REPOSITORY
 // ============================
@@ -272,15 +486,25 @@ type BlogRepository interface {
 	GetByIDWithTags(id uuid.UUID) (*model.BlogPost, error)
 	GetBySlugWithTags(slug string) (*model.BlogPost, error)
 	UpdateWithTags(post *model.BlogPost) error
-	DeleteWithTags(id uuid.UUID) error
+	DeleteWithTags(id uuid.UUID, version int) error
 	GetAllWithTags() ([]model.BlogPost, error)
 	GetPublishedWithTags() ([]model.BlogPost, error)
-	IncrementViewCount(id uuid.UUID) error
+	ListWithTags(q *utils.ListQuery) ([]model.BlogPost, utils.PageInfo, error)
+	ListPublishedWithTags(q *utils.ListQuery) ([]model.BlogPost, utils.PageInfo, error)
 
 	// Tag operations
 	CreateTag(tag *model.BlogTag) error
 	GetOrCreateTag(name string) (*model.BlogTag, error)
 	GetAllTags() ([]model.BlogTag, error)
+	GetTagByID(id uuid.UUID) (*model.BlogTag, error)
+	GetTagByName(name string) (*model.BlogTag, error)
+	GetTagUsage() ([]model.TagUsage, error)
+	RenameTag(id uuid.UUID, name string) error
+	MergeTags(sourceIDs []uuid.UUID, targetID uuid.UUID) error
+	DeleteTag(id uuid.UUID) error
+	GetPublishedByTag(tagID uuid.UUID) ([]model.BlogPost, error)
+	GetRelatedCandidates(postID uuid.UUID, terms string, limit int) ([]model.BlogPost, error)
+	WithContext(ctx context.Context) BlogRepository
 }
 
 type blogRepository struct {
@@ -291,18 +515,16 @@ func NewBlogRepository(db *gorm.DB) BlogRepository {
 	return &blogRepository{db: db}
 }
 
+func (r *blogRepository) WithContext(ctx context.Context) BlogRepository {
+	return &blogRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *blogRepository) CreateWithTags(post *model.BlogPost) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Handle tags first - get or create
-		var processedTags []model.BlogTag
-		if len(post.Tags) > 0 {
-			for _, tag := range post.Tags {
-				existingTag, err := r.getOrCreateTagTx(tx, tag.Name)
-				if err != nil {
-					return err
-				}
-				processedTags = append(processedTags, *existingTag)
-			}
+		processedTags, err := r.processTagsTx(tx, post.Tags)
+		if err != nil {
+			return err
 		}
 
 		// Replace tags with processed ones
@@ -332,32 +554,29 @@ func (r *blogRepository) GetBySlugWithTags(slug string) (*model.BlogPost, error)
 func (r *blogRepository) UpdateWithTags(post *model.BlogPost) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Handle tags - get or create
-		var processedTags []model.BlogTag
-		if len(post.Tags) > 0 {
-			for _, tag := range post.Tags {
-				existingTag, err := r.getOrCreateTagTx(tx, tag.Name)
-				if err != nil {
-					return err
-				}
-				processedTags = append(processedTags, *existingTag)
-			}
+		processedTags, err := r.processTagsTx(tx, post.Tags)
+		if err != nil {
+			return err
 		}
 
-		// Replace tags with processed ones
-		post.Tags = processedTags
+		// view_count tidak ikut ditulis supaya view yang masuk selama edit tidak hilang
+		if err := utils.SaveVersioned(tx, post, &post.Version, "view_count"); err != nil {
+			return err
+		}
 
-		// Update post and replace associations
-		if err := tx.Session(&gorm.Session{FullSaveAssociations: true}).Save(post).Error; err != nil {
+		// Replace menghapus relasi tag yang tidak ada lagi di daftar baru
+		if err := tx.Model(post).Association("Tags").Replace(processedTags); err != nil {
 			return err
 		}
+		post.Tags = processedTags
 
 		return nil
 	})
 }
 
-func (r *blogRepository) DeleteWithTags(id uuid.UUID) error {
-	// GORM akan otomatis delete associations karena ON DELETE CASCADE
-	return r.db.Select("Tags").Delete(&model.BlogPost{ID: id}).Error
+func (r *blogRepository) DeleteWithTags(id uuid.UUID, version int) error {
+	// Relasi blog_post_tags ikut terhapus lewat ON DELETE CASCADE
+	return utils.DeleteVersioned(r.db, &model.BlogPost{}, id, version)
 }
 
 func (r *blogRepository) GetAllWithTags() ([]model.BlogPost, error) {
@@ -375,10 +594,28 @@ func (r *blogRepository) GetPublishedWithTags() ([]model.BlogPost, error) {
 	return posts, err
 }
 
-func (r *blogRepository) IncrementViewCount(id uuid.UUID) error {
-	return r.db.Model(&model.BlogPost{}).
-		Where("id = ?", id).
-		Update("view_count", gorm.Expr("view_count + 1")).Error
+var BlogListSpec = utils.ListSpec{
+	DefaultSort: "-created_at",
+	Fields: map[string]utils.ListField{
+		"title":        {Column: "title", Type: utils.FieldText, Sortable: true},
+		"status":       {Column: "status", Type: utils.FieldText, Filterable: true},
+		"publish_date": {Column: "publish_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"view_count":   {Column: "view_count", Type: utils.FieldInt, Sortable: true},
+		"created_at":   {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+		"updated_at":   {Column: "updated_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func preloadBlogTags(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags")
+}
+
+func (r *blogRepository) ListWithTags(q *utils.ListQuery) ([]model.BlogPost, utils.PageInfo, error) {
+	return utils.FindPage[model.BlogPost](r.db, q, preloadBlogTags)
+}
+
+func (r *blogRepository) ListPublishedWithTags(q *utils.ListQuery) ([]model.BlogPost, utils.PageInfo, error) {
+	return utils.FindPage[model.BlogPost](r.db.Where("status = ?", "published"), q, preloadBlogTags)
 }
 
 func (r *blogRepository) CreateTag(tag *model.BlogTag) error {
@@ -389,9 +626,33 @@ func (r *blogRepository) GetOrCreateTag(name string) (*model.BlogTag, error) {
 	return r.getOrCreateTagTx(r.db, name)
 }
 
+// processTagsTx mengubah nama tag menjadi tag yang sudah ada (atau baru),
+// sekaligus membuang duplikat seperti "Go" dan "go" dalam satu post
+func (r *blogRepository) processTagsTx(tx *gorm.DB, tags []model.BlogTag) ([]model.BlogTag, error) {
+	var processedTags []model.BlogTag
+	seen := make(map[uuid.UUID]bool)
+	for _, tag := range tags {
+		if model.NormalizeTagName(tag.Name) == "" {
+			continue
+		}
+		existingTag, err := r.getOrCreateTagTx(tx, tag.Name)
+		if err != nil {
+			return nil, err
+		}
+		if seen[existingTag.ID] {
+			continue
+		}
+		seen[existingTag.ID] = true
+		processedTags = append(processedTags, *existingTag)
+	}
+	return processedTags, nil
+}
+
 func (r *blogRepository) getOrCreateTagTx(tx *gorm.DB, name string) (*model.BlogTag, error) {
+	name = model.NormalizeTagName(name)
+
 	var tag model.BlogTag
-	err := tx.Where("name = ?", name).First(&tag).Error
+	err := tx.Where("lower(name) = lower(?)", name).First(&tag).Error
 	if err == gorm.ErrRecordNotFound {
 		tag = model.BlogTag{Name: name}
 		if err := tx.Create(&tag).Error; err != nil {
@@ -408,14 +669,641 @@ func (r *blogRepository) GetAllTags() ([]model.BlogTag, error) {
 	return tags, err
 }
 
+func (r *blogRepository) GetTagByID(id uuid.UUID) (*model.BlogTag, error) {
+	var tag model.BlogTag
+	err := r.db.Where("id = ?", id).First(&tag).Error
+	return &tag, err
+}
+
+func (r *blogRepository) GetTagByName(name string) (*model.BlogTag, error) {
+	var tag model.BlogTag
+	err := r.db.Where("lower(name) = lower(?)", model.NormalizeTagName(name)).First(&tag).Error
+	return &tag, err
+}
+
+func (r *blogRepository) GetTagUsage() ([]model.TagUsage, error) {
+	var usage []model.TagUsage
+	err := r.db.Table("blog_tags t").
+		Select(`t.id, t.name, t.created_at,
+			COUNT(bpt.post_id) AS post_count,
+			COUNT(bpt.post_id) FILTER (WHERE p.status = 'published') AS published_count`).
+		Joins("LEFT JOIN blog_post_tags bpt ON bpt.tag_id = t.id").
+		Joins("LEFT JOIN portfolio_blog_posts p ON p.id = bpt.post_id").
+		Group("t.id, t.name, t.created_at").
+		Order("t.name ASC").
+		Scan(&usage).Error
+	return usage, err
+}
+
+func (r *blogRepository) RenameTag(id uuid.UUID, name string) error {
+	return r.db.Model(&model.BlogTag{}).Where("id = ?", id).Update("name", model.NormalizeTagName(name)).Error
+}
+
+// MergeTags memindahkan semua relasi post dari tag sumber ke tag tujuan
+// lalu menghapus tag sumber
+func (r *blogRepository) MergeTags(sourceIDs []uuid.UUID, targetID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO blog_post_tags (post_id, tag_id)
+			SELECT DISTINCT post_id, ? FROM blog_post_tags WHERE tag_id IN ?
+			ON CONFLICT DO NOTHING
+		`, targetID, sourceIDs).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("tag_id IN ?", sourceIDs).Delete(&model.BlogPostTag{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id IN ?", sourceIDs).Delete(&model.BlogTag{}).Error
+	})
+}
+
+func (r *blogRepository) DeleteTag(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&model.BlogPostTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.BlogTag{}).Error
+	})
+}
+
+func (r *blogRepository) GetPublishedByTag(tagID uuid.UUID) ([]model.BlogPost, error) {
+	var posts []model.BlogPost
+	err := r.db.Preload("Tags").
+		Joins("JOIN blog_post_tags bpt ON bpt.post_id = portfolio_blog_posts.id").
+		Where("bpt.tag_id = ? AND portfolio_blog_posts.status = ?", tagID, "published").
+		Order("publish_date DESC").
+		Find(&posts).Error
+	return posts, err
+}
+
+// relatedCandidatesSQL memilih post published yang berbagi tag ATAU cocok
+// secara teks dengan @terms (kata kunci post sumber, dipisah "or"). Post tanpa
+// tag yang sama tetap bisa masuk lewat search_vector. Urutan memakai bobot
+// yang sama dengan skor akhir di service (0.6 tag, 0.4 teks) supaya batas
+// kandidat tidak selalu didominasi post yang berbagi tag.
+const relatedCandidatesSQL = `
+	WITH q AS (
+		SELECT websearch_to_tsquery('simple', @terms)
+			|| websearch_to_tsquery('indonesian', @terms)
+			|| websearch_to_tsquery('english', @terms) AS tsq
+	),
+	src AS (
+		SELECT COUNT(*) AS tags FROM blog_post_tags WHERE post_id = @post_id
+	),
+	shared AS (
+		SELECT bpt.post_id, COUNT(*) AS shared_tags
+		FROM blog_post_tags bpt
+		JOIN blog_post_tags s ON s.tag_id = bpt.tag_id AND s.post_id = @post_id
+		WHERE bpt.post_id <> @post_id
+		GROUP BY bpt.post_id
+	)
+	SELECT b.id
+	FROM portfolio_blog_posts b
+	CROSS JOIN q
+	CROSS JOIN src
+	LEFT JOIN shared ON shared.post_id = b.id
+	WHERE b.id <> @post_id
+		AND b.status = 'published'
+		AND (shared.post_id IS NOT NULL OR b.search_vector @@ q.tsq)
+	ORDER BY 0.6 * COALESCE(shared.shared_tags, 0)::float / GREATEST(src.tags, 1)
+		+ 0.4 * ts_rank_cd(b.search_vector, q.tsq, 32) DESC,
+		b.publish_date DESC
+	LIMIT @limit
+`
+
+// GetRelatedCandidates mengambil kandidat related post untuk postID; skor
+// akhir tetap dihitung di service
+func (r *blogRepository) GetRelatedCandidates(postID uuid.UUID, terms string, limit int) ([]model.BlogPost, error) {
+	var ids []uuid.UUID
+	err := r.db.Raw(relatedCandidatesSQL, map[string]interface{}{
+		"post_id": postID,
+		"terms":   terms,
+		"limit":   limit,
+	}).Scan(&ids).Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var posts []model.BlogPost
+	err = r.db.Preload("Tags").Where("id IN ?", ids).Find(&posts).Error
+	return posts, err
+}
+
+// ============================
+// BLOG SERIES REPOSITORY
+// ============================
+
+type BlogSeriesRepository interface {
+	Create(series *model.BlogSeries) error
+	GetByID(id uuid.UUID) (*model.BlogSeries, error)
+	Update(series *model.BlogSeries) error
+	Delete(id uuid.UUID, version int) error
+	GetAll() ([]model.BlogSeries, error)
+	SetPosts(seriesID uuid.UUID, postIDs []uuid.UUID) error
+	GetByPostID(postID uuid.UUID) (*model.BlogSeries, error)
+}
+
+type blogSeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogSeriesRepository(db *gorm.DB) BlogSeriesRepository {
+	return &blogSeriesRepository{db: db}
+}
+
+func (r *blogSeriesRepository) preloadPosts(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Posts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("blog_series_posts.position ASC")
+		}).
+		Preload("Posts.Post")
+}
+
+func (r *blogSeriesRepository) Create(series *model.BlogSeries) error {
+	return r.db.Omit("Posts").Create(series).Error
+}
+
+func (r *blogSeriesRepository) GetByID(id uuid.UUID) (*model.BlogSeries, error) {
+	var series model.BlogSeries
+	err := r.preloadPosts(r.db).Where("id = ?", id).First(&series).Error
+	return &series, err
+}
+
+func (r *blogSeriesRepository) Update(series *model.BlogSeries) error {
+	return utils.SaveVersioned(r.db, series, &series.Version)
+}
+
+func (r *blogSeriesRepository) Delete(id uuid.UUID, version int) error {
+	return utils.DeleteVersioned(r.db, &model.BlogSeries{}, id, version)
+}
+
+func (r *blogSeriesRepository) GetAll() ([]model.BlogSeries, error) {
+	var series []model.BlogSeries
+	err := r.preloadPosts(r.db).Order("title ASC").Find(&series).Error
+	return series, err
+}
+
+// SetPosts mengganti seluruh anggota series sesuai urutan postIDs.
+// Post yang sebelumnya ada di series lain dipindahkan ke series ini.
+func (r *blogSeriesRepository) SetPosts(seriesID uuid.UUID, postIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&model.BlogSeriesPost{}).Error; err != nil {
+			return err
+		}
+
+		if len(postIDs) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("post_id IN ?", postIDs).Delete(&model.BlogSeriesPost{}).Error; err != nil {
+			return err
+		}
+
+		members := make([]model.BlogSeriesPost, len(postIDs))
+		for i, postID := range postIDs {
+			members[i] = model.BlogSeriesPost{
+				SeriesID: seriesID,
+				PostID:   postID,
+				Position: i + 1,
+			}
+		}
+		if err := tx.Omit("Post").Create(&members).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.BlogSeries{}).Where("id = ?", seriesID).Update("updated_at", time.Now()).Error
+	})
+}
+
+func (r *blogSeriesRepository) GetByPostID(postID uuid.UUID) (*model.BlogSeries, error) {
+	var member model.BlogSeriesPost
+	if err := r.db.Where("post_id = ?", postID).First(&member).Error; err != nil {
+		return nil, err
+	}
+	return r.GetByID(member.SeriesID)
+}
+
+// ============================
+// BLOG VIEW ANALYTICS REPOSITORY
+// ============================
+
+type BlogViewRepository interface {
+	RecordViews(events []model.BlogViewEvent, loc *time.Location) (map[uuid.UUID]int, error)
+	GetDailyViews(postID uuid.UUID, from, to time.Time) ([]model.BlogDailyView, error)
+}
+
+type blogViewRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogViewRepository(db *gorm.DB) BlogViewRepository {
+	return &blogViewRepository{db: db}
+}
+
+// RecordViews menyimpan event view dan hanya menghitung event yang belum ada
+// (unik per post, visitor dan jendela waktu). Counter view_count dan agregat
+// harian diupdate di transaksi yang sama.
+func (r *blogViewRepository) RecordViews(events []model.BlogViewEvent, loc *time.Location) (map[uuid.UUID]int, error) {
+	counted := make(map[uuid.UUID]int)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		daily := make(map[uuid.UUID]map[string]int)
+
+		for i := range events {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&events[i])
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+
+			postID := events[i].PostID
+			counted[postID]++
+
+			day := events[i].ViewedAt.In(loc).Format("2006-01-02")
+			if daily[postID] == nil {
+				daily[postID] = make(map[string]int)
+			}
+			daily[postID][day]++
+		}
+
+		for postID, count := range counted {
+			if err := tx.Model(&model.BlogPost{}).
+				Where("id = ?", postID).
+				Update("view_count", gorm.Expr("view_count + ?", count)).Error; err != nil {
+				return err
+			}
+		}
+
+		for postID, days := range daily {
+			for day, views := range days {
+				if err := tx.Exec(`
+					INSERT INTO blog_post_daily_views (post_id, view_date, views)
+					VALUES (?, ?, ?)
+					ON CONFLICT (post_id, view_date)
+					DO UPDATE SET views = blog_post_daily_views.views + EXCLUDED.views
+				`, postID, day, views).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return counted, err
+}
+
+func (r *blogViewRepository) GetDailyViews(postID uuid.UUID, from, to time.Time) ([]model.BlogDailyView, error) {
+	var views []model.BlogDailyView
+	err := r.db.
+		Where("post_id = ? AND view_date BETWEEN ? AND ?", postID, from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Order("view_date ASC").
+		Find(&views).Error
+	return views, err
+}
+
+// ============================
+// BLOG COMMENTS REPOSITORY
+// ============================
+
+type BlogCommentRepository interface {
+	Create(comment *model.BlogComment) error
+	GetByID(id uuid.UUID) (*model.BlogComment, error)
+	UpdateStatus(id uuid.UUID, status string) error
+	Delete(id uuid.UUID) error
+	GetApprovedByPost(postID uuid.UUID) ([]model.BlogComment, error)
+	GetByStatus(status string) ([]model.BlogComment, error)
+}
+
+type blogCommentRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogCommentRepository(db *gorm.DB) BlogCommentRepository {
+	return &blogCommentRepository{db: db}
+}
+
+func (r *blogCommentRepository) Create(comment *model.BlogComment) error {
+	return r.db.Create(comment).Error
+}
+
+func (r *blogCommentRepository) GetByID(id uuid.UUID) (*model.BlogComment, error) {
+	var comment model.BlogComment
+	err := r.db.Where("id = ?", id).First(&comment).Error
+	return &comment, err
+}
+
+func (r *blogCommentRepository) UpdateStatus(id uuid.UUID, status string) error {
+	result := r.db.Model(&model.BlogComment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *blogCommentRepository) Delete(id uuid.UUID) error {
+	result := r.db.Where("id = ?", id).Delete(&model.BlogComment{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *blogCommentRepository) GetApprovedByPost(postID uuid.UUID) ([]model.BlogComment, error) {
+	var comments []model.BlogComment
+	err := r.db.Where("post_id = ? AND status = ?", postID, "approved").
+		Order("created_at ASC").
+		Find(&comments).Error
+	return comments, err
+}
+
+func (r *blogCommentRepository) GetByStatus(status string) ([]model.BlogComment, error) {
+	var comments []model.BlogComment
+	err := r.db.Where("status = ?", status).Order("created_at ASC").Find(&comments).Error
+	return comments, err
+}
+
+// ============================
+// CONTACT MESSAGES REPOSITORY
+// ============================
+
+type ContactMessageRepository interface {
+	Create(message *model.ContactMessage) error
+	GetByID(id uuid.UUID) (*model.ContactMessage, error)
+	List(q *utils.ListQuery) ([]model.ContactMessage, utils.PageInfo, error)
+	CountByStatus() ([]model.ContactStatusCount, error)
+	UpdateStatus(id uuid.UUID, status string) error
+	SaveReply(id uuid.UUID, reply string, repliedAt time.Time) error
+	Delete(id uuid.UUID) error
+}
+
+type contactMessageRepository struct {
+	db *gorm.DB
+}
+
+func NewContactMessageRepository(db *gorm.DB) ContactMessageRepository {
+	return &contactMessageRepository{db: db}
+}
+
+func (r *contactMessageRepository) Create(message *model.ContactMessage) error {
+	return r.db.Create(message).Error
+}
+
+func (r *contactMessageRepository) GetByID(id uuid.UUID) (*model.ContactMessage, error) {
+	var message model.ContactMessage
+	err := r.db.Where("id = ?", id).First(&message).Error
+	return &message, err
+}
+
+var ContactMessageListSpec = utils.ListSpec{
+	DefaultSort: "-created_at",
+	Fields: map[string]utils.ListField{
+		"status":     {Column: "status", Type: utils.FieldText, Filterable: true},
+		"email":      {Column: "email", Type: utils.FieldText, Filterable: true},
+		"name":       {Column: "name", Type: utils.FieldText, Sortable: true},
+		"created_at": {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+		"replied_at": {Column: "replied_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *contactMessageRepository) List(q *utils.ListQuery) ([]model.ContactMessage, utils.PageInfo, error) {
+	return utils.FindPage[model.ContactMessage](r.db, q)
+}
+
+func (r *contactMessageRepository) CountByStatus() ([]model.ContactStatusCount, error) {
+	var counts []model.ContactStatusCount
+	err := r.db.Model(&model.ContactMessage{}).
+		Select("status, COUNT(*) AS total").
+		Group("status").
+		Order("status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *contactMessageRepository) UpdateStatus(id uuid.UUID, status string) error {
+	result := r.db.Model(&model.ContactMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *contactMessageRepository) SaveReply(id uuid.UUID, reply string, repliedAt time.Time) error {
+	result := r.db.Model(&model.ContactMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":        "replied",
+			"reply_message": reply,
+			"replied_at":    repliedAt,
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *contactMessageRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.ContactMessage{}).Error
+}
+
+// ============================
+// PREVIEW TOKENS REPOSITORY
+// ============================
+
+type PreviewTokenRepository interface {
+	Create(token *model.PreviewToken) error
+	GetByID(id uuid.UUID) (*model.PreviewToken, error)
+	GetAll(resourceType string, resourceID *uuid.UUID) ([]model.PreviewToken, error)
+	FindActive(tokenHash, resourceType string, resourceID uuid.UUID) (*model.PreviewToken, error)
+	MarkUsed(id uuid.UUID) error
+	Revoke(id uuid.UUID) error
+}
+
+type previewTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewPreviewTokenRepository(db *gorm.DB) PreviewTokenRepository {
+	return &previewTokenRepository{db: db}
+}
+
+func (r *previewTokenRepository) Create(token *model.PreviewToken) error {
+	return r.db.Create(token).Error
+}
+
+func (r *previewTokenRepository) GetByID(id uuid.UUID) (*model.PreviewToken, error) {
+	var token model.PreviewToken
+	err := r.db.Where("id = ?", id).First(&token).Error
+	return &token, err
+}
+
+func (r *previewTokenRepository) GetAll(resourceType string, resourceID *uuid.UUID) ([]model.PreviewToken, error) {
+	var tokens []model.PreviewToken
+	query := r.db.Order("created_at DESC")
+	if resourceType != "" {
+		query = query.Where("resource_type = ?", resourceType)
+	}
+	if resourceID != nil {
+		query = query.Where("resource_id = ?", *resourceID)
+	}
+	err := query.Find(&tokens).Error
+	return tokens, err
+}
+
+// FindActive mencari token yang belum dicabut dan belum kedaluwarsa
+// untuk resource tertentu
+func (r *previewTokenRepository) FindActive(tokenHash, resourceType string, resourceID uuid.UUID) (*model.PreviewToken, error) {
+	var token model.PreviewToken
+	err := r.db.Where("token_hash = ? AND resource_type = ? AND resource_id = ?", tokenHash, resourceType, resourceID).
+		Where("revoked_at IS NULL AND expires_at > ?", time.Now()).
+		First(&token).Error
+	return &token, err
+}
+
+func (r *previewTokenRepository) MarkUsed(id uuid.UUID) error {
+	return r.db.Model(&model.PreviewToken{}).Where("id = ?", id).Update("last_used_at", time.Now()).Error
+}
+
+func (r *previewTokenRepository) Revoke(id uuid.UUID) error {
+	result := r.db.Model(&model.PreviewToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ============================
+// SEARCH REPOSITORY
+// ============================
+
+type SearchRepository interface {
+	Search(query string, types []string, includeDrafts bool, limit, offset int) ([]model.SearchResult, []model.SearchFacet, error)
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// searchHitsSQL menggabungkan semua tabel yang bisa dicari. Query user diparse
+// dengan tiga konfigurasi sekaligus (simple, indonesian, english) lalu di-OR.
+const searchHitsSQL = `
+	WITH q AS (
+		SELECT websearch_to_tsquery('simple', @query)
+			|| websearch_to_tsquery('indonesian', @query)
+			|| websearch_to_tsquery('english', @query) AS tsq
+	),
+	hits AS (
+		SELECT 'project' AS type, p.id, p.title, '' AS slug, coalesce(p.description, '') AS body,
+		       ts_rank_cd(p.search_vector, q.tsq) AS rank
+		FROM portfolio_projects p, q
+		WHERE p.search_vector @@ q.tsq AND (@include_drafts OR p.status = 'published')
+		UNION ALL
+		SELECT 'blog_post', b.id, b.title, b.slug, coalesce(nullif(b.excerpt, ''), b.content, ''),
+		       ts_rank_cd(b.search_vector, q.tsq)
+		FROM portfolio_blog_posts b, q
+		WHERE b.search_vector @@ q.tsq AND (@include_drafts OR b.status = 'published')
+		UNION ALL
+		SELECT 'experience', e.id, e.title, '', e.company || ' ' || coalesce(e.location, ''),
+		       ts_rank_cd(e.search_vector, q.tsq)
+		FROM portfolio_experiences e, q
+		WHERE e.search_vector @@ q.tsq
+		UNION ALL
+		SELECT 'skill', s.id, s.name, '', coalesce(s.category, ''),
+		       ts_rank_cd(s.search_vector, q.tsq)
+		FROM portfolio_skills s, q
+		WHERE s.search_vector @@ q.tsq
+	)
+`
+
+func (r *searchRepository) Search(query string, types []string, includeDrafts bool, limit, offset int) ([]model.SearchResult, []model.SearchFacet, error) {
+	args := map[string]interface{}{
+		"query":          query,
+		"include_drafts": includeDrafts,
+		"types":          types,
+		"limit":          limit,
+		"offset":         offset,
+	}
+
+	// Facet dihitung dari semua hasil, tidak terpengaruh filter type
+	var facets []model.SearchFacet
+	err := r.db.Raw(searchHitsSQL+`
+		SELECT type, COUNT(*) AS count FROM hits GROUP BY type ORDER BY type
+	`, args).Scan(&facets).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typeFilter := ""
+	if len(types) > 0 {
+		typeFilter = "WHERE type IN @types"
+	}
+
+	// Highlight hanya dibuat untuk baris di halaman ini karena ts_headline mahal
+	var results []model.SearchResult
+	err = r.db.Raw(searchHitsSQL+`
+		SELECT h.type, h.id, h.title, h.slug, h.rank,
+		       ts_headline('simple', h.body, q.tsq,
+		           'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2') AS snippet
+		FROM (
+			SELECT * FROM hits `+typeFilter+`
+			ORDER BY rank DESC, title ASC
+			LIMIT @limit OFFSET @offset
+		) h, q
+		ORDER BY h.rank DESC, h.title ASC
+	`, args).Scan(&results).Error
+
+	return results, facets, err
+}
+
 // ============================
 // SECTIONS REPOSITORY
 // ============================
 
 type SectionRepository interface {
 	Create(section *model.Section) error
+	GetByID(id uuid.UUID) (*model.Section, error)
+	Update(section *model.Section) error
 	Delete(id uuid.UUID) error
 	GetAll() ([]model.Section, error)
+	List(q *utils.ListQuery) ([]model.Section, utils.PageInfo, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) SectionRepository
 }
 
 type sectionRepository struct {
@@ -426,10 +1314,24 @@ func NewSectionRepository(db *gorm.DB) SectionRepository {
 	return &sectionRepository{db: db}
 }
 
+func (r *sectionRepository) WithContext(ctx context.Context) SectionRepository {
+	return &sectionRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *sectionRepository) Create(section *model.Section) error {
 	return r.db.Create(section).Error
 }
 
+func (r *sectionRepository) GetByID(id uuid.UUID) (*model.Section, error) {
+	var section model.Section
+	err := r.db.Where("id = ?", id).First(&section).Error
+	return &section, err
+}
+
+func (r *sectionRepository) Update(section *model.Section) error {
+	return utils.SaveVersioned(r.db, section, &section.Version)
+}
+
 func (r *sectionRepository) Delete(id uuid.UUID) error {
 	return r.db.Where("id = ?", id).Delete(&model.Section{}).Error
 }
@@ -440,14 +1342,38 @@ func (r *sectionRepository) GetAll() ([]model.Section, error) {
 	return sections, err
 }
 
+var SectionListSpec = utils.ListSpec{
+	DefaultSort: "display_order",
+	Fields: map[string]utils.ListField{
+		"section_id":    {Column: "section_id", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"label":         {Column: "label", Type: utils.FieldText, Sortable: true},
+		"is_active":     {Column: "is_active", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *sectionRepository) List(q *utils.ListQuery) ([]model.Section, utils.PageInfo, error) {
+	return utils.FindPage[model.Section](r.db, q)
+}
+
+func (r *sectionRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Section{}, ids)
+}
+
 // ============================
 // SOCIAL LINKS REPOSITORY
 // ============================
 
 type SocialLinkRepository interface {
 	Create(link *model.SocialLink) error
+	GetByID(id uuid.UUID) (*model.SocialLink, error)
+	Update(link *model.SocialLink) error
 	Delete(id uuid.UUID) error
 	GetAll() ([]model.SocialLink, error)
+	List(q *utils.ListQuery) ([]model.SocialLink, utils.PageInfo, error)
+	Reorder(ids []uuid.UUID) error
+	WithContext(ctx context.Context) SocialLinkRepository
 }
 
 type socialLinkRepository struct {
@@ -458,10 +1384,24 @@ func NewSocialLinkRepository(db *gorm.DB) SocialLinkRepository {
 	return &socialLinkRepository{db: db}
 }
 
+func (r *socialLinkRepository) WithContext(ctx context.Context) SocialLinkRepository {
+	return &socialLinkRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *socialLinkRepository) Create(link *model.SocialLink) error {
 	return r.db.Create(link).Error
 }
 
+func (r *socialLinkRepository) GetByID(id uuid.UUID) (*model.SocialLink, error) {
+	var link model.SocialLink
+	err := r.db.Where("id = ?", id).First(&link).Error
+	return &link, err
+}
+
+func (r *socialLinkRepository) Update(link *model.SocialLink) error {
+	return utils.SaveVersioned(r.db, link, &link.Version)
+}
+
 func (r *socialLinkRepository) Delete(id uuid.UUID) error {
 	return r.db.Where("id = ?", id).Delete(&model.SocialLink{}).Error
 }
@@ -472,14 +1412,35 @@ func (r *socialLinkRepository) GetAll() ([]model.SocialLink, error) {
 	return links, err
 }
 
+var SocialLinkListSpec = utils.ListSpec{
+	DefaultSort: "display_order",
+	Fields: map[string]utils.ListField{
+		"platform":      {Column: "platform", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"is_active":     {Column: "is_active", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *socialLinkRepository) List(q *utils.ListQuery) ([]model.SocialLink, utils.PageInfo, error) {
+	return utils.FindPage[model.SocialLink](r.db, q)
+}
+
+func (r *socialLinkRepository) Reorder(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.SocialLink{}, ids)
+}
+
 // ============================
 // SETTINGS REPOSITORY
 // ============================
 
 type SettingRepository interface {
 	Create(setting *model.Setting) error
-	Delete(id uuid.UUID) error
+	GetByKey(key string) (*model.Setting, error)
+	Update(setting *model.Setting) error
+	Delete(key string) error
 	GetAll() ([]model.Setting, error)
+	WithContext(ctx context.Context) SettingRepository
 }
 
 type settingRepository struct {
@@ -490,12 +1451,33 @@ func NewSettingRepository(db *gorm.DB) SettingRepository {
 	return &settingRepository{db: db}
 }
 
+func (r *settingRepository) WithContext(ctx context.Context) SettingRepository {
+	return &settingRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *settingRepository) Create(setting *model.Setting) error {
 	return r.db.Create(setting).Error
 }
 
-func (r *settingRepository) Delete(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&model.Setting{}).Error
+func (r *settingRepository) GetByKey(key string) (*model.Setting, error) {
+	var setting model.Setting
+	err := r.db.Where("key = ?", key).First(&setting).Error
+	return &setting, err
+}
+
+func (r *settingRepository) Update(setting *model.Setting) error {
+	return utils.SaveVersioned(r.db, setting, &setting.Version)
+}
+
+func (r *settingRepository) Delete(key string) error {
+	result := r.db.Where("key = ?", key).Delete(&model.Setting{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
 func (r *settingRepository) GetAll() ([]model.Setting, error) {
@@ -503,3 +1485,108 @@ func (r *settingRepository) GetAll() ([]model.Setting, error) {
 	err := r.db.Order("key ASC").Find(&settings).Error
 	return settings, err
 }
+
+// ============================
+// CONTENT TRANSLATIONS REPOSITORY
+// ============================
+
+type TranslationFilter struct {
+	EntityType string
+	EntityID   *uuid.UUID
+	Locale     string
+}
+
+type TranslationRepository interface {
+	GetByLocale(entityType, locale string) ([]model.ContentTranslation, error)
+	List(filter TranslationFilter) ([]model.ContentTranslation, error)
+	Save(entityType string, entityID uuid.UUID, locale string, values map[string]string, removed []string) error
+	Delete(entityType string, entityID uuid.UUID, locale string) error
+	EntityExists(table string, id uuid.UUID) (bool, error)
+	SourceRows(table string, columns []string) ([]map[string]interface{}, error)
+}
+
+type translationRepository struct {
+	db *gorm.DB
+}
+
+func NewTranslationRepository(db *gorm.DB) TranslationRepository {
+	return &translationRepository{db: db}
+}
+
+func (r *translationRepository) GetByLocale(entityType, locale string) ([]model.ContentTranslation, error) {
+	var translations []model.ContentTranslation
+	err := r.db.Where("entity_type = ? AND locale = ?", entityType, locale).Find(&translations).Error
+	return translations, err
+}
+
+func (r *translationRepository) List(filter TranslationFilter) ([]model.ContentTranslation, error) {
+	var translations []model.ContentTranslation
+	query := r.db.Order("entity_type ASC, entity_id ASC, locale ASC, field ASC")
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		query = query.Where("entity_id = ?", *filter.EntityID)
+	}
+	if filter.Locale != "" {
+		query = query.Where("locale = ?", filter.Locale)
+	}
+	err := query.Find(&translations).Error
+	return translations, err
+}
+
+// Save meng-upsert field di values dan menghapus field di removed dalam satu
+// transaksi
+func (r *translationRepository) Save(entityType string, entityID uuid.UUID, locale string, values map[string]string, removed []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for field, value := range values {
+			translation := model.ContentTranslation{
+				EntityType: entityType,
+				EntityID:   entityID,
+				Locale:     locale,
+				Field:      field,
+				Value:      value,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "locale"}, {Name: "field"}},
+				DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+			}).Create(&translation).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(removed) > 0 {
+			err := tx.Where("entity_type = ? AND entity_id = ? AND locale = ? AND field IN ?", entityType, entityID, locale, removed).
+				Delete(&model.ContentTranslation{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *translationRepository) Delete(entityType string, entityID uuid.UUID, locale string) error {
+	return r.db.Where("entity_type = ? AND entity_id = ? AND locale = ?", entityType, entityID, locale).
+		Delete(&model.ContentTranslation{}).Error
+}
+
+// EntityExists mengecek entity di tabel sumbernya. Nama tabel selalu berasal
+// dari registry di service, bukan dari input request.
+func (r *translationRepository) EntityExists(table string, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Table(table).Where("id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
+// SourceRows mengambil id dan kolom konten dasar untuk laporan terjemahan
+// yang belum lengkap
+func (r *translationRepository) SourceRows(table string, columns []string) ([]map[string]interface{}, error) {
+	var rows []map[string]interface{}
+	err := r.db.Table(table).Select(append([]string{"CAST(id AS TEXT) AS id"}, columns...)).Order("created_at ASC").Find(&rows).Error
+	return rows, err
+}
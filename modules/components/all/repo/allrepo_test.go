@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// dryRunDB membuat koneksi gorm yang hanya menyusun SQL tanpa menjalankannya
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	sqlDB, _ := sql.Open("postgres", "host=127.0.0.1 port=1 sslmode=disable")
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+	return db
+}
+
+func TestGetRelatedCandidatesDoesNotRequireSharedTags(t *testing.T) {
+	db := dryRunDB(t)
+
+	var statement string
+	var vars []interface{}
+	db.Callback().Row().After("gorm:row").Register("test:capture", func(tx *gorm.DB) {
+		statement, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+	})
+
+	postID := uuid.New()
+	_, err := NewBlogRepository(db).GetRelatedCandidates(postID, "postgresql or index", 20)
+	if err != nil && !errors.Is(err, gorm.ErrDryRunModeUnsupported) {
+		t.Fatalf("GetRelatedCandidates: %v", err)
+	}
+
+	// Post tanpa tag yang sama harus tetap bisa lolos lewat kecocokan teks
+	for _, want := range []string{
+		"LEFT JOIN shared ON shared.post_id = b.id",
+		"shared.post_id IS NOT NULL OR b.search_vector @@ q.tsq",
+		"ts_rank_cd(b.search_vector, q.tsq, 32)",
+	} {
+		if !strings.Contains(statement, want) {
+			t.Fatalf("candidate query does not contain %q:\n%s", want, statement)
+		}
+	}
+
+	foundTerms := false
+	for _, v := range vars {
+		if v == "postgresql or index" {
+			foundTerms = true
+		}
+	}
+	if !foundTerms {
+		t.Fatalf("search terms are not bound, vars = %v", vars)
+	}
+}
@@ -0,0 +1,699 @@
+package repo
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/utils"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+// ============================
+// READ CACHE
+// ============================
+// Cache in-process untuk data portfolio yang jarang berubah. Setiap repository
+// punya cache sendiri (TTL + batas jumlah entry, LRU), miss yang bersamaan
+// digabung dengan singleflight, dan semua Create/Update/Delete lewat wrapper
+// yang sama langsung mengosongkan cache repository tersebut.
+//
+// Caller selalu menerima deep copy (lihat deepCopy), jadi hasil repository
+// boleh diubah tanpa mengotori cache.
+//
+// Cache ini per instance: instance lain baru melihat perubahan setelah TTL habis.
+
+const (
+	defaultReadCacheTTL        = 60 * time.Second
+	defaultReadCacheMaxEntries = 256
+)
+
+type ReadCacheConfig struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// ReadCacheConfigFromEnv membaca READ_CACHE_TTL_SECONDS dan READ_CACHE_MAX_ENTRIES
+func ReadCacheConfigFromEnv() ReadCacheConfig {
+	config := ReadCacheConfig{TTL: defaultReadCacheTTL, MaxEntries: defaultReadCacheMaxEntries}
+	if seconds, err := strconv.Atoi(os.Getenv("READ_CACHE_TTL_SECONDS")); err == nil && seconds > 0 {
+		config.TTL = time.Duration(seconds) * time.Second
+	}
+	if entries, err := strconv.Atoi(os.Getenv("READ_CACHE_MAX_ENTRIES")); err == nil && entries > 0 {
+		config.MaxEntries = entries
+	}
+	return config
+}
+
+type ReadCacheStats struct {
+	Name          string `json:"name"`
+	Entries       int    `json:"entries"`
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Evictions     uint64 `json:"evictions"`
+	Invalidations uint64 `json:"invalidations"`
+}
+
+type readCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+type readCache struct {
+	name   string
+	config ReadCacheConfig
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	order      *list.List // depan = paling baru dipakai
+	generation uint64
+	group      singleflight.Group
+
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	evictions     atomic.Uint64
+	invalidations atomic.Uint64
+}
+
+var (
+	readCachesMu sync.Mutex
+	readCaches   []*readCache
+)
+
+func newReadCache(name string, config ReadCacheConfig) *readCache {
+	c := &readCache{
+		name:    name,
+		config:  config,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+
+	readCachesMu.Lock()
+	readCaches = append(readCaches, c)
+	readCachesMu.Unlock()
+
+	return c
+}
+
+// GetReadCacheStats mengembalikan metrik hit/miss semua read cache
+func GetReadCacheStats() []ReadCacheStats {
+	readCachesMu.Lock()
+	caches := append([]*readCache(nil), readCaches...)
+	readCachesMu.Unlock()
+
+	stats := make([]ReadCacheStats, 0, len(caches))
+	for _, c := range caches {
+		stats = append(stats, c.stats())
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+// InvalidateReadCaches mengosongkan semua read cache. Dipakai setelah write
+// yang melewati repo tanpa cache, misalnya repo di dalam transaksi.
+func InvalidateReadCaches() {
+	readCachesMu.Lock()
+	caches := append([]*readCache(nil), readCaches...)
+	readCachesMu.Unlock()
+
+	for _, c := range caches {
+		c.invalidate()
+	}
+}
+
+func (c *readCache) stats() ReadCacheStats {
+	c.mu.Lock()
+	entries := len(c.entries)
+	c.mu.Unlock()
+
+	return ReadCacheStats{
+		Name:          c.name,
+		Entries:       entries,
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Evictions:     c.evictions.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
+
+func (c *readCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*readCacheEntry)
+		if time.Now().Before(entry.expiresAt) {
+			c.order.MoveToFront(elem)
+			c.mu.Unlock()
+			c.hits.Add(1)
+			return entry.value, nil
+		}
+		c.removeLocked(elem)
+	}
+	generation := c.generation
+	c.mu.Unlock()
+
+	c.misses.Add(1)
+
+	// Generation ikut di key singleflight supaya request setelah invalidasi
+	// tidak menumpang ke query lama
+	value, err, _ := c.group.Do(fmt.Sprintf("%d:%s", generation, key), func() (interface{}, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, value, generation)
+		return value, nil
+	})
+	return value, err
+}
+
+func (c *readCache) store(key string, value interface{}, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Data dimuat sebelum ada write, jangan disimpan
+	if generation != c.generation {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeLocked(elem)
+	}
+
+	c.entries[key] = c.order.PushFront(&readCacheEntry{
+		key:       key,
+		value:     value,
+		expiresAt: time.Now().Add(c.config.TTL),
+	})
+
+	for len(c.entries) > c.config.MaxEntries {
+		c.removeLocked(c.order.Back())
+		c.evictions.Add(1)
+	}
+}
+
+func (c *readCache) removeLocked(elem *list.Element) {
+	entry := elem.Value.(*readCacheEntry)
+	delete(c.entries, entry.key)
+	c.order.Remove(elem)
+}
+
+func (c *readCache) invalidate() {
+	c.mu.Lock()
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+	c.generation++
+	c.mu.Unlock()
+
+	c.invalidations.Add(1)
+}
+
+// cachedSlice mengembalikan deep copy slice supaya caller tidak mengubah isi cache
+func cachedSlice[T any](c *readCache, key string, load func() ([]T, error)) ([]T, error) {
+	value, err := c.get(key, func() (interface{}, error) { return load() })
+	if err != nil {
+		return nil, err
+	}
+	return deepCopy(value.([]T)), nil
+}
+
+// cachedItem mengembalikan pointer ke deep copy item
+func cachedItem[T any](c *readCache, key string, load func() (*T, error)) (*T, error) {
+	value, err := c.get(key, func() (interface{}, error) { return load() })
+	if err != nil {
+		return nil, err
+	}
+	return deepCopy(value.(*T)), nil
+}
+
+// deepCopy menyalin value beserta slice, map dan pointer di dalamnya
+// (misalnya Education.Achievements, BlogPost.Tags, StartDate), sehingga
+// service bebas mengubah hasil repository tanpa mengotori cache.
+func deepCopy[T any](value T) T {
+	return cloneValue(reflect.ValueOf(&value).Elem()).Interface().(T)
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.New(v.Type().Elem())
+		clone.Elem().Set(cloneValue(v.Elem()))
+		return clone
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(clone, v)
+		if needsClone(v.Type().Elem()) {
+			for i := 0; i < v.Len(); i++ {
+				clone.Index(i).Set(cloneValue(v.Index(i)))
+			}
+		}
+		return clone
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return clone
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.New(v.Type()).Elem()
+		clone.Set(cloneValue(v.Elem()))
+		return clone
+	case reflect.Struct:
+		// Salinan dangkal dulu (termasuk field unexported seperti di time.Time),
+		// lalu field exported yang berisi referensi disalin ulang
+		clone := reflect.New(v.Type()).Elem()
+		clone.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if field := clone.Field(i); field.CanSet() && needsClone(field.Type()) {
+				field.Set(cloneValue(v.Field(i)))
+			}
+		}
+		return clone
+	}
+	return v
+}
+
+func needsClone(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface, reflect.Struct:
+		return true
+	}
+	return false
+}
+
+type cachedPage[T any] struct {
+	items []T
+	page  utils.PageInfo
+}
+
+func cachedList[T any](c *readCache, q *utils.ListQuery, load func(*utils.ListQuery) ([]T, utils.PageInfo, error)) ([]T, utils.PageInfo, error) {
+	value, err := c.get("list:"+q.CacheKey(), func() (interface{}, error) {
+		items, page, err := load(q)
+		if err != nil {
+			return nil, err
+		}
+		return cachedPage[T]{items: items, page: page}, nil
+	})
+	if err != nil {
+		return nil, utils.PageInfo{}, err
+	}
+	result := value.(cachedPage[T])
+	return append([]T{}, deepCopy(result.items)...), result.page, nil
+}
+
+// invalidateAfter menjalankan write lalu mengosongkan cache (juga saat gagal,
+// karena write yang gagal di tengah transaksi tetap bisa mengubah data)
+func invalidateAfter(c *readCache, write func() error) error {
+	defer c.invalidate()
+	return write()
+}
+
+// ============================
+// CACHED SKILLS REPOSITORY
+// ============================
+
+type cachedSkillRepository struct {
+	next  SkillRepository
+	cache *readCache
+}
+
+func NewCachedSkillRepository(next SkillRepository, config ReadCacheConfig) SkillRepository {
+	return &cachedSkillRepository{next: next, cache: newReadCache("skills", config)}
+}
+
+// WithContext memakai cache yang sama; hanya query saat miss yang terikat ctx
+func (r *cachedSkillRepository) WithContext(ctx context.Context) SkillRepository {
+	return &cachedSkillRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedSkillRepository) Create(skill *model.Skill) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(skill) })
+}
+
+func (r *cachedSkillRepository) GetByID(id uuid.UUID) (*model.Skill, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.Skill, error) { return r.next.GetByID(id) })
+}
+
+func (r *cachedSkillRepository) Update(skill *model.Skill) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(skill) })
+}
+
+func (r *cachedSkillRepository) Delete(id uuid.UUID, version int) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(id, version) })
+}
+
+func (r *cachedSkillRepository) GetAll() ([]model.Skill, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+func (r *cachedSkillRepository) List(q *utils.ListQuery) ([]model.Skill, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.List)
+}
+
+func (r *cachedSkillRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+func (r *cachedSkillRepository) GetFeatured() ([]model.Skill, error) {
+	return cachedSlice(r.cache, "featured", r.next.GetFeatured)
+}
+
+func (r *cachedSkillRepository) GetByCategory(category string) ([]model.Skill, error) {
+	return cachedSlice(r.cache, "category:"+category, func() ([]model.Skill, error) { return r.next.GetByCategory(category) })
+}
+
+// ============================
+// CACHED CERTIFICATES REPOSITORY
+// ============================
+
+type cachedCertificateRepository struct {
+	next  CertificateRepository
+	cache *readCache
+}
+
+func NewCachedCertificateRepository(next CertificateRepository, config ReadCacheConfig) CertificateRepository {
+	return &cachedCertificateRepository{next: next, cache: newReadCache("certificates", config)}
+}
+
+func (r *cachedCertificateRepository) WithContext(ctx context.Context) CertificateRepository {
+	return &cachedCertificateRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedCertificateRepository) Create(cert *model.Certificate) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(cert) })
+}
+
+func (r *cachedCertificateRepository) GetByID(id uuid.UUID) (*model.Certificate, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.Certificate, error) { return r.next.GetByID(id) })
+}
+
+func (r *cachedCertificateRepository) Update(cert *model.Certificate) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(cert) })
+}
+
+func (r *cachedCertificateRepository) Delete(id uuid.UUID, version int) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(id, version) })
+}
+
+func (r *cachedCertificateRepository) GetAll() ([]model.Certificate, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+func (r *cachedCertificateRepository) List(q *utils.ListQuery) ([]model.Certificate, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.List)
+}
+
+func (r *cachedCertificateRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+// ============================
+// CACHED EDUCATION REPOSITORY
+// ============================
+
+type cachedEducationRepository struct {
+	next  EducationRepository
+	cache *readCache
+}
+
+func NewCachedEducationRepository(next EducationRepository, config ReadCacheConfig) EducationRepository {
+	return &cachedEducationRepository{next: next, cache: newReadCache("education", config)}
+}
+
+func (r *cachedEducationRepository) WithContext(ctx context.Context) EducationRepository {
+	return &cachedEducationRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedEducationRepository) CreateWithAchievements(edu *model.Education) error {
+	return invalidateAfter(r.cache, func() error { return r.next.CreateWithAchievements(edu) })
+}
+
+func (r *cachedEducationRepository) GetByIDWithAchievements(id uuid.UUID) (*model.Education, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.Education, error) { return r.next.GetByIDWithAchievements(id) })
+}
+
+func (r *cachedEducationRepository) UpdateWithAchievements(edu *model.Education) error {
+	return invalidateAfter(r.cache, func() error { return r.next.UpdateWithAchievements(edu) })
+}
+
+func (r *cachedEducationRepository) DeleteWithAchievements(id uuid.UUID, version int) error {
+	return invalidateAfter(r.cache, func() error { return r.next.DeleteWithAchievements(id, version) })
+}
+
+func (r *cachedEducationRepository) GetAllWithAchievements() ([]model.Education, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAllWithAchievements)
+}
+
+func (r *cachedEducationRepository) ListWithAchievements(q *utils.ListQuery) ([]model.Education, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.ListWithAchievements)
+}
+
+func (r *cachedEducationRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+// ============================
+// CACHED TESTIMONIALS REPOSITORY
+// ============================
+
+type cachedTestimonialRepository struct {
+	next  TestimonialRepository
+	cache *readCache
+}
+
+func NewCachedTestimonialRepository(next TestimonialRepository, config ReadCacheConfig) TestimonialRepository {
+	return &cachedTestimonialRepository{next: next, cache: newReadCache("testimonials", config)}
+}
+
+func (r *cachedTestimonialRepository) WithContext(ctx context.Context) TestimonialRepository {
+	return &cachedTestimonialRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedTestimonialRepository) Create(test *model.Testimonial) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(test) })
+}
+
+func (r *cachedTestimonialRepository) GetByID(id uuid.UUID) (*model.Testimonial, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.Testimonial, error) { return r.next.GetByID(id) })
+}
+
+func (r *cachedTestimonialRepository) Update(test *model.Testimonial) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(test) })
+}
+
+func (r *cachedTestimonialRepository) Delete(id uuid.UUID, version int) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(id, version) })
+}
+
+func (r *cachedTestimonialRepository) GetAll() ([]model.Testimonial, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+func (r *cachedTestimonialRepository) List(q *utils.ListQuery) ([]model.Testimonial, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.List)
+}
+
+func (r *cachedTestimonialRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+func (r *cachedTestimonialRepository) GetFeatured() ([]model.Testimonial, error) {
+	return cachedSlice(r.cache, "featured", r.next.GetFeatured)
+}
+
+func (r *cachedTestimonialRepository) GetByStatus(status string) ([]model.Testimonial, error) {
+	return cachedSlice(r.cache, "status:"+status, func() ([]model.Testimonial, error) { return r.next.GetByStatus(status) })
+}
+
+func (r *cachedTestimonialRepository) GetByProject(projectID uuid.UUID, status string) ([]model.Testimonial, error) {
+	return cachedSlice(r.cache, "project:"+projectID.String()+":"+status, func() ([]model.Testimonial, error) {
+		return r.next.GetByProject(projectID, status)
+	})
+}
+
+// ============================
+// CACHED SECTIONS REPOSITORY
+// ============================
+
+type cachedSectionRepository struct {
+	next  SectionRepository
+	cache *readCache
+}
+
+func NewCachedSectionRepository(next SectionRepository, config ReadCacheConfig) SectionRepository {
+	return &cachedSectionRepository{next: next, cache: newReadCache("sections", config)}
+}
+
+func (r *cachedSectionRepository) WithContext(ctx context.Context) SectionRepository {
+	return &cachedSectionRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedSectionRepository) Create(section *model.Section) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(section) })
+}
+
+func (r *cachedSectionRepository) GetByID(id uuid.UUID) (*model.Section, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.Section, error) { return r.next.GetByID(id) })
+}
+
+func (r *cachedSectionRepository) Update(section *model.Section) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(section) })
+}
+
+func (r *cachedSectionRepository) Delete(id uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(id) })
+}
+
+func (r *cachedSectionRepository) GetAll() ([]model.Section, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+func (r *cachedSectionRepository) List(q *utils.ListQuery) ([]model.Section, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.List)
+}
+
+func (r *cachedSectionRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+// ============================
+// CACHED SOCIAL LINKS REPOSITORY
+// ============================
+
+type cachedSocialLinkRepository struct {
+	next  SocialLinkRepository
+	cache *readCache
+}
+
+func NewCachedSocialLinkRepository(next SocialLinkRepository, config ReadCacheConfig) SocialLinkRepository {
+	return &cachedSocialLinkRepository{next: next, cache: newReadCache("social_links", config)}
+}
+
+func (r *cachedSocialLinkRepository) WithContext(ctx context.Context) SocialLinkRepository {
+	return &cachedSocialLinkRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedSocialLinkRepository) Create(link *model.SocialLink) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(link) })
+}
+
+func (r *cachedSocialLinkRepository) GetByID(id uuid.UUID) (*model.SocialLink, error) {
+	return cachedItem(r.cache, "id:"+id.String(), func() (*model.SocialLink, error) { return r.next.GetByID(id) })
+}
+
+func (r *cachedSocialLinkRepository) Update(link *model.SocialLink) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(link) })
+}
+
+func (r *cachedSocialLinkRepository) Delete(id uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(id) })
+}
+
+func (r *cachedSocialLinkRepository) GetAll() ([]model.SocialLink, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+func (r *cachedSocialLinkRepository) List(q *utils.ListQuery) ([]model.SocialLink, utils.PageInfo, error) {
+	return cachedList(r.cache, q, r.next.List)
+}
+
+func (r *cachedSocialLinkRepository) Reorder(ids []uuid.UUID) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Reorder(ids) })
+}
+
+// ============================
+// CACHED SETTINGS REPOSITORY
+// ============================
+
+type cachedSettingRepository struct {
+	next  SettingRepository
+	cache *readCache
+}
+
+func NewCachedSettingRepository(next SettingRepository, config ReadCacheConfig) SettingRepository {
+	return &cachedSettingRepository{next: next, cache: newReadCache("settings", config)}
+}
+
+func (r *cachedSettingRepository) WithContext(ctx context.Context) SettingRepository {
+	return &cachedSettingRepository{next: r.next.WithContext(ctx), cache: r.cache}
+}
+
+func (r *cachedSettingRepository) Create(setting *model.Setting) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Create(setting) })
+}
+
+func (r *cachedSettingRepository) GetByKey(key string) (*model.Setting, error) {
+	return cachedItem(r.cache, "key:"+key, func() (*model.Setting, error) { return r.next.GetByKey(key) })
+}
+
+func (r *cachedSettingRepository) Update(setting *model.Setting) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Update(setting) })
+}
+
+func (r *cachedSettingRepository) Delete(key string) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(key) })
+}
+
+func (r *cachedSettingRepository) GetAll() ([]model.Setting, error) {
+	return cachedSlice(r.cache, "all", r.next.GetAll)
+}
+
+// ============================
+// CACHED CONTENT TRANSLATIONS REPOSITORY
+// ============================
+
+type cachedTranslationRepository struct {
+	next  TranslationRepository
+	cache *readCache
+}
+
+func NewCachedTranslationRepository(next TranslationRepository, config ReadCacheConfig) TranslationRepository {
+	return &cachedTranslationRepository{next: next, cache: newReadCache("translations", config)}
+}
+
+func (r *cachedTranslationRepository) GetByLocale(entityType, locale string) ([]model.ContentTranslation, error) {
+	return cachedSlice(r.cache, "locale:"+entityType+":"+locale, func() ([]model.ContentTranslation, error) {
+		return r.next.GetByLocale(entityType, locale)
+	})
+}
+
+func (r *cachedTranslationRepository) List(filter TranslationFilter) ([]model.ContentTranslation, error) {
+	return r.next.List(filter)
+}
+
+func (r *cachedTranslationRepository) Save(entityType string, entityID uuid.UUID, locale string, values map[string]string, removed []string) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Save(entityType, entityID, locale, values, removed) })
+}
+
+func (r *cachedTranslationRepository) Delete(entityType string, entityID uuid.UUID, locale string) error {
+	return invalidateAfter(r.cache, func() error { return r.next.Delete(entityType, entityID, locale) })
+}
+
+func (r *cachedTranslationRepository) EntityExists(table string, id uuid.UUID) (bool, error) {
+	return r.next.EntityExists(table, id)
+}
+
+func (r *cachedTranslationRepository) SourceRows(table string, columns []string) ([]map[string]interface{}, error) {
+	return r.next.SourceRows(table, columns)
+}
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	model "gintugas/modules/components/all/models"
+
+	"github.com/google/uuid"
+)
+
+type fakeEducationRepo struct {
+	EducationRepository
+	educations []model.Education
+}
+
+func (r *fakeEducationRepo) GetAllWithAchievements() ([]model.Education, error) {
+	return r.educations, nil
+}
+
+func (r *fakeEducationRepo) GetByIDWithAchievements(id uuid.UUID) (*model.Education, error) {
+	return &r.educations[0], nil
+}
+
+func TestCachedValuesAreDeepCopies(t *testing.T) {
+	start := time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC)
+	edu := model.Education{
+		ID:           uuid.New(),
+		School:       "Universitas Indonesia",
+		StartDate:    &start,
+		Achievements: []model.EducationAchievement{{Achievement: "Cum laude"}},
+	}
+	cached := NewCachedEducationRepository(&fakeEducationRepo{educations: []model.Education{edu}}, ReadCacheConfig{TTL: time.Minute, MaxEntries: 8})
+
+	all, _ := cached.GetAllWithAchievements()
+	all[0].Achievements[0].Achievement = "diubah"
+	all[0].Achievements = append(all[0].Achievements, model.EducationAchievement{Achievement: "baru"})
+	*all[0].StartDate = start.AddDate(1, 0, 0)
+
+	one, _ := cached.GetByIDWithAchievements(edu.ID)
+	one.Achievements[0].Achievement = "diubah"
+
+	for i := 0; i < 2; i++ {
+		again, _ := cached.GetAllWithAchievements()
+		if got := again[0].Achievements; len(got) != 1 || got[0].Achievement != "Cum laude" {
+			t.Fatalf("cached achievements changed: %+v", got)
+		}
+		if !again[0].StartDate.Equal(start) {
+			t.Fatalf("cached start date changed: %v", again[0].StartDate)
+		}
+	}
+
+	item, _ := cached.GetByIDWithAchievements(edu.ID)
+	if item.Achievements[0].Achievement != "Cum laude" {
+		t.Fatalf("cached item changed: %+v", item.Achievements)
+	}
+}
+
+func TestDeepCopyKeepsNilAndEmptySlices(t *testing.T) {
+	posts := deepCopy([]model.BlogPost{{Tags: nil}, {Tags: []model.BlogTag{}}})
+	if posts[0].Tags != nil || posts[1].Tags == nil {
+		t.Fatalf("nil/empty slices must be preserved, got %#v / %#v", posts[0].Tags, posts[1].Tags)
+	}
+}
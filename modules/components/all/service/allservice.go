@@ -1,8 +1,14 @@
 package service
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	projectrepo "gintugas/modules/components/Project/repository"
 	model "gintugas/modules/components/all/models"
 	"gintugas/modules/components/all/repo"
 	"gintugas/modules/utils"
@@ -10,11 +16,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // ============================
@@ -46,13 +54,13 @@ func (s *SupabaseUploadWrapper) DeleteFile(fileURL string) error {
 
 func (s *SupabaseUploadWrapper) ValidateFile(file *multipart.FileHeader, maxSizeMB int64, allowedExts []string) error {
 	if file == nil {
-		return errors.New("file tidak ditemukan")
+		return utils.BadRequestKey("file_required", "field", "file")
 	}
 
 	// Ukuran file
 	maxSize := maxSizeMB * 1024 * 1024
 	if file.Size > maxSize {
-		return fmt.Errorf("ukuran file maksimal %dMB", maxSizeMB)
+		return utils.BadRequestKey("file_too_large", "max", fmt.Sprint(maxSizeMB))
 	}
 
 	// Extension
@@ -63,7 +71,7 @@ func (s *SupabaseUploadWrapper) ValidateFile(file *multipart.FileHeader, maxSize
 		}
 	}
 
-	return fmt.Errorf("tipe file tidak diizinkan. File yang diizinkan: %s", strings.Join(allowedExts, ", "))
+	return utils.BadRequestKey("file_type", "param", strings.Join(allowedExts, ", "))
 }
 
 // LocalUploadWrapper
@@ -91,7 +99,7 @@ func (l *LocalUploadWrapper) ValidateFile(file *multipart.FileHeader, maxSizeMB
 	// Simple validation untuk local
 	maxSize := maxSizeMB * 1024 * 1024
 	if file.Size > maxSize {
-		return fmt.Errorf("ukuran file maksimal %dMB", maxSizeMB)
+		return utils.BadRequestKey("file_too_large", "max", fmt.Sprint(maxSizeMB))
 	}
 
 	return nil
@@ -114,9 +122,10 @@ type SkillService interface {
 	CreateWithIcon(ctx *gin.Context) (*model.SkillResponse, error)
 	GetByID(ctx *gin.Context) (*model.SkillResponse, error)
 	Update(ctx *gin.Context) (*model.SkillResponse, error)
+	Patch(ctx *gin.Context) (*model.SkillResponse, error)
 	UpdateWithIcon(ctx *gin.Context) (*model.SkillResponse, error)
 	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.SkillResponse, error)
+	GetAll(ctx *gin.Context) ([]model.SkillResponse, *utils.PageInfo, error)
 	GetFeatured(ctx *gin.Context) ([]model.SkillResponse, error)
 	GetByCategory(ctx *gin.Context) ([]model.SkillResponse, error)
 }
@@ -204,7 +213,7 @@ func getUploadPath() string {
 
 func (s *skillService) Create(ctx *gin.Context) (*model.SkillResponse, error) {
 	var req model.SkillRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
@@ -221,30 +230,30 @@ func (s *skillService) Create(ctx *gin.Context) (*model.SkillResponse, error) {
 		return nil, err
 	}
 
-	return s.convertSkillToResponse(skill), nil
+	return convertSkillToResponse(skill), nil
 }
 
 func (s *skillService) CreateWithIcon(ctx *gin.Context) (*model.SkillResponse, error) {
 	var form model.SkillForm
 
 	// Bind form data
-	if err := ctx.ShouldBind(&form); err != nil {
-		return nil, fmt.Errorf("gagal binding data: %v", err)
+	if err := utils.Bind(ctx, &form); err != nil {
+		return nil, err
 	}
 
 	// Validasi required fields
 	if form.Name == "" {
-		return nil, errors.New("nama skill harus diisi")
+		return nil, utils.RequireFields("name", form.Name)
 	}
 
 	if form.Value < 0 || form.Value > 100 {
-		return nil, errors.New("nilai skill harus antara 0-100")
+		return nil, utils.BadRequestKey("range", "field", "value", "min", "0", "max", "100")
 	}
 
 	// Handle file upload
 	file, err := ctx.FormFile("icon")
 	if err != nil && err != http.ErrMissingFile {
-		return nil, fmt.Errorf("gagal mengambil file icon: %v", err)
+		return nil, fmt.Errorf("gagal mengambil file icon: %w", err)
 	}
 
 	iconURL := ""
@@ -258,7 +267,7 @@ func (s *skillService) CreateWithIcon(ctx *gin.Context) (*model.SkillResponse, e
 		// Upload ke Supabase atau Local storage
 		iconURL, err = s.uploadService.UploadFile(file, "skills")
 		if err != nil {
-			return nil, fmt.Errorf("gagal upload file icon: %v", err)
+			return nil, fmt.Errorf("gagal upload file icon: %w", err)
 		}
 		fmt.Printf("✅ Skill icon uploaded: %s\n", iconURL)
 	}
@@ -287,16 +296,16 @@ func (s *skillService) CreateWithIcon(ctx *gin.Context) (*model.SkillResponse, e
 		if file != nil && iconURL != "" {
 			s.uploadService.DeleteFile(iconURL)
 		}
-		return nil, fmt.Errorf("gagal menyimpan data skill: %v", err)
+		return nil, fmt.Errorf("gagal menyimpan data skill: %w", err)
 	}
 
-	return s.convertSkillToResponse(skill), nil
+	return convertSkillToResponse(skill), nil
 }
 
 func (s *skillService) GetByID(ctx *gin.Context) (*model.SkillResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid skill ID")
+		return nil, utils.InvalidID("skill")
 	}
 
 	skill, err := s.repo.GetByID(id)
@@ -304,31 +313,58 @@ func (s *skillService) GetByID(ctx *gin.Context) (*model.SkillResponse, error) {
 		return nil, err
 	}
 
-	return s.convertSkillToResponse(skill), nil
+	return convertSkillToResponse(skill), nil
 }
 
 func (s *skillService) Update(ctx *gin.Context) (*model.SkillResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid skill ID")
+		return nil, utils.InvalidID("skill")
 	}
 
 	existing, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
 
-	var req model.SkillUpdateRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	// PUT mengganti seluruh field; field yang tidak dikirim menjadi nilai kosong
+	var req model.SkillRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	if req.Name != "" {
-		existing.Name = req.Name
+	return s.replace(existing, req)
+}
+
+// Patch menerapkan JSON Merge Patch: hanya field yang dikirim yang berubah
+func (s *skillService) Patch(ctx *gin.Context) (*model.SkillResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("skill")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
 	}
-	if req.Value != 0 {
-		existing.Value = req.Value
+
+	req := skillToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
 	}
+
+	return s.replace(existing, req)
+}
+
+func (s *skillService) replace(existing *model.Skill, req model.SkillRequest) (*model.SkillResponse, error) {
+	existing.Name = req.Name
+	existing.Value = req.Value
 	existing.IconURL = req.IconURL
 	existing.Category = req.Category
 	existing.DisplayOrder = req.DisplayOrder
@@ -339,29 +375,32 @@ func (s *skillService) Update(ctx *gin.Context) (*model.SkillResponse, error) {
 		return nil, err
 	}
 
-	return s.convertSkillToResponse(existing), nil
+	return convertSkillToResponse(existing), nil
 }
 
 func (s *skillService) UpdateWithIcon(ctx *gin.Context) (*model.SkillResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid skill ID")
+		return nil, utils.InvalidID("skill")
 	}
 
 	existing, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
 
 	var form model.SkillForm
-	if err := ctx.ShouldBind(&form); err != nil {
-		return nil, fmt.Errorf("gagal binding data: %v", err)
+	if err := utils.Bind(ctx, &form); err != nil {
+		return nil, err
 	}
 
 	// Handle file upload
 	file, err := ctx.FormFile("icon")
 	if err != nil && err != http.ErrMissingFile {
-		return nil, fmt.Errorf("gagal mengambil file icon: %v", err)
+		return nil, fmt.Errorf("gagal mengambil file icon: %w", err)
 	}
 
 	// Jika ada file baru diupload
@@ -375,7 +414,7 @@ func (s *skillService) UpdateWithIcon(ctx *gin.Context) (*model.SkillResponse, e
 		// Upload file baru
 		newIconURL, err := s.uploadService.UploadFile(file, "skills")
 		if err != nil {
-			return nil, fmt.Errorf("gagal upload file icon: %v", err)
+			return nil, fmt.Errorf("gagal upload file icon: %w", err)
 		}
 
 		// Hapus file lama jika ada
@@ -387,16 +426,10 @@ func (s *skillService) UpdateWithIcon(ctx *gin.Context) (*model.SkillResponse, e
 		existing.IconURL = newIconURL
 	}
 
-	// Update fields lainnya
-	if form.Name != "" {
-		existing.Name = form.Name
-	}
-	if form.Value != 0 {
-		existing.Value = form.Value
-	}
-	if form.Category != "" {
-		existing.Category = form.Category
-	}
+	// PUT: semua field form menggantikan nilai lama, icon tetap jika tidak diupload
+	existing.Name = form.Name
+	existing.Value = form.Value
+	existing.Category = form.Category
 	existing.DisplayOrder = form.DisplayOrder
 	existing.IsFeatured = form.IsFeatured
 	existing.UpdatedAt = time.Now()
@@ -406,16 +439,16 @@ func (s *skillService) UpdateWithIcon(ctx *gin.Context) (*model.SkillResponse, e
 		if file != nil && existing.IconURL != "" {
 			s.uploadService.DeleteFile(existing.IconURL)
 		}
-		return nil, fmt.Errorf("gagal mengupdate data skill: %v", err)
+		return nil, fmt.Errorf("gagal mengupdate data skill: %w", err)
 	}
 
-	return s.convertSkillToResponse(existing), nil
+	return convertSkillToResponse(existing), nil
 }
 
 func (s *skillService) Delete(ctx *gin.Context) error {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return errors.New("invalid skill ID")
+		return utils.InvalidID("skill")
 	}
 
 	// Get skill data untuk menghapus file icon
@@ -423,30 +456,43 @@ func (s *skillService) Delete(ctx *gin.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := utils.CheckIfMatch(ctx, skill.Version); err != nil {
+		return err
+	}
+
+	// Delete dari database dulu; file icon hanya dihapus jika row benar-benar
+	// terhapus (bisa gagal 412 kalau skill diubah di antaranya)
+	if err := s.repo.Delete(id, skill.Version); err != nil {
+		return err
+	}
 
 	// Hapus file icon dari Supabase atau local storage jika ada
 	if skill.IconURL != "" {
 		if err := s.uploadService.DeleteFile(skill.IconURL); err != nil {
 			fmt.Printf("⚠️ Warning: gagal hapus file icon: %v\n", err)
-			// Jangan return error, lanjut delete dari DB
 		}
 	}
 
-	return s.repo.Delete(id)
+	return nil
 }
 
-func (s *skillService) GetAll(ctx *gin.Context) ([]model.SkillResponse, error) {
-	skills, err := s.repo.GetAll()
+func (s *skillService) GetAll(ctx *gin.Context) ([]model.SkillResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.SkillListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var responses []model.SkillResponse
+	skills, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses := make([]model.SkillResponse, 0, len(skills))
 	for _, skill := range skills {
-		responses = append(responses, *s.convertSkillToResponse(&skill))
+		responses = append(responses, *convertSkillToResponse(&skill))
 	}
 
-	return responses, nil
+	return responses, &page, nil
 }
 
 func (s *skillService) GetFeatured(ctx *gin.Context) ([]model.SkillResponse, error) {
@@ -457,7 +503,7 @@ func (s *skillService) GetFeatured(ctx *gin.Context) ([]model.SkillResponse, err
 
 	var responses []model.SkillResponse
 	for _, skill := range skills {
-		responses = append(responses, *s.convertSkillToResponse(&skill))
+		responses = append(responses, *convertSkillToResponse(&skill))
 	}
 
 	return responses, nil
@@ -472,13 +518,13 @@ func (s *skillService) GetByCategory(ctx *gin.Context) ([]model.SkillResponse, e
 
 	var responses []model.SkillResponse
 	for _, skill := range skills {
-		responses = append(responses, *s.convertSkillToResponse(&skill))
+		responses = append(responses, *convertSkillToResponse(&skill))
 	}
 
 	return responses, nil
 }
 
-func (s *skillService) convertSkillToResponse(skill *model.Skill) *model.SkillResponse {
+func convertSkillToResponse(skill *model.Skill) *model.SkillResponse {
 	return &model.SkillResponse{
 		ID:           skill.ID,
 		Name:         skill.Name,
@@ -487,6 +533,7 @@ func (s *skillService) convertSkillToResponse(skill *model.Skill) *model.SkillRe
 		Category:     skill.Category,
 		DisplayOrder: skill.DisplayOrder,
 		IsFeatured:   skill.IsFeatured,
+		Version:      skill.Version,
 		CreatedAt:    skill.CreatedAt,
 		UpdatedAt:    skill.UpdatedAt,
 	}
@@ -501,8 +548,9 @@ type CertificateService interface {
 	CreateWithImage(ctx *gin.Context) (*model.CertificateResponse, error)
 	GetByID(ctx *gin.Context) (*model.CertificateResponse, error)
 	Update(ctx *gin.Context) (*model.CertificateResponse, error)
+	Patch(ctx *gin.Context) (*model.CertificateResponse, error)
 	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.CertificateResponse, error)
+	GetAll(ctx *gin.Context) ([]model.CertificateResponse, *utils.PageInfo, error)
 }
 
 type certificateService struct {
@@ -559,7 +607,7 @@ func NewCertificateServiceWithUpload(repo repo.CertificateRepository, uploadServ
 
 func (s *certificateService) Create(ctx *gin.Context) (*model.CertificateResponse, error) {
 	var req model.CertificateRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
@@ -576,26 +624,26 @@ func (s *certificateService) Create(ctx *gin.Context) (*model.CertificateRespons
 		return nil, err
 	}
 
-	return s.convertCertToResponse(cert), nil
+	return convertCertToResponse(cert), nil
 }
 
 func (s *certificateService) CreateWithImage(ctx *gin.Context) (*model.CertificateResponse, error) {
 	var form model.CertificateForm
 
 	// Bind form data
-	if err := ctx.ShouldBind(&form); err != nil {
-		return nil, fmt.Errorf("gagal binding data: %v", err)
+	if err := utils.Bind(ctx, &form); err != nil {
+		return nil, err
 	}
 
 	// Validasi required fields
 	if form.Name == "" {
-		return nil, errors.New("nama sertifikat harus diisi")
+		return nil, utils.RequireFields("name", form.Name)
 	}
 
 	// Handle file upload
 	file, err := ctx.FormFile("image")
 	if err != nil {
-		return nil, fmt.Errorf("file gambar harus diupload: %v", err)
+		return nil, utils.BadRequestKey("file_required", "field", "image").WithCause(err)
 	}
 
 	// Validasi file
@@ -607,7 +655,7 @@ func (s *certificateService) CreateWithImage(ctx *gin.Context) (*model.Certifica
 	// Upload ke Supabase atau Local storage
 	imageURL, err := s.uploadService.UploadFile(file, "certificates")
 	if err != nil {
-		return nil, fmt.Errorf("gagal upload file: %v", err)
+		return nil, fmt.Errorf("gagal upload file: %w", err)
 	}
 	fmt.Printf("✅ Certificate image uploaded: %s\n", imageURL)
 
@@ -618,7 +666,7 @@ func (s *certificateService) CreateWithImage(ctx *gin.Context) (*model.Certifica
 		if err != nil {
 			// Cleanup file jika parsing gagal
 			s.uploadService.DeleteFile(imageURL)
-			return nil, fmt.Errorf("format tanggal tidak valid, gunakan format YYYY-MM-DD: %v", err)
+			return nil, utils.BadRequestKey("date_ymd", "field", "issue_date").WithCause(err)
 		}
 		issueDate = parsedDate
 	}
@@ -645,17 +693,17 @@ func (s *certificateService) CreateWithImage(ctx *gin.Context) (*model.Certifica
 	if err := s.repo.Create(cert); err != nil {
 		// Cleanup file jika gagal save ke database
 		s.uploadService.DeleteFile(imageURL)
-		return nil, fmt.Errorf("gagal menyimpan data sertifikat: %v", err)
+		return nil, fmt.Errorf("gagal menyimpan data sertifikat: %w", err)
 	}
 
-	return s.convertCertToResponse(cert), nil
+	return convertCertToResponse(cert), nil
 }
 
 func (s *certificateService) GetByID(ctx *gin.Context) (*model.CertificateResponse, error) {
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return nil, errors.New("ID sertifikat tidak valid")
+		return nil, utils.InvalidID("certificate")
 	}
 
 	cert, err := s.repo.GetByID(id)
@@ -663,69 +711,92 @@ func (s *certificateService) GetByID(ctx *gin.Context) (*model.CertificateRespon
 		return nil, err
 	}
 
-	return s.convertCertToResponse(cert), nil
+	return convertCertToResponse(cert), nil
 }
 
 func (s *certificateService) Update(ctx *gin.Context) (*model.CertificateResponse, error) {
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return nil, errors.New("ID sertifikat tidak valid")
+		return nil, utils.InvalidID("certificate")
 	}
 
 	// Check if certificate exists
 	existingCert, err := s.repo.GetByID(id)
 	if err != nil {
-		return nil, errors.New("sertifikat tidak ditemukan")
+		return nil, utils.NotFound("certificate")
 	}
-
-	var updateData model.CertificateUpdateRequest
-	if err := ctx.ShouldBindJSON(&updateData); err != nil {
+	if err := utils.CheckIfMatch(ctx, existingCert.Version); err != nil {
 		return nil, err
 	}
 
-	// Update fields
-	if updateData.Name != "" {
-		existingCert.Name = updateData.Name
+	// PUT mengganti seluruh field
+	var req model.CertificateRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
 	}
-	if updateData.ImageURL != "" {
-		existingCert.ImageURL = updateData.ImageURL
+
+	return s.replace(existingCert, req)
+}
+
+// Patch menerapkan JSON Merge Patch pada sertifikat
+func (s *certificateService) Patch(ctx *gin.Context) (*model.CertificateResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("certificate")
 	}
-	if !updateData.IssueDate.IsZero() {
-		existingCert.IssueDate = updateData.IssueDate
+
+	existingCert, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
 	}
-	if updateData.Issuer != "" {
-		existingCert.Issuer = updateData.Issuer
+	if err := utils.CheckIfMatch(ctx, existingCert.Version); err != nil {
+		return nil, err
 	}
-	if updateData.CredentialURL != "" {
-		existingCert.CredentialURL = updateData.CredentialURL
+
+	req := certificateToRequest(existingCert)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
 	}
-	existingCert.DisplayOrder = updateData.DisplayOrder
+
+	return s.replace(existingCert, req)
+}
+
+func (s *certificateService) replace(existingCert *model.Certificate, req model.CertificateRequest) (*model.CertificateResponse, error) {
+	existingCert.Name = req.Name
+	existingCert.ImageURL = req.ImageURL
+	existingCert.IssueDate = req.IssueDate
+	existingCert.Issuer = req.Issuer
+	existingCert.CredentialURL = req.CredentialURL
+	existingCert.DisplayOrder = req.DisplayOrder
 
 	if err := s.repo.Update(existingCert); err != nil {
 		return nil, err
 	}
 
-	return s.convertCertToResponse(existingCert), nil
+	return convertCertToResponse(existingCert), nil
 }
 
 func (s *certificateService) Delete(ctx *gin.Context) error {
 	idStr := ctx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return errors.New("ID sertifikat tidak valid")
+		return utils.InvalidID("certificate")
 	}
 
 	// Get certificate data untuk hapus file
 	cert, err := s.repo.GetByID(id)
 	if err != nil {
-		return errors.New("sertifikat tidak ditemukan")
+		return utils.NotFound("certificate")
+	}
+	if err := utils.CheckIfMatch(ctx, cert.Version); err != nil {
+		return err
 	}
 
 	// Delete dari database terlebih dahulu
-	err = s.repo.Delete(id)
+	err = s.repo.Delete(id, cert.Version)
 	if err != nil {
-		return fmt.Errorf("gagal menghapus sertifikat: %v", err)
+		return fmt.Errorf("gagal menghapus sertifikat: %w", err)
 	}
 
 	// Hapus file image dari Supabase atau local storage jika ada
@@ -739,21 +810,26 @@ func (s *certificateService) Delete(ctx *gin.Context) error {
 	return nil
 }
 
-func (s *certificateService) GetAll(ctx *gin.Context) ([]model.CertificateResponse, error) {
-	certs, err := s.repo.GetAll()
+func (s *certificateService) GetAll(ctx *gin.Context) ([]model.CertificateResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.CertificateListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	certs, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	responses := make([]model.CertificateResponse, len(certs))
 	for i, cert := range certs {
-		responses[i] = *s.convertCertToResponse(&cert)
+		responses[i] = *convertCertToResponse(&cert)
 	}
 
-	return responses, nil
+	return responses, &page, nil
 }
 
-func (s *certificateService) convertCertToResponse(cert *model.Certificate) *model.CertificateResponse {
+func convertCertToResponse(cert *model.Certificate) *model.CertificateResponse {
 	return &model.CertificateResponse{
 		ID:            cert.ID,
 		Name:          cert.Name,
@@ -762,6 +838,7 @@ func (s *certificateService) convertCertToResponse(cert *model.Certificate) *mod
 		Issuer:        cert.Issuer,
 		CredentialURL: cert.CredentialURL,
 		DisplayOrder:  cert.DisplayOrder,
+		Version:       cert.Version,
 		CreatedAt:     cert.CreatedAt,
 	}
 }
@@ -774,32 +851,41 @@ type EducationService interface {
 	CreateWithAchievements(ctx *gin.Context) (*model.EducationResponse, error)
 	GetByIDWithAchievements(ctx *gin.Context) (*model.EducationResponse, error)
 	UpdateWithAchievements(ctx *gin.Context) (*model.EducationResponse, error)
+	PatchWithAchievements(ctx *gin.Context) (*model.EducationResponse, error)
 	DeleteWithAchievements(ctx *gin.Context) error
-	GetAllWithAchievements(ctx *gin.Context) ([]model.EducationResponse, error)
+	GetAllWithAchievements(ctx *gin.Context) ([]model.EducationResponse, *utils.PageInfo, error)
 }
 
 type educationService struct {
-	repo repo.EducationRepository
+	repo      repo.EducationRepository
+	localizer utils.Localizer
 }
 
-func NewEducationService(repo repo.EducationRepository) EducationService {
-	return &educationService{repo: repo}
+func NewEducationService(repo repo.EducationRepository, localizer utils.Localizer) EducationService {
+	return &educationService{repo: repo, localizer: localizer}
 }
 
 func (s *educationService) CreateWithAchievements(ctx *gin.Context) (*model.EducationResponse, error) {
 	var req model.EducationRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	dates, err := educationDates(req)
+	if err != nil {
 		return nil, err
 	}
 
 	edu := &model.Education{
-		School:       req.School,
-		Major:        req.Major,
-		StartYear:    req.StartYear,
-		EndYear:      req.EndYear,
-		Description:  req.Description,
-		Degree:       req.Degree,
-		DisplayOrder: req.DisplayOrder,
+		School:        req.School,
+		Major:         req.Major,
+		StartDate:     dates.Start,
+		EndDate:       dates.End,
+		IsOngoing:     dates.Ongoing,
+		DatePrecision: dates.Precision,
+		Description:   req.Description,
+		Degree:        req.Degree,
+		DisplayOrder:  req.DisplayOrder,
 	}
 
 	for _, achReq := range req.Achievements {
@@ -813,13 +899,13 @@ func (s *educationService) CreateWithAchievements(ctx *gin.Context) (*model.Educ
 		return nil, err
 	}
 
-	return convertEducationToResponse(edu), nil
+	return convertEducationToResponse(edu, utils.NewDateFormatter(ctx)), nil
 }
 
 func (s *educationService) GetByIDWithAchievements(ctx *gin.Context) (*model.EducationResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid education ID")
+		return nil, utils.InvalidID("education")
 	}
 
 	edu, err := s.repo.GetByIDWithAchievements(id)
@@ -827,33 +913,70 @@ func (s *educationService) GetByIDWithAchievements(ctx *gin.Context) (*model.Edu
 		return nil, err
 	}
 
-	return convertEducationToResponse(edu), nil
+	response := convertEducationToResponse(edu, utils.NewDateFormatter(ctx))
+	localizeEducation(utils.Localize(s.localizer, ctx, utils.TranslatableEducation), response)
+	return response, nil
 }
 
 func (s *educationService) UpdateWithAchievements(ctx *gin.Context) (*model.EducationResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid education ID")
+		return nil, utils.InvalidID("education")
 	}
 
 	existing, err := s.repo.GetByIDWithAchievements(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	// PUT mengganti seluruh field termasuk daftar achievements
+	var req model.EducationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return s.replace(ctx, existing, req)
+}
+
+// PatchWithAchievements menerapkan JSON Merge Patch; achievements yang dikirim
+// menggantikan seluruh daftar lama (array tidak di-merge per item)
+func (s *educationService) PatchWithAchievements(ctx *gin.Context) (*model.EducationResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("education")
+	}
 
-	var req model.EducationUpdateRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	existing, err := s.repo.GetByIDWithAchievements(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
 		return nil, err
 	}
 
-	if req.School != "" {
-		existing.School = req.School
+	req := educationToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
 	}
-	if req.Major != "" {
-		existing.Major = req.Major
+
+	return s.replace(ctx, existing, req)
+}
+
+func (s *educationService) replace(ctx *gin.Context, existing *model.Education, req model.EducationRequest) (*model.EducationResponse, error) {
+	dates, err := educationDates(req)
+	if err != nil {
+		return nil, err
 	}
-	existing.StartYear = req.StartYear
-	existing.EndYear = req.EndYear
+
+	existing.School = req.School
+	existing.Major = req.Major
+	existing.StartDate = dates.Start
+	existing.EndDate = dates.End
+	existing.IsOngoing = dates.Ongoing
+	existing.DatePrecision = dates.Precision
 	existing.Description = req.Description
 	existing.Degree = req.Degree
 	existing.DisplayOrder = req.DisplayOrder
@@ -871,57 +994,138 @@ func (s *educationService) UpdateWithAchievements(ctx *gin.Context) (*model.Educ
 		return nil, err
 	}
 
-	return convertEducationToResponse(existing), nil
+	return convertEducationToResponse(existing, utils.NewDateFormatter(ctx)), nil
 }
 
 func (s *educationService) DeleteWithAchievements(ctx *gin.Context) error {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return errors.New("invalid education ID")
+		return utils.InvalidID("education")
+	}
+
+	existing, err := s.repo.GetByIDWithAchievements(id)
+	if err != nil {
+		return utils.NotFound("education")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return err
 	}
 
-	return s.repo.DeleteWithAchievements(id)
+	return s.repo.DeleteWithAchievements(id, existing.Version)
 }
 
-func (s *educationService) GetAllWithAchievements(ctx *gin.Context) ([]model.EducationResponse, error) {
-	educations, err := s.repo.GetAllWithAchievements()
+func (s *educationService) GetAllWithAchievements(ctx *gin.Context) ([]model.EducationResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.EducationListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var responses []model.EducationResponse
+	educations, page, err := s.repo.ListWithAchievements(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableEducation)
+	formatter := utils.NewDateFormatter(ctx)
+
+	responses := make([]model.EducationResponse, 0, len(educations))
 	for _, edu := range educations {
-		responses = append(responses, *convertEducationToResponse(&edu))
+		response := convertEducationToResponse(&edu, formatter)
+		localizeEducation(translations, response)
+		responses = append(responses, *response)
 	}
 
-	return responses, nil
+	return responses, &page, nil
 }
 
 // ============================
-// TESTIMONIALS SERVICE (no upload needed)
+// TESTIMONIALS SERVICE
 // ============================
+// Testimoni dari admin langsung approved; testimoni dari form publik selalu
+// pending sampai dimoderasi. Pengunjung anonim hanya melihat yang approved.
+// Invite link untuk klien ada di testimonial_invite.go.
 
 type TestimonialService interface {
 	Create(ctx *gin.Context) (*model.TestimonialResponse, error)
 	GetByID(ctx *gin.Context) (*model.TestimonialResponse, error)
 	Update(ctx *gin.Context) (*model.TestimonialResponse, error)
+	Patch(ctx *gin.Context) (*model.TestimonialResponse, error)
 	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.TestimonialResponse, error)
+	GetAll(ctx *gin.Context) ([]model.TestimonialResponse, *utils.PageInfo, error)
 	GetFeatured(ctx *gin.Context) ([]model.TestimonialResponse, error)
 	GetByStatus(ctx *gin.Context) ([]model.TestimonialResponse, error)
+	Submit(ctx *gin.Context) (*model.TestimonialSubmissionResponse, error)
+	Moderate(ctx *gin.Context) (*model.TestimonialResponse, error)
+	GetByProject(ctx *gin.Context) ([]model.TestimonialResponse, error)
+
+	CreateInvite(ctx *gin.Context) (*model.TestimonialInviteResponse, error)
+	GetInvites(ctx *gin.Context) ([]model.TestimonialInviteResponse, error)
+	RevokeInvite(ctx *gin.Context) error
+	GetInvitePrefill(ctx *gin.Context) (*model.TestimonialInvitePrefill, error)
+	SubmitWithInvite(ctx *gin.Context) (*model.TestimonialSubmissionResponse, error)
 }
 
 type testimonialService struct {
-	repo repo.TestimonialRepository
+	repo          repo.TestimonialRepository
+	invites       repo.TestimonialInviteRepository
+	projectRepo   projectrepo.Repository
+	uploadPath    string
+	uploadService UploadServiceWrapper
+}
+
+// NewTestimonialService untuk local storage (avatar dari form publik)
+func NewTestimonialService(repo repo.TestimonialRepository, invites repo.TestimonialInviteRepository, projectRepo projectrepo.Repository, uploadPath string) TestimonialService {
+	if err := os.MkdirAll(uploadPath, 0755); err != nil {
+		fmt.Printf("⚠️ Warning: gagal membuat folder upload testimonial: %v\n", err)
+	}
+
+	var uploadService UploadServiceWrapper
+
+	if getUploadProvider() == "supabase" {
+		supabaseService := createSupabaseUploadService()
+		if supabaseService != nil {
+			uploadService = NewSupabaseUploadWrapper(supabaseService)
+			fmt.Println("✅ Using Supabase Storage for testimonials")
+		} else {
+			localService := utils.NewLocalUploadService(uploadPath)
+			uploadService = NewLocalUploadWrapper(localService)
+			fmt.Println("⚠️ Using Local Storage for testimonials (Supabase not configured)")
+		}
+	} else {
+		localService := utils.NewLocalUploadService(uploadPath)
+		uploadService = NewLocalUploadWrapper(localService)
+		fmt.Println("ℹ️ Using Local Storage for testimonials (development)")
+	}
+
+	return &testimonialService{
+		repo:          repo,
+		invites:       invites,
+		projectRepo:   projectRepo,
+		uploadPath:    uploadPath,
+		uploadService: uploadService,
+	}
 }
 
-func NewTestimonialService(repo repo.TestimonialRepository) TestimonialService {
-	return &testimonialService{repo: repo}
+// NewTestimonialServiceWithUpload untuk custom upload service
+func NewTestimonialServiceWithUpload(repo repo.TestimonialRepository, invites repo.TestimonialInviteRepository, projectRepo projectrepo.Repository, uploadService UploadServiceWrapper, folder string) TestimonialService {
+	uploadPath := getUploadPath()
+	localPath := filepath.Join(uploadPath, folder)
+	if err := os.MkdirAll(localPath, 0755); err != nil {
+		fmt.Printf("⚠️ Warning: gagal membuat folder upload: %v\n", err)
+	}
+
+	return &testimonialService{
+		repo:          repo,
+		invites:       invites,
+		projectRepo:   projectRepo,
+		uploadPath:    localPath,
+		uploadService: uploadService,
+	}
 }
 
 func (s *testimonialService) Create(ctx *gin.Context) (*model.TestimonialResponse, error) {
 	var req model.TestimonialRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
@@ -934,12 +1138,17 @@ func (s *testimonialService) Create(ctx *gin.Context) (*model.TestimonialRespons
 		IsFeatured:   req.IsFeatured,
 		DisplayOrder: req.DisplayOrder,
 		Status:       req.Status,
+		ProjectID:    req.ProjectID,
 	}
 
 	if test.Status == "" {
 		test.Status = "approved"
 	}
 
+	if err := s.checkProject(test.ProjectID); err != nil {
+		return nil, err
+	}
+
 	if err := s.repo.Create(test); err != nil {
 		return nil, err
 	}
@@ -950,7 +1159,7 @@ func (s *testimonialService) Create(ctx *gin.Context) (*model.TestimonialRespons
 func (s *testimonialService) GetByID(ctx *gin.Context) (*model.TestimonialResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid testimonial ID")
+		return nil, utils.InvalidID("testimonial")
 	}
 
 	test, err := s.repo.GetByID(id)
@@ -958,43 +1167,79 @@ func (s *testimonialService) GetByID(ctx *gin.Context) (*model.TestimonialRespon
 		return nil, err
 	}
 
+	if !authmiddleware.IsAdmin(ctx) {
+		if test.Status != "approved" {
+			return nil, utils.NotFound("testimonial")
+		}
+		return publicTestimonialResponse(test), nil
+	}
+
 	return convertTestimonialToResponse(test), nil
 }
 
 func (s *testimonialService) Update(ctx *gin.Context) (*model.TestimonialResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return nil, errors.New("invalid testimonial ID")
+		return nil, utils.InvalidID("testimonial")
 	}
 
 	existing, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, err
 	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
 
-	var req model.TestimonialUpdateRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	// PUT mengganti seluruh field
+	var req model.TestimonialRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	if req.Name != "" {
-		existing.Name = req.Name
+	return s.replace(existing, req)
+}
+
+// Patch menerapkan JSON Merge Patch pada testimonial
+func (s *testimonialService) Patch(ctx *gin.Context) (*model.TestimonialResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("testimonial")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
 	}
-	if req.Title != "" {
-		existing.Title = req.Title
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
 	}
-	if req.Message != "" {
-		existing.Message = req.Message
+
+	req := testimonialToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
 	}
+
+	return s.replace(existing, req)
+}
+
+func (s *testimonialService) replace(existing *model.Testimonial, req model.TestimonialRequest) (*model.TestimonialResponse, error) {
+	existing.Name = req.Name
+	existing.Title = req.Title
+	existing.Message = req.Message
 	existing.AvatarURL = req.AvatarURL
-	if req.Rating != 0 {
-		existing.Rating = req.Rating
-	}
+	existing.Rating = req.Rating
 	existing.IsFeatured = req.IsFeatured
 	existing.DisplayOrder = req.DisplayOrder
-	if req.Status != "" {
-		existing.Status = req.Status
+	existing.Status = req.Status
+	if existing.Status == "" {
+		existing.Status = "approved"
+	}
+
+	if err := s.checkProject(req.ProjectID); err != nil {
+		return nil, err
 	}
+	existing.ProjectID = req.ProjectID
 
 	if err := s.repo.Update(existing); err != nil {
 		return nil, err
@@ -1006,24 +1251,46 @@ func (s *testimonialService) Update(ctx *gin.Context) (*model.TestimonialRespons
 func (s *testimonialService) Delete(ctx *gin.Context) error {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return errors.New("invalid testimonial ID")
+		return utils.InvalidID("testimonial")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return utils.NotFound("testimonial")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return err
 	}
 
-	return s.repo.Delete(id)
+	return s.repo.Delete(id, existing.Version)
 }
 
-func (s *testimonialService) GetAll(ctx *gin.Context) ([]model.TestimonialResponse, error) {
-	testimonials, err := s.repo.GetAll()
+func (s *testimonialService) GetAll(ctx *gin.Context) ([]model.TestimonialResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.TestimonialListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var responses []model.TestimonialResponse
+	isAdmin := authmiddleware.IsAdmin(ctx)
+	if !isAdmin {
+		q.ForceFilter(repo.TestimonialListSpec, "status", "approved")
+	}
+
+	testimonials, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses := make([]model.TestimonialResponse, 0, len(testimonials))
 	for _, test := range testimonials {
-		responses = append(responses, *convertTestimonialToResponse(&test))
+		if isAdmin {
+			responses = append(responses, *convertTestimonialToResponse(&test))
+		} else {
+			responses = append(responses, *publicTestimonialResponse(&test))
+		}
 	}
 
-	return responses, nil
+	return responses, &page, nil
 }
 
 func (s *testimonialService) GetFeatured(ctx *gin.Context) ([]model.TestimonialResponse, error) {
@@ -1032,9 +1299,16 @@ func (s *testimonialService) GetFeatured(ctx *gin.Context) ([]model.TestimonialR
 		return nil, err
 	}
 
+	isAdmin := authmiddleware.IsAdmin(ctx)
+
 	var responses []model.TestimonialResponse
 	for _, test := range testimonials {
-		responses = append(responses, *convertTestimonialToResponse(&test))
+		switch {
+		case isAdmin:
+			responses = append(responses, *convertTestimonialToResponse(&test))
+		case test.Status == "approved":
+			responses = append(responses, *publicTestimonialResponse(&test))
+		}
 	}
 
 	return responses, nil
@@ -1047,258 +1321,1466 @@ func (s *testimonialService) GetByStatus(ctx *gin.Context) ([]model.TestimonialR
 		return nil, err
 	}
 
-	var responses []model.TestimonialResponse
+	isAdmin := authmiddleware.IsAdmin(ctx)
+
+	responses := make([]model.TestimonialResponse, 0, len(testimonials))
 	for _, test := range testimonials {
-		responses = append(responses, *convertTestimonialToResponse(&test))
+		if isAdmin {
+			responses = append(responses, *convertTestimonialToResponse(&test))
+		} else {
+			responses = append(responses, *publicTestimonialResponse(&test))
+		}
 	}
 
 	return responses, nil
 }
 
-// ============================
-// BLOG SERVICE (no upload needed)
-// ============================
-
-type BlogService interface {
-	CreateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
-	GetByIDWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
-	GetBySlugWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
-	UpdateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
-	DeleteWithTags(ctx *gin.Context) error
-	GetAllWithTags(ctx *gin.Context) ([]model.BlogPostResponse, error)
-	GetPublishedWithTags(ctx *gin.Context) ([]model.BlogPostResponse, error)
-	GetAllTags(ctx *gin.Context) ([]model.TagResponse, error)
-}
-
-type blogService struct {
-	repo repo.BlogRepository
-}
-
-func NewBlogService(repo repo.BlogRepository) BlogService {
-	return &blogService{repo: repo}
-}
-
-func (s *blogService) CreateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
-	var req model.BlogPostRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+// Submit menerima testimoni dari form publik (JSON atau multipart dengan
+// field file "avatar"). Status selalu pending, apa pun isi request.
+func (s *testimonialService) Submit(ctx *gin.Context) (*model.TestimonialSubmissionResponse, error) {
+	var form model.TestimonialSubmissionForm
+	if err := utils.Bind(ctx, &form); err != nil {
 		return nil, err
 	}
 
-	post := &model.BlogPost{
-		Title:         req.Title,
-		Content:       req.Content,
-		Excerpt:       req.Excerpt,
-		Slug:          req.Slug,
-		FeaturedImage: req.FeaturedImage,
-		PublishDate:   req.PublishDate,
-		Status:        req.Status,
-	}
-
-	if post.Status == "" {
-		post.Status = "draft"
+	test := &model.Testimonial{
+		Name:      strings.TrimSpace(form.Name),
+		Title:     strings.TrimSpace(form.Title),
+		Message:   strings.TrimSpace(form.Message),
+		Rating:    form.Rating,
+		Email:     strings.TrimSpace(form.Email),
+		Status:    "pending",
+		Source:    "public",
+		UserAgent: ctx.Request.UserAgent(),
 	}
 
-	for _, tagReq := range req.Tags {
-		post.Tags = append(post.Tags, model.BlogTag{Name: tagReq.Name})
+	if test.Name == "" || test.Message == "" {
+		return nil, utils.RequireFields("name", test.Name, "message", test.Message)
 	}
 
-	if err := s.repo.CreateWithTags(post); err != nil {
+	if err := s.saveSubmission(ctx, test); err != nil {
 		return nil, err
 	}
 
-	return convertBlogToResponse(post), nil
+	return &model.TestimonialSubmissionResponse{
+		ID:        test.ID,
+		Status:    test.Status,
+		CreatedAt: test.CreatedAt,
+	}, nil
 }
 
-func (s *blogService) GetByIDWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
-	id, err := uuid.Parse(ctx.Param("id"))
-	if err != nil {
-		return nil, errors.New("invalid post ID")
+// saveSubmission melengkapi testimoni dari publik dengan IP dan avatar lalu
+// menyimpannya. Avatar yang sudah terunggah dihapus lagi jika insert gagal.
+func (s *testimonialService) saveSubmission(ctx *gin.Context, test *model.Testimonial) error {
+	if ip := ctx.ClientIP(); ip != "" {
+		test.IPAddress = &ip
 	}
 
-	post, err := s.repo.GetByIDWithTags(id)
+	avatarURL, err := s.uploadAvatar(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	test.AvatarURL = avatarURL
 
-	// Increment view count
-	_ = s.repo.IncrementViewCount(id)
-
-	return convertBlogToResponse(post), nil
+	if err := s.repo.Create(test); err != nil {
+		if avatarURL != "" {
+			s.uploadService.DeleteFile(avatarURL)
+		}
+		return fmt.Errorf("gagal menyimpan testimonial: %w", err)
+	}
+	return nil
 }
 
-func (s *blogService) GetBySlugWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
-	slug := ctx.Param("slug")
+// uploadAvatar mengunggah avatar opsional. Karena berasal dari publik, isi
+// file juga dicek harus gambar (bukan hanya ekstensinya).
+func (s *testimonialService) uploadAvatar(ctx *gin.Context) (string, error) {
+	if ctx.ContentType() != gin.MIMEMultipartPOSTForm {
+		return "", nil
+	}
 
-	post, err := s.repo.GetBySlugWithTags(slug)
+	file, err := ctx.FormFile("avatar")
+	if err == http.ErrMissingFile {
+		return "", nil
+	}
 	if err != nil {
-		return nil, err
+		return "", utils.BadRequestKey("file_read_failed", "field", "avatar").WithCause(err)
 	}
 
-	// Increment view count
-	_ = s.repo.IncrementViewCount(post.ID)
-
-	return convertBlogToResponse(post), nil
-}
+	allowedExts := []string{".jpg", ".jpeg", ".png", ".webp"}
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	allowed := false
+	for _, candidate := range allowedExts {
+		allowed = allowed || ext == candidate
+	}
+	if !allowed {
+		return "", utils.FieldValidationError(ctx, "avatar", "oneof", strings.Join(allowedExts, " "))
+	}
+	if err := s.uploadService.ValidateFile(file, 2, allowedExts); err != nil {
+		return "", err
+	}
 
-func (s *blogService) UpdateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
-	id, err := uuid.Parse(ctx.Param("id"))
+	src, err := file.Open()
 	if err != nil {
-		return nil, errors.New("invalid post ID")
+		return "", utils.BadRequestKey("file_read_failed", "field", "avatar").WithCause(err)
+	}
+	head := make([]byte, 512)
+	n, _ := src.Read(head)
+	src.Close()
+	if !strings.HasPrefix(http.DetectContentType(head[:n]), "image/") {
+		return "", utils.BadRequestKey("image_required", "field", "avatar")
+	}
+
+	avatarURL, err := s.uploadService.UploadFile(file, "testimonials")
+	if err != nil {
+		return "", fmt.Errorf("gagal upload avatar: %w", err)
+	}
+	return avatarURL, nil
+}
+
+// Moderate menyetujui atau menolak testimoni. Alasan wajib saat rejected
+// dan dihapus lagi jika testimoni kemudian disetujui.
+func (s *testimonialService) Moderate(ctx *gin.Context) (*model.TestimonialResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("testimonial")
+	}
+
+	var req model.TestimonialModerationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	existing.Status = req.Status
+	existing.RejectionReason = ""
+	if req.Status == "rejected" {
+		existing.RejectionReason = strings.TrimSpace(req.Reason)
+	}
+	existing.ModeratedAt = &now
+
+	if err := s.repo.Update(existing); err != nil {
+		return nil, err
+	}
+
+	return convertTestimonialToResponse(existing), nil
+}
+
+// GetByProject untuk halaman project. Pengunjung anonim hanya melihat
+// testimoni approved dari project yang sudah published.
+func (s *testimonialService) GetByProject(ctx *gin.Context) ([]model.TestimonialResponse, error) {
+	projectID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("project")
+	}
+
+	project, err := s.projectRepo.GetProjekRepository(projectID)
+	if err != nil {
+		return nil, utils.NotFound("project")
+	}
+
+	isAdmin := authmiddleware.IsAdmin(ctx)
+	status := ""
+	if !isAdmin {
+		if project.Status != "published" {
+			return nil, utils.NotFound("project")
+		}
+		status = "approved"
+	}
+
+	testimonials, err := s.repo.GetByProject(projectID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.TestimonialResponse, 0, len(testimonials))
+	for i := range testimonials {
+		if isAdmin {
+			responses = append(responses, *convertTestimonialToResponse(&testimonials[i]))
+		} else {
+			responses = append(responses, *publicTestimonialResponse(&testimonials[i]))
+		}
+	}
+
+	return responses, nil
+}
+
+func (s *testimonialService) checkProject(projectID *uuid.UUID) error {
+	if projectID == nil {
+		return nil
+	}
+	if _, err := s.projectRepo.GetProjekRepository(*projectID); err != nil {
+		return utils.NotFound("project")
+	}
+	return nil
+}
+
+// ============================
+// BLOG SERVICE (no upload needed)
+// ============================
+
+type BlogService interface {
+	CreateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
+	GetByIDWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
+	GetBySlugWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
+	UpdateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
+	PatchWithTags(ctx *gin.Context) (*model.BlogPostResponse, error)
+	DeleteWithTags(ctx *gin.Context) error
+	GetAllWithTags(ctx *gin.Context) ([]model.BlogPostResponse, *utils.PageInfo, error)
+	GetPublishedWithTags(ctx *gin.Context) ([]model.BlogPostResponse, *utils.PageInfo, error)
+	GetAllTags(ctx *gin.Context) ([]model.TagUsage, error)
+	GetPublishedByTag(ctx *gin.Context) (*model.TagPostsResponse, error)
+	RenameTag(ctx *gin.Context) (*model.TagResponse, error)
+	MergeTags(ctx *gin.Context) (*model.TagResponse, error)
+	DeleteTag(ctx *gin.Context) error
+	GetDailyViews(ctx *gin.Context) (*model.BlogViewStatsResponse, error)
+}
+
+type blogService struct {
+	repo        repo.BlogRepository
+	seriesRepo  repo.BlogSeriesRepository
+	viewTracker *BlogViewTracker
+	previews    PreviewTokenService
+	localizer   utils.Localizer
+}
+
+func NewBlogService(repo repo.BlogRepository, seriesRepo repo.BlogSeriesRepository, viewTracker *BlogViewTracker, previews PreviewTokenService, localizer utils.Localizer) BlogService {
+	return &blogService{repo: repo, seriesRepo: seriesRepo, viewTracker: viewTracker, previews: previews, localizer: localizer}
+}
+
+func (s *blogService) CreateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
+	var req model.BlogPostRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	post := &model.BlogPost{
+		Title:         req.Title,
+		Content:       req.Content,
+		Excerpt:       req.Excerpt,
+		Slug:          req.Slug,
+		FeaturedImage: req.FeaturedImage,
+		PublishDate:   req.PublishDate,
+		Status:        req.Status,
+	}
+
+	if post.Status == "" {
+		post.Status = "draft"
+	}
+
+	for _, tagReq := range req.Tags {
+		post.Tags = append(post.Tags, model.BlogTag{Name: tagReq.Name})
+	}
+
+	if err := s.repo.CreateWithTags(post); err != nil {
+		return nil, err
+	}
+
+	return convertBlogToResponse(post), nil
+}
+
+func (s *blogService) GetByIDWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	post, err := s.repo.GetByIDWithTags(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.viewPost(ctx, post)
+}
+
+func (s *blogService) GetBySlugWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
+	slug := ctx.Param("slug")
+
+	post, err := s.repo.GetBySlugWithTags(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.viewPost(ctx, post)
+}
+
+// viewPost menentukan apakah post boleh dilihat oleh request ini.
+// Post yang belum published hanya bisa dilihat admin atau lewat preview token,
+// dan akses lewat preview token tidak dihitung sebagai view.
+func (s *blogService) viewPost(ctx *gin.Context, post *model.BlogPost) (*model.BlogPostResponse, error) {
+	if post.Status != "published" && !authmiddleware.IsAdmin(ctx) {
+		if !s.previews.Authorize(ctx, model.PreviewResourceBlogPost, post.ID) {
+			return nil, utils.NotFound("blog post")
+		}
+
+		response := s.buildDetailResponse(ctx, post)
+		response.Preview = true
+		return response, nil
+	}
+
+	s.viewTracker.Track(ctx, post)
+
+	return s.buildDetailResponse(ctx, post), nil
+}
+
+// buildDetailResponse menambahkan navigasi series dan related posts
+// untuk endpoint detail (tidak dipakai di endpoint list)
+func (s *blogService) buildDetailResponse(ctx *gin.Context, post *model.BlogPost) *model.BlogPostResponse {
+	response := convertBlogToResponse(post)
+
+	if series, err := s.seriesRepo.GetByPostID(post.ID); err == nil {
+		response.Series = buildSeriesNavigation(series, post.ID)
+	}
+
+	if candidates, err := s.repo.GetRelatedCandidates(post.ID, relatedSearchTerms(post), relatedCandidateLimit); err == nil {
+		response.Related = findRelatedPosts(post, candidates)
+	}
+
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableBlogPost)
+	localizeBlogPost(translations, response)
+	if response.Series != nil {
+		for _, nav := range []*model.SeriesPostSummary{response.Series.Prev, response.Series.Next} {
+			if nav != nil {
+				translations.Apply(nav.ID, "title", &nav.Title)
+			}
+		}
+	}
+	for i := range response.Related {
+		translations.Apply(response.Related[i].ID, "title", &response.Related[i].Title)
+		translations.Apply(response.Related[i].ID, "excerpt", &response.Related[i].Excerpt)
+	}
+
+	return response
+}
+
+// localizedBlogResponses dipakai semua endpoint list blog
+func (s *blogService) localizedBlogResponses(ctx *gin.Context, posts []model.BlogPost) []model.BlogPostResponse {
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableBlogPost)
+
+	responses := make([]model.BlogPostResponse, 0, len(posts))
+	for i := range posts {
+		response := convertBlogToResponse(&posts[i])
+		localizeBlogPost(translations, response)
+		responses = append(responses, *response)
+	}
+	return responses
+}
+
+func (s *blogService) UpdateWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	existing, err := s.repo.GetByIDWithTags(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	// PUT mengganti seluruh post termasuk tags; gunakan PATCH untuk
+	// mengubah sebagian field tanpa menghapus content/featured_image/tags
+	var req model.BlogPostRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return s.replace(existing, req)
+}
+
+// PatchWithTags menerapkan JSON Merge Patch; "tags" yang dikirim menggantikan
+// seluruh daftar tag, "tags": null menghapus semua tag
+func (s *blogService) PatchWithTags(ctx *gin.Context) (*model.BlogPostResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	existing, err := s.repo.GetByIDWithTags(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	req := blogPostToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return s.replace(existing, req)
+}
+
+func (s *blogService) replace(existing *model.BlogPost, req model.BlogPostRequest) (*model.BlogPostResponse, error) {
+	existing.Title = req.Title
+	existing.Content = req.Content
+	existing.Excerpt = req.Excerpt
+	existing.Slug = req.Slug
+	existing.FeaturedImage = req.FeaturedImage
+	existing.PublishDate = req.PublishDate
+	existing.Status = req.Status
+	if existing.Status == "" {
+		existing.Status = "draft"
+	}
+	existing.UpdatedAt = time.Now()
+
+	existing.Tags = nil
+	for _, tagReq := range req.Tags {
+		existing.Tags = append(existing.Tags, model.BlogTag{Name: tagReq.Name})
+	}
+
+	if err := s.repo.UpdateWithTags(existing); err != nil {
+		return nil, err
+	}
+
+	return convertBlogToResponse(existing), nil
+}
+
+func (s *blogService) DeleteWithTags(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return utils.InvalidID("post")
 	}
 
 	existing, err := s.repo.GetByIDWithTags(id)
 	if err != nil {
+		return utils.NotFound("post")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteWithTags(id, existing.Version)
+}
+
+func (s *blogService) GetAllWithTags(ctx *gin.Context) ([]model.BlogPostResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.BlogListSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Sengaja tidak memfilter status: admin UI memakai endpoint ini tanpa
+	// token untuk menampilkan draft. Daftar publik ada di /blog/published,
+	// draft hanya disembunyikan di endpoint detail (lihat preview token).
+	posts, page, err := s.repo.ListWithTags(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.localizedBlogResponses(ctx, posts), &page, nil
+}
+
+func (s *blogService) GetPublishedWithTags(ctx *gin.Context) ([]model.BlogPostResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, publishedBlogListSpec())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posts, page, err := s.repo.ListPublishedWithTags(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.localizedBlogResponses(ctx, posts), &page, nil
+}
+
+// publishedBlogListSpec sama dengan BlogListSpec, default urut publish_date
+func publishedBlogListSpec() utils.ListSpec {
+	spec := repo.BlogListSpec
+	spec.DefaultSort = "-publish_date"
+	return spec
+}
+
+func (s *blogService) GetAllTags(ctx *gin.Context) ([]model.TagUsage, error) {
+	tags, err := s.repo.GetTagUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	if tags == nil {
+		tags = []model.TagUsage{}
+	}
+
+	// Jumlah post draft hanya untuk admin
+	if !authmiddleware.IsAdmin(ctx) {
+		for i := range tags {
+			tags[i].PostCount = tags[i].PublishedCount
+		}
+	}
+
+	return tags, nil
+}
+
+func (s *blogService) GetPublishedByTag(ctx *gin.Context) (*model.TagPostsResponse, error) {
+	tag, err := s.repo.GetTagByName(ctx.Param("name"))
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.repo.GetPublishedByTag(tag.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TagPostsResponse{
+		Tag:   convertTagToResponse(tag),
+		Posts: s.localizedBlogResponses(ctx, posts),
+	}, nil
+}
+
+func (s *blogService) RenameTag(ctx *gin.Context) (*model.TagResponse, error) {
+	id, err := uuid.Parse(ctx.Param("tag_id"))
+	if err != nil {
+		return nil, utils.InvalidID("tag")
+	}
+
+	tag, err := s.repo.GetTagByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var req model.TagRenameRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	var req model.BlogPostUpdateRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	name := model.NormalizeTagName(req.Name)
+	if name == "" {
+		return nil, utils.RequireFields("name", name)
+	}
+
+	// Nama baru tidak boleh bentrok dengan tag lain, gunakan merge untuk itu
+	if other, err := s.repo.GetTagByName(name); err == nil && other.ID != tag.ID {
+		return nil, utils.ConflictKey("tag_exists", "name", other.Name)
+	}
+
+	if err := s.repo.RenameTag(tag.ID, name); err != nil {
+		return nil, err
+	}
+
+	tag.Name = name
+	response := convertTagToResponse(tag)
+	return &response, nil
+}
+
+func (s *blogService) MergeTags(ctx *gin.Context) (*model.TagResponse, error) {
+	var req model.TagMergeRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		return nil, utils.InvalidID("target tag")
+	}
+
+	target, err := s.repo.GetTagByID(targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceIDs []uuid.UUID
+	for _, idStr := range req.SourceIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, utils.InvalidID("source tag")
+		}
+		if id == targetID {
+			return nil, utils.BadRequestKey("tag_merge_self")
+		}
+		if _, err := s.repo.GetTagByID(id); err != nil {
+			return nil, utils.NotFound("tag " + idStr)
+		}
+		sourceIDs = append(sourceIDs, id)
+	}
+
+	if err := s.repo.MergeTags(sourceIDs, targetID); err != nil {
+		return nil, err
+	}
+
+	response := convertTagToResponse(target)
+	return &response, nil
+}
+
+func (s *blogService) DeleteTag(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("tag_id"))
+	if err != nil {
+		return utils.InvalidID("tag")
+	}
+
+	if _, err := s.repo.GetTagByID(id); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteTag(id)
+}
+
+func (s *blogService) GetDailyViews(ctx *gin.Context) (*model.BlogViewStatsResponse, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	if _, err := s.repo.GetByIDWithTags(id); err != nil {
+		return nil, err
+	}
+
+	days := 30
+	if daysStr := ctx.Query("days"); daysStr != "" {
+		days, err = strconv.Atoi(daysStr)
+		if err != nil || days < 1 || days > 365 {
+			return nil, utils.BadRequestKey("range", "field", "days", "min", "1", "max", "365")
+		}
+	}
+
+	return s.viewTracker.DailyViews(id, days)
+}
+
+// ============================
+// BLOG SERIES SERVICE
+// ============================
+
+type BlogSeriesService interface {
+	Create(ctx *gin.Context) (*model.BlogSeriesResponse, error)
+	GetByID(ctx *gin.Context) (*model.BlogSeriesResponse, error)
+	Update(ctx *gin.Context) (*model.BlogSeriesResponse, error)
+	Patch(ctx *gin.Context) (*model.BlogSeriesResponse, error)
+	Delete(ctx *gin.Context) error
+	GetAll(ctx *gin.Context) ([]model.BlogSeriesResponse, error)
+	SetPosts(ctx *gin.Context) (*model.BlogSeriesResponse, error)
+}
+
+type blogSeriesService struct {
+	repo     repo.BlogSeriesRepository
+	blogRepo repo.BlogRepository
+}
+
+func NewBlogSeriesService(repo repo.BlogSeriesRepository, blogRepo repo.BlogRepository) BlogSeriesService {
+	return &blogSeriesService{repo: repo, blogRepo: blogRepo}
+}
+
+func (s *blogSeriesService) Create(ctx *gin.Context) (*model.BlogSeriesResponse, error) {
+	var req model.BlogSeriesRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	series := &model.BlogSeries{
+		Title:       req.Title,
+		Slug:        req.Slug,
+		Description: req.Description,
+	}
+
+	if err := s.repo.Create(series); err != nil {
+		return nil, err
+	}
+
+	return convertBlogSeriesToResponse(series, true), nil
+}
+
+func (s *blogSeriesService) GetByID(ctx *gin.Context) (*model.BlogSeriesResponse, error) {
+	id, err := uuid.Parse(ctx.Param("series_id"))
+	if err != nil {
+		return nil, utils.InvalidID("series")
+	}
+
+	series, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBlogSeriesToResponse(series, authmiddleware.IsAdmin(ctx)), nil
+}
+
+func (s *blogSeriesService) Update(ctx *gin.Context) (*model.BlogSeriesResponse, error) {
+	id, err := uuid.Parse(ctx.Param("series_id"))
+	if err != nil {
+		return nil, utils.InvalidID("series")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	var req model.BlogSeriesRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return s.replace(existing, req)
+}
+
+// Patch menerapkan JSON Merge Patch pada series (tanpa mengubah urutan post)
+func (s *blogSeriesService) Patch(ctx *gin.Context) (*model.BlogSeriesResponse, error) {
+	id, err := uuid.Parse(ctx.Param("series_id"))
+	if err != nil {
+		return nil, utils.InvalidID("series")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
+	}
+
+	req := blogSeriesToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return s.replace(existing, req)
+}
+
+func (s *blogSeriesService) replace(existing *model.BlogSeries, req model.BlogSeriesRequest) (*model.BlogSeriesResponse, error) {
+	existing.Title = req.Title
+	existing.Slug = req.Slug
+	existing.Description = req.Description
+	existing.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(existing); err != nil {
+		return nil, err
+	}
+
+	return convertBlogSeriesToResponse(existing, true), nil
+}
+
+func (s *blogSeriesService) Delete(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("series_id"))
+	if err != nil {
+		return utils.InvalidID("series")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return utils.NotFound("series")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(id, existing.Version)
+}
+
+func (s *blogSeriesService) GetAll(ctx *gin.Context) ([]model.BlogSeriesResponse, error) {
+	series, err := s.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	includeDrafts := authmiddleware.IsAdmin(ctx)
+	responses := make([]model.BlogSeriesResponse, 0, len(series))
+	for i := range series {
+		responses = append(responses, *convertBlogSeriesToResponse(&series[i], includeDrafts))
+	}
+
+	return responses, nil
+}
+
+func (s *blogSeriesService) SetPosts(ctx *gin.Context) (*model.BlogSeriesResponse, error) {
+	id, err := uuid.Parse(ctx.Param("series_id"))
+	if err != nil {
+		return nil, utils.InvalidID("series")
+	}
+
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, err
+	}
+
+	var req model.BlogSeriesPostsRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.PostIDs))
+	postIDs := make([]uuid.UUID, 0, len(req.PostIDs))
+	for _, idStr := range req.PostIDs {
+		postID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, utils.InvalidID("post")
+		}
+		if seen[postID] {
+			return nil, utils.BadRequestKey("id_duplicate", "field", "post_ids", "id", idStr)
+		}
+		if _, err := s.blogRepo.GetByIDWithTags(postID); err != nil {
+			return nil, utils.NotFound("blog post " + idStr)
+		}
+		seen[postID] = true
+		postIDs = append(postIDs, postID)
+	}
+
+	if err := s.repo.SetPosts(id, postIDs); err != nil {
+		return nil, err
+	}
+
+	series, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBlogSeriesToResponse(series, true), nil
+}
+
+// buildSeriesNavigation menghitung posisi post di series beserta prev/next.
+// Hanya post yang sudah published yang ikut dihitung.
+func buildSeriesNavigation(series *model.BlogSeries, postID uuid.UUID) *model.BlogSeriesNavigation {
+	var published []model.BlogSeriesPost
+	for _, member := range series.Posts {
+		if member.Post.Status == "published" || member.PostID == postID {
+			published = append(published, member)
+		}
+	}
+
+	index := -1
+	for i, member := range published {
+		if member.PostID == postID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+
+	nav := &model.BlogSeriesNavigation{
+		ID:       series.ID,
+		Title:    series.Title,
+		Slug:     series.Slug,
+		Position: index + 1,
+		Total:    len(published),
+	}
+
+	if index > 0 {
+		prev := convertSeriesPostToSummary(&published[index-1])
+		nav.Prev = &prev
+	}
+	if index < len(published)-1 {
+		next := convertSeriesPostToSummary(&published[index+1])
+		nav.Next = &next
+	}
+
+	return nav
+}
+
+// ============================
+// BLOG COMMENTS SERVICE
+// ============================
+
+var blogCommentStatuses = map[string]bool{
+	"pending":  true,
+	"approved": true,
+	"spam":     true,
+}
+
+type BlogCommentService interface {
+	Create(ctx *gin.Context) (*model.BlogCommentResponse, error)
+	GetApprovedByPost(ctx *gin.Context) ([]model.BlogCommentResponse, error)
+	GetByStatus(ctx *gin.Context) ([]model.BlogCommentAdminResponse, error)
+	UpdateStatus(ctx *gin.Context) (*model.BlogCommentAdminResponse, error)
+	Delete(ctx *gin.Context) error
+}
+
+type blogCommentService struct {
+	repo     repo.BlogCommentRepository
+	blogRepo repo.BlogRepository
+}
+
+func NewBlogCommentService(repo repo.BlogCommentRepository, blogRepo repo.BlogRepository) BlogCommentService {
+	return &blogCommentService{
+		repo:     repo,
+		blogRepo: blogRepo,
+	}
+}
+
+func (s *blogCommentService) Create(ctx *gin.Context) (*model.BlogCommentResponse, error) {
+	postID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	post, err := s.blogRepo.GetByIDWithTags(postID)
+	if err != nil || post.Status != "published" {
+		return nil, utils.NotFound("blog post")
+	}
+
+	var req model.BlogCommentRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	comment := &model.BlogComment{
+		PostID:      postID,
+		AuthorName:  strings.TrimSpace(req.AuthorName),
+		AuthorEmail: strings.TrimSpace(req.AuthorEmail),
+		Content:     strings.TrimSpace(req.Content),
+		Status:      "pending",
+		UserAgent:   ctx.Request.UserAgent(),
+	}
+
+	if comment.AuthorName == "" || comment.Content == "" {
+		return nil, utils.RequireFields("author_name", comment.AuthorName, "content", comment.Content)
+	}
+
+	if ip := ctx.ClientIP(); ip != "" {
+		comment.IPAddress = &ip
+	}
+
+	if req.ParentID != "" {
+		parentID, err := uuid.Parse(req.ParentID)
+		if err != nil {
+			return nil, utils.InvalidID("parent comment")
+		}
+
+		parent, err := s.repo.GetByID(parentID)
+		if err != nil || parent.PostID != postID || parent.Status != "approved" {
+			return nil, utils.NotFound("parent comment")
+		}
+		comment.ParentID = &parentID
+	}
+
+	if err := s.repo.Create(comment); err != nil {
+		return nil, err
+	}
+
+	response := convertBlogCommentToResponse(comment)
+	return &response, nil
+}
+
+func (s *blogCommentService) GetApprovedByPost(ctx *gin.Context) ([]model.BlogCommentResponse, error) {
+	postID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("post")
+	}
+
+	comments, err := s.repo.GetApprovedByPost(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBlogCommentTree(comments), nil
+}
+
+func (s *blogCommentService) GetByStatus(ctx *gin.Context) ([]model.BlogCommentAdminResponse, error) {
+	status := ctx.Param("status")
+	if !blogCommentStatuses[status] {
+		return nil, utils.BadRequestKey("oneof", "field", "status", "param", "pending, approved, spam")
+	}
+
+	comments, err := s.repo.GetByStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.BlogCommentAdminResponse, 0, len(comments))
+	for i := range comments {
+		responses = append(responses, *convertBlogCommentToAdminResponse(&comments[i]))
+	}
+
+	return responses, nil
+}
+
+func (s *blogCommentService) UpdateStatus(ctx *gin.Context) (*model.BlogCommentAdminResponse, error) {
+	id, err := uuid.Parse(ctx.Param("comment_id"))
+	if err != nil {
+		return nil, utils.InvalidID("comment")
+	}
+
+	var req model.BlogCommentStatusRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStatus(id, req.Status); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBlogCommentToAdminResponse(comment), nil
+}
+
+func (s *blogCommentService) Delete(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("comment_id"))
+	if err != nil {
+		return utils.InvalidID("comment")
+	}
+
+	if err := s.repo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.NotFound("comment")
+		}
+		return err
+	}
+	return nil
+}
+
+// buildBlogCommentTree menyusun komentar menjadi thread. Balasan yang
+// parent-nya tidak disetujui ikut disembunyikan.
+func buildBlogCommentTree(comments []model.BlogComment) []model.BlogCommentResponse {
+	children := make(map[uuid.UUID][]model.BlogComment)
+	var roots []model.BlogComment
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			roots = append(roots, comment)
+			continue
+		}
+		children[*comment.ParentID] = append(children[*comment.ParentID], comment)
+	}
+
+	var build func(comment model.BlogComment) model.BlogCommentResponse
+	build = func(comment model.BlogComment) model.BlogCommentResponse {
+		response := convertBlogCommentToResponse(&comment)
+		for _, child := range children[comment.ID] {
+			response.Replies = append(response.Replies, build(child))
+		}
+		return response
+	}
+
+	responses := make([]model.BlogCommentResponse, 0, len(roots))
+	for _, root := range roots {
+		responses = append(responses, build(root))
+	}
+
+	return responses
+}
+
+// ============================
+// PREVIEW TOKENS SERVICE
+// ============================
+
+const defaultPreviewTokenTTL = 72 * time.Hour
+
+type PreviewTokenService interface {
+	Create(ctx *gin.Context) (*model.PreviewTokenResponse, error)
+	GetAll(ctx *gin.Context) ([]model.PreviewTokenResponse, error)
+	Revoke(ctx *gin.Context) error
+	Authorize(ctx *gin.Context, resourceType string, resourceID uuid.UUID) bool
+}
+
+type previewTokenService struct {
+	repo        repo.PreviewTokenRepository
+	blogRepo    repo.BlogRepository
+	projectRepo projectrepo.Repository
+}
+
+func NewPreviewTokenService(repo repo.PreviewTokenRepository, blogRepo repo.BlogRepository, projectRepo projectrepo.Repository) PreviewTokenService {
+	return &previewTokenService{repo: repo, blogRepo: blogRepo, projectRepo: projectRepo}
+}
+
+func (s *previewTokenService) Create(ctx *gin.Context) (*model.PreviewTokenResponse, error) {
+	var req model.PreviewTokenRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	resourceID, err := uuid.Parse(req.ResourceID)
+	if err != nil {
+		return nil, utils.InvalidID("resource")
+	}
+
+	// Pastikan resource yang mau di-preview memang ada
+	switch req.ResourceType {
+	case model.PreviewResourceBlogPost:
+		if _, err := s.blogRepo.GetByIDWithTags(resourceID); err != nil {
+			return nil, utils.NotFound("blog post")
+		}
+	case model.PreviewResourceProject:
+		if _, err := s.projectRepo.GetProjekRepository(resourceID); err != nil {
+			return nil, utils.NotFound("project")
+		}
+	}
+
+	ttl := defaultPreviewTokenTTL
+	if req.ExpiresInHours > 0 {
+		ttl = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	plainToken, err := newSecretToken()
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuat preview token: %w", err)
+	}
+
+	token := &model.PreviewToken{
+		TokenHash:    hashSecretToken(plainToken),
+		ResourceType: req.ResourceType,
+		ResourceID:   resourceID,
+		Note:         req.Note,
+		ExpiresAt:    time.Now().Add(ttl),
+	}
+
+	if err := s.repo.Create(token); err != nil {
+		return nil, err
+	}
+
+	response := convertPreviewTokenToResponse(token)
+	response.Token = plainToken
+	return &response, nil
+}
+
+func (s *previewTokenService) GetAll(ctx *gin.Context) ([]model.PreviewTokenResponse, error) {
+	var resourceID *uuid.UUID
+	if idStr := ctx.Query("resource_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, utils.InvalidID("resource")
+		}
+		resourceID = &id
+	}
+
+	tokens, err := s.repo.GetAll(ctx.Query("resource_type"), resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.PreviewTokenResponse, 0, len(tokens))
+	for i := range tokens {
+		responses = append(responses, convertPreviewTokenToResponse(&tokens[i]))
+	}
+
+	return responses, nil
+}
+
+func (s *previewTokenService) Revoke(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("token_id"))
+	if err != nil {
+		return utils.InvalidID("preview token")
+	}
+
+	return s.repo.Revoke(id)
+}
+
+// Authorize mengecek preview token dari query ?preview_token= atau header
+// X-Preview-Token untuk resource tertentu
+func (s *previewTokenService) Authorize(ctx *gin.Context, resourceType string, resourceID uuid.UUID) bool {
+	plainToken := ctx.Query("preview_token")
+	if plainToken == "" {
+		plainToken = ctx.GetHeader("X-Preview-Token")
+	}
+	if plainToken == "" {
+		return false
+	}
+
+	token, err := s.repo.FindActive(hashSecretToken(plainToken), resourceType, resourceID)
+	if err != nil {
+		return false
+	}
+
+	if err := s.repo.MarkUsed(token.ID); err != nil {
+		fmt.Printf("⚠️ Warning: gagal update last_used_at preview token: %v\n", err)
+	}
+
+	return true
+}
+
+// newSecretToken membuat token acak untuk link yang dibagikan ke luar
+// (preview, invite testimoni). Database hanya menyimpan hashSecretToken-nya.
+func newSecretToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ============================
+// SEARCH SERVICE
+// ============================
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+var searchTypes = map[string]bool{
+	model.SearchTypeProject:    true,
+	model.SearchTypeBlogPost:   true,
+	model.SearchTypeExperience: true,
+	model.SearchTypeSkill:      true,
+}
+
+type SearchService interface {
+	Search(ctx *gin.Context) (*model.SearchResponse, error)
+}
+
+type searchService struct {
+	repo repo.SearchRepository
+}
+
+func NewSearchService(repo repo.SearchRepository) SearchService {
+	return &searchService{repo: repo}
+}
+
+func (s *searchService) Search(ctx *gin.Context) (*model.SearchResponse, error) {
+	query := strings.TrimSpace(ctx.Query("q"))
+	if len([]rune(query)) < 2 {
+		return nil, utils.BadRequestKey("min.string", "field", "q", "param", "2")
+	}
+	if len([]rune(query)) > 200 {
+		return nil, utils.BadRequestKey("max.string", "field", "q", "param", "200")
+	}
+
+	limit := defaultSearchLimit
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil || l < 1 || l > maxSearchLimit {
+			return nil, utils.BadRequestKey("range", "field", "limit", "min", "1", "max", strconv.Itoa(maxSearchLimit))
+		}
+		limit = l
+	}
+
+	offset := 0
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		o, err := strconv.Atoi(offsetStr)
+		if err != nil || o < 0 {
+			return nil, utils.BadRequestKey("invalid_field", "field", "offset")
+		}
+		offset = o
+	}
+
+	var types []string
+	if typeStr := ctx.Query("type"); typeStr != "" {
+		for _, t := range strings.Split(typeStr, ",") {
+			t = strings.TrimSpace(t)
+			if !searchTypes[t] {
+				return nil, utils.BadRequestKey("unsupported_value", "field", "type", "value", t)
+			}
+			types = append(types, t)
+		}
+	}
+
+	// Draft hanya ikut dicari untuk admin
+	results, facets, err := s.repo.Search(query, types, authmiddleware.IsAdmin(ctx), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.SearchResponse{
+		Query:   query,
+		Results: results,
+		Facets:  facets,
+		Limit:   limit,
+		Offset:  offset,
+	}
+	if response.Results == nil {
+		response.Results = []model.SearchResult{}
+	}
+	if response.Facets == nil {
+		response.Facets = []model.SearchFacet{}
+	}
+
+	// Total mengikuti filter type yang dipilih
+	selected := make(map[string]bool)
+	for _, t := range types {
+		selected[t] = true
+	}
+	for _, facet := range response.Facets {
+		if len(selected) == 0 || selected[facet.Type] {
+			response.Total += facet.Count
+		}
+	}
+
+	return response, nil
+}
+
+// ============================
+// SECTIONS SERVICE (no upload needed)
+// ============================
+// Section nonaktif disembunyikan dari pengunjung anonim, tapi tetap bisa
+// dikelola admin.
+
+type SectionService interface {
+	Create(ctx *gin.Context) (*model.SectionResponse, error)
+	GetByID(ctx *gin.Context) (*model.SectionResponse, error)
+	Update(ctx *gin.Context) (*model.SectionResponse, error)
+	Patch(ctx *gin.Context) (*model.SectionResponse, error)
+	SetActive(ctx *gin.Context) (*model.SectionResponse, error)
+	Delete(ctx *gin.Context) error
+	GetAll(ctx *gin.Context) ([]model.SectionResponse, *utils.PageInfo, error)
+}
+
+type sectionService struct {
+	repo      repo.SectionRepository
+	localizer utils.Localizer
+}
+
+func NewSectionService(repo repo.SectionRepository, localizer utils.Localizer) SectionService {
+	return &sectionService{repo: repo, localizer: localizer}
+}
+
+func (s *sectionService) Create(ctx *gin.Context) (*model.SectionResponse, error) {
+	var req model.SectionRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	if req.Title != "" {
-		existing.Title = req.Title
-	}
-	existing.Content = req.Content
-	existing.Excerpt = req.Excerpt
-	if req.Slug != "" {
-		existing.Slug = req.Slug
-	}
-	existing.FeaturedImage = req.FeaturedImage
-	if !req.PublishDate.IsZero() {
-		existing.PublishDate = req.PublishDate
-	}
-	if req.Status != "" {
-		existing.Status = req.Status
-	}
-	existing.UpdatedAt = time.Now()
-
-	existing.Tags = nil
-	for _, tagReq := range req.Tags {
-		existing.Tags = append(existing.Tags, model.BlogTag{Name: tagReq.Name})
+	section := &model.Section{
+		SectionID:    req.SectionID,
+		Label:        req.Label,
+		DisplayOrder: req.DisplayOrder,
+		IsActive:     req.IsActive == nil || *req.IsActive,
 	}
 
-	if err := s.repo.UpdateWithTags(existing); err != nil {
+	if err := s.repo.Create(section); err != nil {
 		return nil, err
 	}
 
-	return convertBlogToResponse(existing), nil
+	return convertSectionToResponse(section), nil
 }
 
-func (s *blogService) DeleteWithTags(ctx *gin.Context) error {
+func (s *sectionService) GetByID(ctx *gin.Context) (*model.SectionResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return errors.New("invalid post ID")
+		return nil, utils.InvalidID("section")
 	}
 
-	return s.repo.DeleteWithTags(id)
-}
-
-func (s *blogService) GetAllWithTags(ctx *gin.Context) ([]model.BlogPostResponse, error) {
-	posts, err := s.repo.GetAllWithTags()
+	section, err := s.repo.GetByID(id)
 	if err != nil {
-		return nil, err
+		return nil, utils.NotFound("section")
 	}
 
-	var responses []model.BlogPostResponse
-	for _, post := range posts {
-		responses = append(responses, *convertBlogToResponse(&post))
+	if !section.IsActive && !authmiddleware.IsAdmin(ctx) {
+		return nil, utils.NotFound("section")
 	}
 
-	return responses, nil
+	response := convertSectionToResponse(section)
+	utils.Localize(s.localizer, ctx, utils.TranslatableSection).Apply(response.ID, "label", &response.Label)
+	return response, nil
 }
 
-func (s *blogService) GetPublishedWithTags(ctx *gin.Context) ([]model.BlogPostResponse, error) {
-	posts, err := s.repo.GetPublishedWithTags()
+func (s *sectionService) Update(ctx *gin.Context) (*model.SectionResponse, error) {
+	existing, err := s.loadForWrite(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var responses []model.BlogPostResponse
-	for _, post := range posts {
-		responses = append(responses, *convertBlogToResponse(&post))
+	var req model.SectionRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
 	}
 
-	return responses, nil
+	return s.replace(existing, req)
 }
 
-func (s *blogService) GetAllTags(ctx *gin.Context) ([]model.TagResponse, error) {
-	tags, err := s.repo.GetAllTags()
+// Patch menerapkan JSON Merge Patch pada section
+func (s *sectionService) Patch(ctx *gin.Context) (*model.SectionResponse, error) {
+	existing, err := s.loadForWrite(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var responses []model.TagResponse
-	for _, tag := range tags {
-		responses = append(responses, model.TagResponse{
-			ID:        tag.ID,
-			Name:      tag.Name,
-			CreatedAt: tag.CreatedAt,
-		})
+	req := sectionToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
 	}
 
-	return responses, nil
+	return s.replace(existing, req)
 }
 
-// ============================
-// SECTIONS SERVICE (no upload needed)
-// ============================
+func (s *sectionService) SetActive(ctx *gin.Context) (*model.SectionResponse, error) {
+	existing, err := s.loadForWrite(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-type SectionService interface {
-	Create(ctx *gin.Context) (*model.SectionResponse, error)
-	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.SectionResponse, error)
-}
+	var req model.ActivationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
 
-type sectionService struct {
-	repo repo.SectionRepository
-}
+	existing.IsActive = *req.IsActive
+	if err := s.repo.Update(existing); err != nil {
+		return nil, err
+	}
 
-func NewSectionService(repo repo.SectionRepository) SectionService {
-	return &sectionService{repo: repo}
+	return convertSectionToResponse(existing), nil
 }
 
-func (s *sectionService) Create(ctx *gin.Context) (*model.SectionResponse, error) {
-	var req model.SectionRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		return nil, err
+func (s *sectionService) loadForWrite(ctx *gin.Context) (*model.Section, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("section")
 	}
 
-	section := &model.Section{
-		SectionID:    req.SectionID,
-		Label:        req.Label,
-		DisplayOrder: req.DisplayOrder,
-		IsActive:     req.IsActive,
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, utils.NotFound("section")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
 	}
+	return existing, nil
+}
 
-	if err := s.repo.Create(section); err != nil {
+func (s *sectionService) replace(existing *model.Section, req model.SectionRequest) (*model.SectionResponse, error) {
+	existing.SectionID = req.SectionID
+	existing.Label = req.Label
+	existing.DisplayOrder = req.DisplayOrder
+	existing.IsActive = req.IsActive == nil || *req.IsActive
+
+	if err := s.repo.Update(existing); err != nil {
 		return nil, err
 	}
 
-	return convertSectionToResponse(section), nil
+	return convertSectionToResponse(existing), nil
 }
 
 func (s *sectionService) Delete(ctx *gin.Context) error {
-	id, err := uuid.Parse(ctx.Param("id"))
+	existing, err := s.loadForWrite(ctx)
 	if err != nil {
-		return errors.New("invalid section ID")
+		return err
 	}
 
-	return s.repo.Delete(id)
+	return s.repo.Delete(existing.ID)
 }
 
-func (s *sectionService) GetAll(ctx *gin.Context) ([]model.SectionResponse, error) {
-	sections, err := s.repo.GetAll()
+func (s *sectionService) GetAll(ctx *gin.Context) ([]model.SectionResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.SectionListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if !authmiddleware.IsAdmin(ctx) {
+		q.ForceFilter(repo.SectionListSpec, "is_active", "true")
+	}
+
+	sections, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var responses []model.SectionResponse
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableSection)
+
+	responses := make([]model.SectionResponse, 0, len(sections))
 	for _, section := range sections {
-		responses = append(responses, *convertSectionToResponse(&section))
+		response := convertSectionToResponse(&section)
+		translations.Apply(response.ID, "label", &response.Label)
+		responses = append(responses, *response)
 	}
 
-	return responses, nil
+	return responses, &page, nil
 }
 
 // ============================
@@ -1307,8 +2789,12 @@ func (s *sectionService) GetAll(ctx *gin.Context) ([]model.SectionResponse, erro
 
 type SocialLinkService interface {
 	Create(ctx *gin.Context) (*model.SocialLinkResponse, error)
+	GetByID(ctx *gin.Context) (*model.SocialLinkResponse, error)
+	Update(ctx *gin.Context) (*model.SocialLinkResponse, error)
+	Patch(ctx *gin.Context) (*model.SocialLinkResponse, error)
+	SetActive(ctx *gin.Context) (*model.SocialLinkResponse, error)
 	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.SocialLinkResponse, error)
+	GetAll(ctx *gin.Context) ([]model.SocialLinkResponse, *utils.PageInfo, error)
 }
 
 type socialLinkService struct {
@@ -1321,16 +2807,13 @@ func NewSocialLinkService(repo repo.SocialLinkRepository) SocialLinkService {
 
 func (s *socialLinkService) Create(ctx *gin.Context) (*model.SocialLinkResponse, error) {
 	var req model.SocialLinkRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	link := &model.SocialLink{
-		Platform:     req.Platform,
-		URL:          req.URL,
-		IconName:     req.IconName,
-		DisplayOrder: req.DisplayOrder,
-		IsActive:     req.IsActive,
+	link := &model.SocialLink{}
+	if err := applySocialLinkRequest(link, req); err != nil {
+		return nil, err
 	}
 
 	if err := s.repo.Create(link); err != nil {
@@ -1340,99 +2823,164 @@ func (s *socialLinkService) Create(ctx *gin.Context) (*model.SocialLinkResponse,
 	return convertSocialLinkToResponse(link), nil
 }
 
-func (s *socialLinkService) Delete(ctx *gin.Context) error {
+func (s *socialLinkService) GetByID(ctx *gin.Context) (*model.SocialLinkResponse, error) {
 	id, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
-		return errors.New("invalid social link ID")
+		return nil, utils.InvalidID("social link")
+	}
+
+	link, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, utils.NotFound("social link")
+	}
+
+	if !link.IsActive && !authmiddleware.IsAdmin(ctx) {
+		return nil, utils.NotFound("social link")
 	}
 
-	return s.repo.Delete(id)
+	return convertSocialLinkToResponse(link), nil
 }
 
-func (s *socialLinkService) GetAll(ctx *gin.Context) ([]model.SocialLinkResponse, error) {
-	links, err := s.repo.GetAll()
+func (s *socialLinkService) Update(ctx *gin.Context) (*model.SocialLinkResponse, error) {
+	existing, err := s.loadForWrite(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var responses []model.SocialLinkResponse
-	for _, link := range links {
-		responses = append(responses, *convertSocialLinkToResponse(&link))
+	var req model.SocialLinkRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
 	}
 
-	return responses, nil
+	return s.replace(existing, req)
 }
 
-// ============================
-// SETTINGS SERVICE (no upload needed)
-// ============================
+// Patch menerapkan JSON Merge Patch pada social link
+func (s *socialLinkService) Patch(ctx *gin.Context) (*model.SocialLinkResponse, error) {
+	existing, err := s.loadForWrite(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-type SettingService interface {
-	Create(ctx *gin.Context) (*model.SettingResponse, error)
-	Delete(ctx *gin.Context) error
-	GetAll(ctx *gin.Context) ([]model.SettingResponse, error)
-}
+	req := socialLinkToRequest(existing)
+	if err := utils.BindMergePatch(ctx, &req); err != nil {
+		return nil, err
+	}
 
-type settingService struct {
-	repo repo.SettingRepository
+	return s.replace(existing, req)
 }
 
-func NewSettingService(repo repo.SettingRepository) SettingService {
-	return &settingService{repo: repo}
-}
+func (s *socialLinkService) SetActive(ctx *gin.Context) (*model.SocialLinkResponse, error) {
+	existing, err := s.loadForWrite(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *settingService) Create(ctx *gin.Context) (*model.SettingResponse, error) {
-	var req model.SettingRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	var req model.ActivationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
 		return nil, err
 	}
 
-	setting := &model.Setting{
-		Key:         req.Key,
-		Value:       req.Value,
-		DataType:    req.DataType,
-		Description: req.Description,
+	existing.IsActive = *req.IsActive
+	if err := s.repo.Update(existing); err != nil {
+		return nil, err
+	}
+
+	return convertSocialLinkToResponse(existing), nil
+}
+
+func (s *socialLinkService) loadForWrite(ctx *gin.Context) (*model.SocialLink, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("social link")
+	}
+
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, utils.NotFound("social link")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, err
 	}
+	return existing, nil
+}
 
-	if setting.DataType == "" {
-		setting.DataType = "string"
+func (s *socialLinkService) replace(existing *model.SocialLink, req model.SocialLinkRequest) (*model.SocialLinkResponse, error) {
+	if err := applySocialLinkRequest(existing, req); err != nil {
+		return nil, err
 	}
 
-	if err := s.repo.Create(setting); err != nil {
+	if err := s.repo.Update(existing); err != nil {
 		return nil, err
 	}
 
-	return convertSettingToResponse(setting), nil
+	return convertSocialLinkToResponse(existing), nil
 }
 
-func (s *settingService) Delete(ctx *gin.Context) error {
-	id, err := uuid.Parse(ctx.Param("id"))
+func (s *socialLinkService) Delete(ctx *gin.Context) error {
+	existing, err := s.loadForWrite(ctx)
 	if err != nil {
-		return errors.New("invalid setting ID")
+		return err
 	}
 
-	return s.repo.Delete(id)
+	return s.repo.Delete(existing.ID)
 }
 
-func (s *settingService) GetAll(ctx *gin.Context) ([]model.SettingResponse, error) {
-	settings, err := s.repo.GetAll()
+func (s *socialLinkService) GetAll(ctx *gin.Context) ([]model.SocialLinkResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.SocialLinkListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var responses []model.SettingResponse
-	for _, setting := range settings {
-		responses = append(responses, *convertSettingToResponse(&setting))
+	if !authmiddleware.IsAdmin(ctx) {
+		q.ForceFilter(repo.SocialLinkListSpec, "is_active", "true")
 	}
 
-	return responses, nil
+	links, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses := make([]model.SocialLinkResponse, 0, len(links))
+	for _, link := range links {
+		responses = append(responses, *convertSocialLinkToResponse(&link))
+	}
+
+	return responses, &page, nil
+}
+
+func applySocialLinkRequest(link *model.SocialLink, req model.SocialLinkRequest) error {
+	platform := strings.TrimSpace(req.Platform)
+	url := strings.TrimSpace(req.URL)
+	if err := checkSocialLinkURL(platform, url); err != nil {
+		return err
+	}
+
+	link.Platform = platform
+	link.URL = url
+	link.IconName = req.IconName
+	link.DisplayOrder = req.DisplayOrder
+	link.IsActive = req.IsActive == nil || *req.IsActive
+	return nil
 }
 
 // ============================
 // HELPER FUNCTIONS
 // ============================
 
-func convertEducationToResponse(edu *model.Education) *model.EducationResponse {
+// educationDates: start_date opsional untuk pendidikan; end_date tetap wajib
+// jika start_date diisi dan is_ongoing false
+func educationDates(req model.EducationRequest) (utils.DateRange, error) {
+	return utils.ParseDateRange(req.StartDate, req.EndDate, req.IsOngoing, "start_date", "end_date")
+}
+
+func convertEducationToResponse(edu *model.Education, dates utils.DateFormatter) *model.EducationResponse {
+	var endDate *string
+	if edu.EndDate != nil {
+		value := utils.FormatMonthDate(edu.EndDate, edu.DatePrecision)
+		endDate = &value
+	}
+
 	var achievements []model.AchievementResponse
 	for _, ach := range edu.Achievements {
 		achievements = append(achievements, model.AchievementResponse{
@@ -1440,22 +2988,26 @@ func convertEducationToResponse(edu *model.Education) *model.EducationResponse {
 			EducationID:  ach.EducationID,
 			Achievement:  ach.Achievement,
 			DisplayOrder: ach.DisplayOrder,
-			CreatedAt:    ach.CreatedAt,
+			CreatedAt:    dates.Time(ach.CreatedAt),
 		})
 	}
 
 	return &model.EducationResponse{
-		ID:           edu.ID,
-		School:       edu.School,
-		Major:        edu.Major,
-		StartYear:    edu.StartYear,
-		EndYear:      edu.EndYear,
-		Description:  edu.Description,
-		Degree:       edu.Degree,
-		DisplayOrder: edu.DisplayOrder,
-		Achievements: achievements,
-		CreatedAt:    edu.CreatedAt,
-		UpdatedAt:    edu.UpdatedAt,
+		ID:            edu.ID,
+		School:        edu.School,
+		Major:         edu.Major,
+		StartDate:     utils.FormatMonthDate(edu.StartDate, edu.DatePrecision),
+		EndDate:       endDate,
+		IsOngoing:     edu.IsOngoing,
+		DatePrecision: edu.DatePrecision,
+		Period:        dates.Period(edu.DateRange()),
+		Description:   edu.Description,
+		Degree:        edu.Degree,
+		DisplayOrder:  edu.DisplayOrder,
+		Achievements:  achievements,
+		Version:       edu.Version,
+		CreatedAt:     dates.Time(edu.CreatedAt),
+		UpdatedAt:     dates.Time(edu.UpdatedAt),
 	}
 }
 
@@ -1470,10 +3022,28 @@ func convertTestimonialToResponse(test *model.Testimonial) *model.TestimonialRes
 		IsFeatured:   test.IsFeatured,
 		DisplayOrder: test.DisplayOrder,
 		Status:       test.Status,
+		ProjectID:    test.ProjectID,
+		IsVerified:   test.IsVerified,
+		Version:      test.Version,
 		CreatedAt:    test.CreatedAt,
+
+		Email:           test.Email,
+		Source:          test.Source,
+		RejectionReason: test.RejectionReason,
+		ModeratedAt:     test.ModeratedAt,
 	}
 }
 
+// publicTestimonialResponse tanpa email dan data moderasi
+func publicTestimonialResponse(test *model.Testimonial) *model.TestimonialResponse {
+	response := convertTestimonialToResponse(test)
+	response.Email = ""
+	response.Source = ""
+	response.RejectionReason = ""
+	response.ModeratedAt = nil
+	return response
+}
+
 func convertBlogToResponse(post *model.BlogPost) *model.BlogPostResponse {
 	var tags []model.TagResponse
 	for _, tag := range post.Tags {
@@ -1495,11 +3065,100 @@ func convertBlogToResponse(post *model.BlogPost) *model.BlogPostResponse {
 		Status:        post.Status,
 		ViewCount:     post.ViewCount,
 		Tags:          tags,
+		Version:       post.Version,
 		CreatedAt:     post.CreatedAt,
 		UpdatedAt:     post.UpdatedAt,
 	}
 }
 
+func convertSeriesPostToSummary(member *model.BlogSeriesPost) model.SeriesPostSummary {
+	return model.SeriesPostSummary{
+		ID:       member.PostID,
+		Title:    member.Post.Title,
+		Slug:     member.Post.Slug,
+		Status:   member.Post.Status,
+		Position: member.Position,
+	}
+}
+
+func convertPreviewTokenToResponse(token *model.PreviewToken) model.PreviewTokenResponse {
+	return model.PreviewTokenResponse{
+		ID:           token.ID,
+		ResourceType: token.ResourceType,
+		ResourceID:   token.ResourceID,
+		Note:         token.Note,
+		ExpiresAt:    token.ExpiresAt,
+		RevokedAt:    token.RevokedAt,
+		LastUsedAt:   token.LastUsedAt,
+		Active:       token.RevokedAt == nil && time.Now().Before(token.ExpiresAt),
+		CreatedAt:    token.CreatedAt,
+	}
+}
+
+func convertTagToResponse(tag *model.BlogTag) model.TagResponse {
+	return model.TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+	}
+}
+
+// convertBlogSeriesToResponse: includeDrafts=false untuk pengunjung publik,
+// supaya judul/slug post yang belum published tidak bocor
+func convertBlogSeriesToResponse(series *model.BlogSeries, includeDrafts bool) *model.BlogSeriesResponse {
+	posts := make([]model.SeriesPostSummary, 0, len(series.Posts))
+	for i := range series.Posts {
+		if !includeDrafts && series.Posts[i].Post.Status != "published" {
+			continue
+		}
+		posts = append(posts, convertSeriesPostToSummary(&series.Posts[i]))
+	}
+
+	return &model.BlogSeriesResponse{
+		ID:          series.ID,
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		Posts:       posts,
+		Version:     series.Version,
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+	}
+}
+
+func convertBlogCommentToResponse(comment *model.BlogComment) model.BlogCommentResponse {
+	return model.BlogCommentResponse{
+		ID:         comment.ID,
+		PostID:     comment.PostID,
+		ParentID:   comment.ParentID,
+		AuthorName: comment.AuthorName,
+		Content:    comment.Content,
+		Replies:    []model.BlogCommentResponse{},
+		CreatedAt:  comment.CreatedAt,
+	}
+}
+
+func convertBlogCommentToAdminResponse(comment *model.BlogComment) *model.BlogCommentAdminResponse {
+	ipAddress := ""
+	if comment.IPAddress != nil {
+		ipAddress = *comment.IPAddress
+	}
+
+	return &model.BlogCommentAdminResponse{
+		ID:          comment.ID,
+		PostID:      comment.PostID,
+		ParentID:    comment.ParentID,
+		AuthorName:  comment.AuthorName,
+		AuthorEmail: comment.AuthorEmail,
+		Content:     comment.Content,
+		Status:      comment.Status,
+		IPAddress:   ipAddress,
+		UserAgent:   comment.UserAgent,
+		CreatedAt:   comment.CreatedAt,
+		UpdatedAt:   comment.UpdatedAt,
+	}
+}
+
 func convertSectionToResponse(section *model.Section) *model.SectionResponse {
 	return &model.SectionResponse{
 		ID:           section.ID,
@@ -1507,6 +3166,7 @@ func convertSectionToResponse(section *model.Section) *model.SectionResponse {
 		Label:        section.Label,
 		DisplayOrder: section.DisplayOrder,
 		IsActive:     section.IsActive,
+		Version:      section.Version,
 		CreatedAt:    section.CreatedAt,
 		UpdatedAt:    section.UpdatedAt,
 	}
@@ -1520,19 +3180,117 @@ func convertSocialLinkToResponse(link *model.SocialLink) *model.SocialLinkRespon
 		IconName:     link.IconName,
 		DisplayOrder: link.DisplayOrder,
 		IsActive:     link.IsActive,
+		Version:      link.Version,
 		CreatedAt:    link.CreatedAt,
 		UpdatedAt:    link.UpdatedAt,
 	}
 }
 
-func convertSettingToResponse(setting *model.Setting) *model.SettingResponse {
-	return &model.SettingResponse{
-		ID:          setting.ID,
-		Key:         setting.Key,
-		Value:       setting.Value,
-		DataType:    setting.DataType,
-		Description: setting.Description,
-		CreatedAt:   setting.CreatedAt,
-		UpdatedAt:   setting.UpdatedAt,
+// ============================
+// REQUEST CONVERTERS (dasar JSON Merge Patch)
+// ============================
+
+func skillToRequest(skill *model.Skill) model.SkillRequest {
+	return model.SkillRequest{
+		Name:         skill.Name,
+		Value:        skill.Value,
+		IconURL:      skill.IconURL,
+		Category:     skill.Category,
+		DisplayOrder: skill.DisplayOrder,
+		IsFeatured:   skill.IsFeatured,
+	}
+}
+
+func certificateToRequest(cert *model.Certificate) model.CertificateRequest {
+	return model.CertificateRequest{
+		Name:          cert.Name,
+		ImageURL:      cert.ImageURL,
+		IssueDate:     cert.IssueDate,
+		Issuer:        cert.Issuer,
+		CredentialURL: cert.CredentialURL,
+		DisplayOrder:  cert.DisplayOrder,
+	}
+}
+
+func educationToRequest(edu *model.Education) model.EducationRequest {
+	achievements := make([]model.AchievementRequest, 0, len(edu.Achievements))
+	for _, ach := range edu.Achievements {
+		achievements = append(achievements, model.AchievementRequest{
+			Achievement:  ach.Achievement,
+			DisplayOrder: ach.DisplayOrder,
+		})
+	}
+
+	return model.EducationRequest{
+		School:       edu.School,
+		Major:        edu.Major,
+		StartDate:    utils.FormatMonthDate(edu.StartDate, edu.DatePrecision),
+		EndDate:      utils.FormatMonthDate(edu.EndDate, edu.DatePrecision),
+		IsOngoing:    edu.IsOngoing,
+		Description:  edu.Description,
+		Degree:       edu.Degree,
+		DisplayOrder: edu.DisplayOrder,
+		Achievements: achievements,
+	}
+}
+
+func testimonialToRequest(test *model.Testimonial) model.TestimonialRequest {
+	return model.TestimonialRequest{
+		Name:         test.Name,
+		Title:        test.Title,
+		Message:      test.Message,
+		AvatarURL:    test.AvatarURL,
+		Rating:       test.Rating,
+		IsFeatured:   test.IsFeatured,
+		DisplayOrder: test.DisplayOrder,
+		Status:       test.Status,
+		ProjectID:    test.ProjectID,
+	}
+}
+
+func sectionToRequest(section *model.Section) model.SectionRequest {
+	isActive := section.IsActive
+	return model.SectionRequest{
+		SectionID:    section.SectionID,
+		Label:        section.Label,
+		DisplayOrder: section.DisplayOrder,
+		IsActive:     &isActive,
+	}
+}
+
+func socialLinkToRequest(link *model.SocialLink) model.SocialLinkRequest {
+	isActive := link.IsActive
+	return model.SocialLinkRequest{
+		Platform:     link.Platform,
+		URL:          link.URL,
+		IconName:     link.IconName,
+		DisplayOrder: link.DisplayOrder,
+		IsActive:     &isActive,
+	}
+}
+
+func blogPostToRequest(post *model.BlogPost) model.BlogPostRequest {
+	tags := make([]model.TagRequest, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		tags = append(tags, model.TagRequest{Name: tag.Name})
+	}
+
+	return model.BlogPostRequest{
+		Title:         post.Title,
+		Content:       post.Content,
+		Excerpt:       post.Excerpt,
+		Slug:          post.Slug,
+		FeaturedImage: post.FeaturedImage,
+		PublishDate:   post.PublishDate,
+		Status:        post.Status,
+		Tags:          tags,
+	}
+}
+
+func blogSeriesToRequest(series *model.BlogSeries) model.BlogSeriesRequest {
+	return model.BlogSeriesRequest{
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
 	}
 }
@@ -0,0 +1,175 @@
+package service
+
+import (
+	model "gintugas/modules/components/all/models"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// ============================
+// RELATED POSTS
+// ============================
+// Skor related post = gabungan kemiripan tag (Jaccard) dan kemiripan teks
+// (cosine similarity term frequency dari title, excerpt dan content).
+// Kandidat dibatasi di SQL ke post yang berbagi tag atau cocok full-text
+// dengan kata kunci post ini, jadi halaman detail tidak perlu memuat seluruh
+// post published beserta kontennya.
+
+const (
+	relatedTagWeight       = 0.6
+	relatedTextWeight      = 0.4
+	relatedPostLimit       = 3
+	relatedCandidateLimit  = 20
+	relatedSearchTermLimit = 15
+)
+
+var relatedStopwords = map[string]bool{
+	// english
+	"the": true, "and": true, "for": true, "with": true, "that": true, "this": true,
+	"are": true, "was": true, "you": true, "your": true, "from": true, "how": true,
+	"what": true, "can": true, "not": true, "but": true, "have": true, "has": true,
+	"will": true, "into": true, "using": true, "use": true,
+	// indonesia
+	"yang": true, "dan": true, "untuk": true, "dengan": true, "ini": true, "itu": true,
+	"dari": true, "pada": true, "dalam": true, "adalah": true, "akan": true, "bisa": true,
+	"atau": true, "juga": true, "kita": true, "kamu": true, "tidak": true, "cara": true,
+}
+
+func findRelatedPosts(post *model.BlogPost, candidates []model.BlogPost) []model.RelatedPostResponse {
+	postTags := blogTagSet(post)
+	postTerms := termFrequencies(post.Title + " " + post.Excerpt + " " + post.Content)
+
+	type scored struct {
+		post  *model.BlogPost
+		score float64
+	}
+
+	var results []scored
+	for i := range candidates {
+		candidate := &candidates[i]
+		if candidate.ID == post.ID {
+			continue
+		}
+
+		tagScore := jaccard(postTags, blogTagSet(candidate))
+		textScore := cosine(postTerms, termFrequencies(candidate.Title+" "+candidate.Excerpt+" "+candidate.Content))
+		score := relatedTagWeight*tagScore + relatedTextWeight*textScore
+		if score <= 0 {
+			continue
+		}
+
+		results = append(results, scored{post: candidate, score: score})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].score == results[j].score {
+			return results[i].post.PublishDate.After(results[j].post.PublishDate)
+		}
+		return results[i].score > results[j].score
+	})
+
+	if len(results) > relatedPostLimit {
+		results = results[:relatedPostLimit]
+	}
+
+	related := make([]model.RelatedPostResponse, 0, len(results))
+	for _, r := range results {
+		related = append(related, model.RelatedPostResponse{
+			ID:            r.post.ID,
+			Title:         r.post.Title,
+			Slug:          r.post.Slug,
+			Excerpt:       r.post.Excerpt,
+			FeaturedImage: r.post.FeaturedImage,
+			PublishDate:   r.post.PublishDate,
+			Score:         math.Round(r.score*1000) / 1000,
+		})
+	}
+
+	return related
+}
+
+// relatedSearchTerms mengambil kata kunci paling sering dari post sebagai
+// query websearch_to_tsquery ("a or b or c")
+func relatedSearchTerms(post *model.BlogPost) string {
+	frequencies := termFrequencies(post.Title + " " + post.Excerpt + " " + post.Content)
+
+	terms := make([]string, 0, len(frequencies))
+	for term := range frequencies {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if frequencies[terms[i]] == frequencies[terms[j]] {
+			return terms[i] < terms[j]
+		}
+		return frequencies[terms[i]] > frequencies[terms[j]]
+	})
+
+	if len(terms) > relatedSearchTermLimit {
+		terms = terms[:relatedSearchTermLimit]
+	}
+	return strings.Join(terms, " or ")
+}
+
+func blogTagSet(post *model.BlogPost) map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]bool, len(post.Tags))
+	for _, tag := range post.Tags {
+		set[tag.ID] = true
+	}
+	return set
+}
+
+func jaccard(a, b map[uuid.UUID]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for id := range a {
+		if b[id] {
+			intersection++
+		}
+	}
+
+	union := len(a) + len(b) - intersection
+	return float64(intersection) / float64(union)
+}
+
+func termFrequencies(text string) map[string]float64 {
+	terms := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len([]rune(word)) < 3 || relatedStopwords[word] {
+			continue
+		}
+		terms[word]++
+	}
+	return terms
+}
+
+func cosine(a, b map[string]float64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for term, weight := range a {
+		normA += weight * weight
+		if other, ok := b[term]; ok {
+			dot += weight * other
+		}
+	}
+	for _, weight := range b {
+		normB += weight * weight
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
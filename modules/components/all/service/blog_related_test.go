@@ -0,0 +1,79 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	model "gintugas/modules/components/all/models"
+
+	"github.com/google/uuid"
+)
+
+func TestRelatedSearchTerms(t *testing.T) {
+	post := &model.BlogPost{
+		Title:   "Deploy Go ke Docker",
+		Excerpt: "Cara deploy aplikasi dengan docker",
+		Content: "Docker membuat deploy lebih mudah dan docker image kecil.",
+	}
+
+	terms := strings.Split(relatedSearchTerms(post), " or ")
+	if terms[0] != "docker" || terms[1] != "deploy" {
+		t.Fatalf("terms = %q, want docker and deploy first", terms)
+	}
+	for _, term := range terms {
+		if term == "go" || term == "ke" || term == "dan" || term == "cara" {
+			t.Fatalf("short words and stopwords must be skipped, got %q", terms)
+		}
+	}
+
+	long := &model.BlogPost{Content: strings.Repeat("alpha bravo charlie delta echo foxtrot golf hotel india juliet kilo lima mike november oscar papa quebec ", 2)}
+	if got := strings.Count(relatedSearchTerms(long), " or ") + 1; got != relatedSearchTermLimit {
+		t.Fatalf("got %d terms, want %d", got, relatedSearchTermLimit)
+	}
+}
+
+func TestFindRelatedPostsIncludesUntaggedTextMatch(t *testing.T) {
+	golang := model.BlogTag{ID: uuid.New(), Name: "go"}
+	post := &model.BlogPost{
+		ID:      uuid.New(),
+		Title:   "Optimasi query PostgreSQL",
+		Content: "Index postgresql dan explain analyze untuk query lambat",
+		Tags:    []model.BlogTag{golang},
+	}
+
+	untagged := model.BlogPost{
+		ID:          uuid.New(),
+		Title:       "Membaca explain analyze PostgreSQL",
+		Content:     "Query lambat biasanya butuh index",
+		PublishDate: time.Now(),
+	}
+	unrelated := model.BlogPost{
+		ID:      uuid.New(),
+		Title:   "Liburan ke Bali",
+		Content: "Pantai dan kuliner",
+	}
+	tagged := model.BlogPost{
+		ID:    uuid.New(),
+		Title: "Goroutine dan channel",
+		Tags:  []model.BlogTag{golang},
+	}
+
+	related := findRelatedPosts(post, []model.BlogPost{tagged, unrelated, untagged})
+	if len(related) != 2 {
+		t.Fatalf("related = %+v, want the tagged and the untagged text match", related)
+	}
+
+	found := false
+	for _, r := range related {
+		if r.ID == unrelated.ID {
+			t.Fatal("post without shared tags or text must not be related")
+		}
+		if r.ID == untagged.ID {
+			found = r.Score > 0
+		}
+	}
+	if !found {
+		t.Fatal("untagged post with similar text must be related")
+	}
+}
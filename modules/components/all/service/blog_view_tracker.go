@@ -0,0 +1,243 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// BLOG VIEW TRACKER
+// ============================
+// Menghitung view blog: event dideduplikasi per visitor (hash IP +
+// User-Agent) per jendela waktu dan bot diabaikan. Di server biasa event
+// ditulis ke database secara batch oleh goroutine terpisah; event yang
+// belum di-flush (maksimal defaultViewFlushEvery) hilang jika proses mati.
+// Di Vercel (env VERCEL=1) instance bisa dibekukan atau dimatikan begitu
+// response selesai, jadi event langsung ditulis di dalam request.
+
+var botUserAgentPattern = regexp.MustCompile(`(?i)(bot|crawl|spider|slurp|curl|wget|python-requests|go-http-client|httpclient|headless|lighthouse|facebookexternalhit|whatsapp|telegram|discord|preview|monitor|pingdom|uptime)`)
+
+const (
+	defaultViewWindow     = 30 * time.Minute
+	defaultViewFlushEvery = 5 * time.Second
+	defaultViewBatchSize  = 100
+	viewQueueSize         = 1000
+)
+
+type BlogViewTracker struct {
+	repo       repo.BlogViewRepository
+	secret     []byte
+	window     time.Duration
+	location   *time.Location
+	flushEvery time.Duration
+	batchSize  int
+	events     chan model.BlogViewEvent
+
+	// writeThrough menulis event langsung tanpa antrian (serverless)
+	writeThrough bool
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewBlogViewTracker(repo repo.BlogViewRepository) *BlogViewTracker {
+	secret := []byte(os.Getenv("BLOG_VIEW_SALT"))
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			fmt.Printf("⚠️ Warning: gagal membuat salt view blog: %v\n", err)
+		}
+		fmt.Println("⚠️ BLOG_VIEW_SALT not set, using random salt (deduplication is per instance)")
+	}
+
+	window := defaultViewWindow
+	if minutes, err := strconv.Atoi(os.Getenv("BLOG_VIEW_WINDOW_MINUTES")); err == nil && minutes > 0 {
+		window = time.Duration(minutes) * time.Minute
+	}
+
+	location, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		location = time.UTC
+	}
+
+	t := &BlogViewTracker{
+		repo:       repo,
+		secret:     secret,
+		window:     window,
+		location:   location,
+		flushEvery: defaultViewFlushEvery,
+		batchSize:  defaultViewBatchSize,
+		events:     make(chan model.BlogViewEvent, viewQueueSize),
+		seen:       make(map[string]time.Time),
+
+		writeThrough: os.Getenv("VERCEL") == "1",
+	}
+
+	go t.run()
+
+	return t
+}
+
+// Track mencatat satu view untuk post. Dalam mode batch tidak pernah
+// memblokir request (jika antrian penuh event dibuang); dalam mode
+// writeThrough request menunggu satu insert.
+func (t *BlogViewTracker) Track(ctx *gin.Context, post *model.BlogPost) {
+	if post == nil || post.Status != "published" {
+		return
+	}
+
+	// Preview admin tidak dihitung
+	if authmiddleware.IsAdmin(ctx) {
+		return
+	}
+
+	userAgent := ctx.Request.UserAgent()
+	if userAgent == "" || botUserAgentPattern.MatchString(userAgent) {
+		return
+	}
+
+	now := time.Now()
+	windowStart := now.Truncate(t.window)
+	visitorHash := t.visitorHash(ctx.ClientIP(), userAgent, now)
+
+	if !t.markSeen(post.ID, visitorHash, windowStart) {
+		return
+	}
+
+	event := model.BlogViewEvent{
+		PostID:      post.ID,
+		VisitorHash: visitorHash,
+		WindowStart: windowStart,
+		ViewedAt:    now,
+	}
+
+	if t.writeThrough {
+		t.flush([]model.BlogViewEvent{event})
+		return
+	}
+
+	select {
+	case t.events <- event:
+	default:
+		fmt.Println("⚠️ Warning: antrian view blog penuh, event dibuang")
+	}
+}
+
+// DailyViews mengembalikan time series view harian untuk N hari terakhir
+func (t *BlogViewTracker) DailyViews(postID uuid.UUID, days int) (*model.BlogViewStatsResponse, error) {
+	to := time.Now().In(t.location)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, t.location)
+	from := to.AddDate(0, 0, -(days - 1))
+
+	views, err := t.repo.GetDailyViews(postID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]int, len(views))
+	for _, v := range views {
+		byDate[v.ViewDate.Format("2006-01-02")] = v.Views
+	}
+
+	response := &model.BlogViewStatsResponse{
+		PostID: postID,
+		From:   from.Format("2006-01-02"),
+		To:     to.Format("2006-01-02"),
+		Series: make([]model.DailyViewPoint, 0, days),
+	}
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		response.Series = append(response.Series, model.DailyViewPoint{
+			Date:  date,
+			Views: byDate[date],
+		})
+		response.TotalViews += byDate[date]
+	}
+
+	return response, nil
+}
+
+// visitorHash - hash IP + User-Agent dengan salt yang berganti setiap hari,
+// sehingga IP asli tidak pernah disimpan dan visitor tidak bisa dilacak antar hari.
+func (t *BlogViewTracker) visitorHash(ip, userAgent string, now time.Time) string {
+	h := sha256.New()
+	h.Write(t.secret)
+	h.Write([]byte(now.UTC().Format("2006-01-02")))
+	h.Write([]byte(ip))
+	h.Write([]byte("|"))
+	h.Write([]byte(userAgent))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// markSeen mengembalikan false jika visitor sudah tercatat di jendela yang sama
+func (t *BlogViewTracker) markSeen(postID uuid.UUID, visitorHash string, windowStart time.Time) bool {
+	key := postID.String() + ":" + visitorHash + ":" + strconv.FormatInt(windowStart.Unix(), 10)
+	expiry := windowStart.Add(t.window)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if until, exists := t.seen[key]; exists && time.Now().Before(until) {
+		return false
+	}
+	t.seen[key] = expiry
+	return true
+}
+
+func (t *BlogViewTracker) cleanupSeen() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for key, until := range t.seen {
+		if now.After(until) {
+			delete(t.seen, key)
+		}
+	}
+}
+
+func (t *BlogViewTracker) run() {
+	ticker := time.NewTicker(t.flushEvery)
+	defer ticker.Stop()
+
+	batch := make([]model.BlogViewEvent, 0, t.batchSize)
+	for {
+		select {
+		case event := <-t.events:
+			batch = append(batch, event)
+			if len(batch) >= t.batchSize {
+				t.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				t.flush(batch)
+				batch = batch[:0]
+			}
+			t.cleanupSeen()
+		}
+	}
+}
+
+func (t *BlogViewTracker) flush(batch []model.BlogViewEvent) {
+	events := make([]model.BlogViewEvent, len(batch))
+	copy(events, batch)
+
+	if _, err := t.repo.RecordViews(events, t.location); err != nil {
+		fmt.Printf("⚠️ Warning: gagal menyimpan %d view blog: %v\n", len(events), err)
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type recordingViewRepo struct {
+	repo.BlogViewRepository
+	recorded []model.BlogViewEvent
+}
+
+func (r *recordingViewRepo) RecordViews(events []model.BlogViewEvent, loc *time.Location) (map[uuid.UUID]int, error) {
+	r.recorded = append(r.recorded, events...)
+	return nil, nil
+}
+
+func TestTrackWriteThroughRecordsBeforeReturning(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	views := &recordingViewRepo{}
+	tracker := &BlogViewTracker{
+		repo:         views,
+		secret:       []byte("salt"),
+		window:       defaultViewWindow,
+		location:     time.UTC,
+		seen:         make(map[string]time.Time),
+		writeThrough: true,
+	}
+	post := &model.BlogPost{ID: uuid.New(), Status: "published"}
+
+	for i := 0; i < 2; i++ {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", "/api/v1/blog/"+post.ID.String(), nil)
+		ctx.Request.Header.Set("User-Agent", "Mozilla/5.0")
+		tracker.Track(ctx, post)
+	}
+
+	// Tidak ada goroutine/antrian: event sudah tertulis saat Track kembali,
+	// dan view kedua dari visitor yang sama tetap dideduplikasi
+	if len(views.recorded) != 1 || views.recorded[0].PostID != post.ID {
+		t.Fatalf("recorded = %+v, want one event for the post", views.recorded)
+	}
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	"gintugas/modules/utils"
+	"log"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// CONTACT SERVICE
+// ============================
+// Form kontak publik disimpan ke contact_messages lalu pemilik portfolio
+// diberi tahu lewat ContactNotifier. Notifikasi dikirim di background supaya
+// pengirim tidak menunggu webhook/SMTP, dan kegagalannya hanya di-log.
+// Rate limit, honeypot dan CAPTCHA ditangani middleware SpamProtection.
+
+const contactNotifyTimeout = 10 * time.Second
+
+type ContactService interface {
+	Submit(ctx *gin.Context) (*model.ContactSubmissionResponse, error)
+	GetAll(ctx *gin.Context) ([]model.ContactMessageResponse, *utils.PageInfo, error)
+	GetByID(ctx *gin.Context) (*model.ContactMessageResponse, error)
+	CountByStatus(ctx *gin.Context) ([]model.ContactStatusCount, error)
+	UpdateStatus(ctx *gin.Context) (*model.ContactMessageResponse, error)
+	Reply(ctx *gin.Context) (*model.ContactMessageResponse, error)
+	Delete(ctx *gin.Context) error
+}
+
+type contactService struct {
+	repo     repo.ContactMessageRepository
+	notifier ContactNotifier
+}
+
+func NewContactService(repo repo.ContactMessageRepository, notifier ContactNotifier) ContactService {
+	if notifier == nil {
+		notifier = LogContactNotifier{}
+	}
+
+	return &contactService{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+func (s *contactService) Submit(ctx *gin.Context) (*model.ContactSubmissionResponse, error) {
+	var req model.ContactMessageRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	message := &model.ContactMessage{
+		Name:      strings.TrimSpace(req.Name),
+		Email:     strings.TrimSpace(req.Email),
+		Subject:   strings.TrimSpace(req.Subject),
+		Message:   strings.TrimSpace(req.Message),
+		Status:    "unread",
+		UserAgent: ctx.Request.UserAgent(),
+	}
+
+	if message.Name == "" || message.Message == "" {
+		return nil, utils.RequireFields("name", message.Name, "message", message.Message)
+	}
+
+	if ip := ctx.ClientIP(); ip != "" {
+		message.IPAddress = &ip
+	}
+
+	if err := s.repo.Create(message); err != nil {
+		return nil, err
+	}
+
+	go s.notify(*message)
+
+	return &model.ContactSubmissionResponse{ID: message.ID, CreatedAt: message.CreatedAt}, nil
+}
+
+func (s *contactService) notify(message model.ContactMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), contactNotifyTimeout)
+	defer cancel()
+
+	if err := s.notifier.NotifyContactMessage(ctx, &message); err != nil {
+		log.Printf("⚠️ Warning: gagal mengirim notifikasi pesan kontak %s: %v", message.ID, err)
+	}
+}
+
+func (s *contactService) GetAll(ctx *gin.Context) ([]model.ContactMessageResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.ContactMessageListSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, page, err := s.repo.List(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses := make([]model.ContactMessageResponse, 0, len(messages))
+	for i := range messages {
+		responses = append(responses, *convertContactMessageToResponse(&messages[i]))
+	}
+
+	return responses, &page, nil
+}
+
+func (s *contactService) GetByID(ctx *gin.Context) (*model.ContactMessageResponse, error) {
+	id, err := uuid.Parse(ctx.Param("message_id"))
+	if err != nil {
+		return nil, utils.InvalidID("message")
+	}
+
+	message, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, utils.NotFound("message")
+	}
+
+	return convertContactMessageToResponse(message), nil
+}
+
+func (s *contactService) CountByStatus(ctx *gin.Context) ([]model.ContactStatusCount, error) {
+	return s.repo.CountByStatus()
+}
+
+func (s *contactService) UpdateStatus(ctx *gin.Context) (*model.ContactMessageResponse, error) {
+	id, err := uuid.Parse(ctx.Param("message_id"))
+	if err != nil {
+		return nil, utils.InvalidID("message")
+	}
+
+	var req model.ContactStatusRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStatus(id, req.Status); err != nil {
+		return nil, err
+	}
+
+	message, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertContactMessageToResponse(message), nil
+}
+
+// Reply mencatat balasan yang sudah dikirim admin (misalnya lewat email
+// pribadi) supaya inbox tahu pesan mana yang sudah ditangani
+func (s *contactService) Reply(ctx *gin.Context) (*model.ContactMessageResponse, error) {
+	id, err := uuid.Parse(ctx.Param("message_id"))
+	if err != nil {
+		return nil, utils.InvalidID("message")
+	}
+
+	var req model.ContactReplyRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	reply := strings.TrimSpace(req.Message)
+	if reply == "" {
+		return nil, utils.RequireFields("message", reply)
+	}
+
+	if err := s.repo.SaveReply(id, reply, time.Now()); err != nil {
+		return nil, err
+	}
+
+	message, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertContactMessageToResponse(message), nil
+}
+
+func (s *contactService) Delete(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("message_id"))
+	if err != nil {
+		return utils.InvalidID("message")
+	}
+
+	return s.repo.Delete(id)
+}
+
+func convertContactMessageToResponse(message *model.ContactMessage) *model.ContactMessageResponse {
+	ipAddress := ""
+	if message.IPAddress != nil {
+		ipAddress = *message.IPAddress
+	}
+
+	return &model.ContactMessageResponse{
+		ID:           message.ID,
+		Name:         message.Name,
+		Email:        message.Email,
+		Subject:      message.Subject,
+		Message:      message.Message,
+		IPAddress:    ipAddress,
+		UserAgent:    message.UserAgent,
+		Status:       message.Status,
+		ReplyMessage: message.ReplyMessage,
+		RepliedAt:    message.RepliedAt,
+		CreatedAt:    message.CreatedAt,
+		UpdatedAt:    message.UpdatedAt,
+	}
+}
+
+// ============================
+// CONTACT NOTIFIERS
+// ============================
+// Dipilih lewat CONTACT_NOTIFIER: "log" (default), "webhook" atau "smtp".
+// Implementasi lain (Telegram, dsb.) cukup memenuhi interface ContactNotifier.
+
+type ContactNotifier interface {
+	NotifyContactMessage(ctx context.Context, message *model.ContactMessage) error
+}
+
+func NewContactNotifierFromEnv() ContactNotifier {
+	switch os.Getenv("CONTACT_NOTIFIER") {
+	case "webhook":
+		if url := os.Getenv("CONTACT_WEBHOOK_URL"); url != "" {
+			return NewWebhookContactNotifier(url)
+		}
+		log.Println("⚠️  CONTACT_WEBHOOK_URL kosong, notifikasi kontak hanya di-log")
+	case "smtp":
+		notifier := SMTPContactNotifier{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("CONTACT_NOTIFY_FROM"),
+			To:       os.Getenv("CONTACT_NOTIFY_TO"),
+		}
+		if notifier.Port == "" {
+			notifier.Port = "587"
+		}
+		if notifier.Host != "" && notifier.From != "" && notifier.To != "" {
+			return notifier
+		}
+		log.Println("⚠️  Konfigurasi SMTP belum lengkap, notifikasi kontak hanya di-log")
+	}
+	return LogContactNotifier{}
+}
+
+// LogContactNotifier hanya menulis ke log server
+type LogContactNotifier struct{}
+
+func (LogContactNotifier) NotifyContactMessage(ctx context.Context, message *model.ContactMessage) error {
+	log.Printf("📬 Pesan kontak baru dari %s <%s>: %s", message.Name, message.Email, message.Subject)
+	return nil
+}
+
+// WebhookContactNotifier mengirim JSON ke URL (Slack/Discord/n8n, dsb.).
+// Field text dan content disertakan supaya langsung terbaca di Slack dan Discord.
+type WebhookContactNotifier struct {
+	URL    string
+	client *http.Client
+}
+
+func NewWebhookContactNotifier(url string) *WebhookContactNotifier {
+	return &WebhookContactNotifier{URL: url, client: &http.Client{Timeout: contactNotifyTimeout}}
+}
+
+func (n *WebhookContactNotifier) NotifyContactMessage(ctx context.Context, message *model.ContactMessage) error {
+	summary := fmt.Sprintf("Pesan kontak baru dari %s <%s>: %s", message.Name, message.Email, message.Subject)
+	payload, err := json.Marshal(map[string]interface{}{
+		"event":      "contact.message.created",
+		"text":       summary,
+		"content":    summary,
+		"id":         message.ID,
+		"name":       message.Name,
+		"email":      message.Email,
+		"subject":    message.Subject,
+		"message":    message.Message,
+		"created_at": message.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook membalas status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SMTPContactNotifier mengirim email ke pemilik dengan Reply-To pengirim
+type SMTPContactNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	To       string
+}
+
+func (n SMTPContactNotifier) NotifyContactMessage(ctx context.Context, message *model.ContactMessage) error {
+	subject := message.Subject
+	if subject == "" {
+		subject = "(tanpa subjek)"
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "From: %s\r\n", n.From)
+	fmt.Fprintf(&body, "To: %s\r\n", n.To)
+	fmt.Fprintf(&body, "Reply-To: %s\r\n", headerValue(message.Email))
+	fmt.Fprintf(&body, "Subject: [Kontak] %s\r\n", headerValue(subject))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&body, "Nama: %s\r\nEmail: %s\r\n\r\n%s\r\n", message.Name, message.Email, message.Message)
+
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	}
+
+	// net/smtp tidak menerima context; batasi lewat goroutine supaya tidak menggantung
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(n.Host+":"+n.Port, auth, n.From, strings.Split(n.To, ","), []byte(body.String()))
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// headerValue mencegah header injection dari input pengirim
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	projectmodel "gintugas/modules/components/Project/model"
+	projectrepo "gintugas/modules/components/Project/repository"
+	projectservice "gintugas/modules/components/Project/service"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	experepo "gintugas/modules/components/experiences/repo"
+	expeservice "gintugas/modules/components/experiences/service"
+	"gintugas/modules/utils"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// PORTFOLIO SNAPSHOT SERVICE
+// ============================
+// Menggabungkan semua section aktif menjadi satu response supaya frontend
+// cukup satu request saat load pertama. Setiap sumber data diambil paralel;
+// jika ada yang gagal atau timeout, section lain tetap dikembalikan.
+
+const (
+	defaultSnapshotTimeout = 3 * time.Second
+	snapshotBlogLimit      = 6
+)
+
+type PortfolioSnapshotService interface {
+	GetSnapshot(ctx *gin.Context) (*model.PortfolioSnapshotResponse, error)
+}
+
+type PortfolioSnapshotRepos struct {
+	Sections     repo.SectionRepository
+	Projects     projectrepo.Repository
+	Experiences  experepo.ExperiencesRepository
+	Skills       repo.SkillRepository
+	Education    repo.EducationRepository
+	Certificates repo.CertificateRepository
+	Testimonials repo.TestimonialRepository
+	Blog         repo.BlogRepository
+	SocialLinks  repo.SocialLinkRepository
+	Settings     repo.SettingRepository
+}
+
+type portfolioSnapshotService struct {
+	repos     PortfolioSnapshotRepos
+	localizer utils.Localizer
+	timeout   time.Duration
+}
+
+func NewPortfolioSnapshotService(repos PortfolioSnapshotRepos, localizer utils.Localizer) PortfolioSnapshotService {
+	timeout := defaultSnapshotTimeout
+	if ms, err := strconv.Atoi(os.Getenv("PORTFOLIO_SNAPSHOT_TIMEOUT_MS")); err == nil && ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	return &portfolioSnapshotService{repos: repos, localizer: localizer, timeout: timeout}
+}
+
+// snapshotLoader menerima ctx dengan timeout snapshot dan meneruskannya ke
+// repository, sehingga query yang masih jalan ikut dibatalkan saat timeout
+type snapshotLoader func(ctx context.Context) (interface{}, error)
+
+type snapshotResult struct {
+	key  string
+	data interface{}
+	err  error
+}
+
+func (s *portfolioSnapshotService) GetSnapshot(ctx *gin.Context) (*model.PortfolioSnapshotResponse, error) {
+	sections, err := s.repos.Sections.WithContext(ctx.Request.Context()).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Terjemahan dan formatter tanggal diambil di goroutine request sebelum
+	// loader paralel jalan
+	translations := make(map[string]utils.Translations)
+	for _, entityType := range []string{utils.TranslatableSection, utils.TranslatableProject, utils.TranslatableExperience, utils.TranslatableEducation, utils.TranslatableBlogPost} {
+		translations[entityType] = utils.Localize(s.localizer, ctx, entityType)
+	}
+
+	loaders := s.sectionLoaders(authmiddleware.IsAdmin(ctx), translations, utils.NewDateFormatter(ctx))
+
+	// Hanya section aktif yang punya sumber data yang di-fetch
+	jobs := map[string]snapshotLoader{
+		"social_links": s.loadSocialLinks,
+		"settings":     s.loadSettings,
+	}
+	for _, section := range sections {
+		if !section.IsActive {
+			continue
+		}
+		if loader, ok := loaders[section.SectionID]; ok {
+			jobs[section.SectionID] = loader
+		}
+	}
+
+	results := s.runLoaders(ctx.Request.Context(), jobs)
+
+	response := &model.PortfolioSnapshotResponse{
+		Sections:    []model.PortfolioSectionSnapshot{},
+		SocialLinks: []model.SocialLinkResponse{},
+		Settings:    map[string]interface{}{},
+		Errors:      map[string]string{},
+	}
+
+	for _, section := range sections {
+		if !section.IsActive {
+			continue
+		}
+
+		snapshot := model.PortfolioSectionSnapshot{
+			SectionID:    section.SectionID,
+			Label:        section.Label,
+			DisplayOrder: section.DisplayOrder,
+		}
+		translations[utils.TranslatableSection].Apply(section.ID, "label", &snapshot.Label)
+		if result, ok := results[section.SectionID]; ok {
+			snapshot.Data = result.data
+			if result.err != nil {
+				snapshot.Error = result.err.Error()
+				response.Errors[section.SectionID] = result.err.Error()
+			}
+		}
+		response.Sections = append(response.Sections, snapshot)
+	}
+
+	if result := results["social_links"]; result.err != nil {
+		response.Errors["social_links"] = result.err.Error()
+	} else if links, ok := result.data.([]model.SocialLinkResponse); ok {
+		response.SocialLinks = links
+	}
+
+	if result := results["settings"]; result.err != nil {
+		response.Errors["settings"] = result.err.Error()
+	} else if settings, ok := result.data.(map[string]interface{}); ok {
+		response.Settings = settings
+	}
+
+	if len(response.Errors) == 0 {
+		response.Errors = nil
+	}
+
+	return response, nil
+}
+
+// runLoaders menjalankan semua loader paralel dan menunggu sampai selesai
+// atau timeout. Loader yang belum selesai ditandai error timeout; query-nya
+// dibatalkan lewat ctx yang sama.
+func (s *portfolioSnapshotService) runLoaders(parent context.Context, jobs map[string]snapshotLoader) map[string]snapshotResult {
+	ctx, cancel := context.WithTimeout(parent, s.timeout)
+	defer cancel()
+
+	ch := make(chan snapshotResult, len(jobs))
+	for key, loader := range jobs {
+		go func(key string, loader snapshotLoader) {
+			defer func() {
+				if r := recover(); r != nil {
+					ch <- snapshotResult{key: key, err: fmt.Errorf("panic: %v", r)}
+				}
+			}()
+			data, err := loader(ctx)
+			ch <- snapshotResult{key: key, data: data, err: err}
+		}(key, loader)
+	}
+
+	results := make(map[string]snapshotResult, len(jobs))
+	for len(results) < len(jobs) {
+		select {
+		case result := <-ch:
+			results[result.key] = result
+		case <-ctx.Done():
+			for key := range jobs {
+				if _, ok := results[key]; !ok {
+					results[key] = snapshotResult{key: key, err: errors.New("timeout saat mengambil data")}
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+// sectionLoaders memetakan section_id (bawaan seed dan alias bahasa Inggris)
+// ke sumber datanya. Section tanpa loader (profil, about) hanya berisi label.
+func (s *portfolioSnapshotService) sectionLoaders(isAdmin bool, translations map[string]utils.Translations, dates utils.DateFormatter) map[string]snapshotLoader {
+	projects := func(ctx context.Context) (interface{}, error) {
+		return s.loadProjects(ctx, isAdmin, translations[utils.TranslatableProject])
+	}
+	experiences := func(ctx context.Context) (interface{}, error) {
+		return s.loadExperiences(ctx, translations[utils.TranslatableExperience], dates)
+	}
+	education := func(ctx context.Context) (interface{}, error) {
+		return s.loadEducation(ctx, translations[utils.TranslatableEducation], dates)
+	}
+	blog := func(ctx context.Context) (interface{}, error) {
+		return s.loadBlog(ctx, translations[utils.TranslatableBlogPost])
+	}
+
+	return map[string]snapshotLoader{
+		"projek":       projects,
+		"projects":     projects,
+		"pengalaman":   experiences,
+		"experiences":  experiences,
+		"skill":        s.loadSkills,
+		"skills":       s.loadSkills,
+		"studi":        education,
+		"education":    education,
+		"testimoni":    s.loadTestimonials,
+		"testimonials": s.loadTestimonials,
+		"blog":         blog,
+		"kontak":       s.loadSocialLinks,
+		"contact":      s.loadSocialLinks,
+	}
+}
+
+func (s *portfolioSnapshotService) loadProjects(ctx context.Context, isAdmin bool, translations utils.Translations) (interface{}, error) {
+	projects, err := s.repos.Projects.WithContext(ctx).GetAllProjekWithTagsRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]projectmodel.Project, 0, len(projects))
+	for _, project := range projects {
+		if isAdmin || project.Status == "published" {
+			projectservice.LocalizeProject(translations, &project)
+			visible = append(visible, project)
+		}
+	}
+	return visible, nil
+}
+
+func (s *portfolioSnapshotService) loadExperiences(ctx context.Context, translations utils.Translations, dates utils.DateFormatter) (interface{}, error) {
+	experiences, err := s.repos.Experiences.WithContext(ctx).GetAllExperiencesWithRelations()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]interface{}, 0, len(experiences))
+	for i := range experiences {
+		response := expeservice.ConvertToResponse(&experiences[i], dates)
+		expeservice.LocalizeExperience(translations, response)
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+func (s *portfolioSnapshotService) loadSkills(ctx context.Context) (interface{}, error) {
+	skills, err := s.repos.Skills.WithContext(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.SkillResponse, 0, len(skills))
+	for i := range skills {
+		responses = append(responses, *convertSkillToResponse(&skills[i]))
+	}
+	return responses, nil
+}
+
+// loadEducation berisi pendidikan dan sertifikat (section "studi")
+func (s *portfolioSnapshotService) loadEducation(ctx context.Context, translations utils.Translations, dates utils.DateFormatter) (interface{}, error) {
+	educations, err := s.repos.Education.WithContext(ctx).GetAllWithAchievements()
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := s.repos.Certificates.WithContext(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	eduResponses := make([]model.EducationResponse, 0, len(educations))
+	for i := range educations {
+		response := convertEducationToResponse(&educations[i], dates)
+		localizeEducation(translations, response)
+		eduResponses = append(eduResponses, *response)
+	}
+
+	certResponses := make([]model.CertificateResponse, 0, len(certs))
+	for i := range certs {
+		certResponses = append(certResponses, *convertCertToResponse(&certs[i]))
+	}
+
+	return gin.H{
+		"education":    eduResponses,
+		"certificates": certResponses,
+	}, nil
+}
+
+func (s *portfolioSnapshotService) loadTestimonials(ctx context.Context) (interface{}, error) {
+	testimonials, err := s.repos.Testimonials.WithContext(ctx).GetByStatus("approved")
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.TestimonialResponse, 0, len(testimonials))
+	for i := range testimonials {
+		responses = append(responses, *publicTestimonialResponse(&testimonials[i]))
+	}
+	return responses, nil
+}
+
+func (s *portfolioSnapshotService) loadBlog(ctx context.Context, translations utils.Translations) (interface{}, error) {
+	posts, err := s.repos.Blog.WithContext(ctx).GetPublishedWithTags()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(posts) > snapshotBlogLimit {
+		posts = posts[:snapshotBlogLimit]
+	}
+
+	responses := make([]model.BlogPostResponse, 0, len(posts))
+	for i := range posts {
+		response := convertBlogToResponse(&posts[i])
+		localizeBlogPost(translations, response)
+		responses = append(responses, *response)
+	}
+	return responses, nil
+}
+
+func (s *portfolioSnapshotService) loadSocialLinks(ctx context.Context) (interface{}, error) {
+	links, err := s.repos.SocialLinks.WithContext(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.SocialLinkResponse, 0, len(links))
+	for i := range links {
+		if links[i].IsActive {
+			responses = append(responses, *convertSocialLinkToResponse(&links[i]))
+		}
+	}
+	return responses, nil
+}
+
+func (s *portfolioSnapshotService) loadSettings(ctx context.Context) (interface{}, error) {
+	settings, err := s.repos.Settings.WithContext(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshot bisa diakses publik, jadi setting private tidak pernah ikut
+	values := make(map[string]interface{}, len(settings))
+	for i := range settings {
+		if settings[i].IsPublic {
+			values[settings[i].Key] = settingJSON(&settings[i])
+		}
+	}
+	return values, nil
+}
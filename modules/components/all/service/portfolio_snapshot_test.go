@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRunLoadersCancelsSlowLoader(t *testing.T) {
+	s := &portfolioSnapshotService{timeout: 20 * time.Millisecond}
+	cancelled := make(chan error, 1)
+
+	results := s.runLoaders(context.Background(), map[string]snapshotLoader{
+		"fast": func(ctx context.Context) (interface{}, error) { return "ok", nil },
+		"slow": func(ctx context.Context) (interface{}, error) {
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return nil, ctx.Err()
+		},
+	})
+
+	if results["fast"].data != "ok" || results["fast"].err != nil {
+		t.Fatalf("fast = %+v", results["fast"])
+	}
+	if results["slow"].err == nil {
+		t.Fatal("slow loader must be reported as timeout")
+	}
+
+	// Timeout bukan cuma berhenti menunggu: ctx loader ikut dibatalkan
+	select {
+	case err := <-cancelled:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("ctx.Err() = %v, want DeadlineExceeded", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("loader ctx was not cancelled")
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	projectrepo "gintugas/modules/components/Project/repository"
+	"gintugas/modules/components/all/repo"
+	experepo "gintugas/modules/components/experiences/repo"
+	"gintugas/modules/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// REORDER SERVICE
+// ============================
+// Satu endpoint POST /{resource}/reorder untuk semua resource yang punya
+// display_order. Setiap repository menjalankan reorder dalam transaksinya
+// sendiri; validasi kelengkapan ID dilakukan di dalam transaksi tersebut.
+
+type ReorderService interface {
+	Reorder(ctx *gin.Context, resource string) ([]uuid.UUID, error)
+}
+
+type ReorderRepos struct {
+	Projects     projectrepo.Repository
+	Skills       repo.SkillRepository
+	Certificates repo.CertificateRepository
+	Education    repo.EducationRepository
+	Experiences  experepo.ExperiencesRepository
+	Testimonials repo.TestimonialRepository
+	Sections     repo.SectionRepository
+	SocialLinks  repo.SocialLinkRepository
+}
+
+type reorderService struct {
+	reorderers map[string]func(ids []uuid.UUID) error
+}
+
+func NewReorderService(repos ReorderRepos) ReorderService {
+	return &reorderService{
+		reorderers: map[string]func(ids []uuid.UUID) error{
+			"projects":     repos.Projects.ReorderProjekRepository,
+			"skills":       repos.Skills.Reorder,
+			"certificates": repos.Certificates.Reorder,
+			"education":    repos.Education.Reorder,
+			"experiences":  repos.Experiences.ReorderExperiences,
+			"testimonials": repos.Testimonials.Reorder,
+			"sections":     repos.Sections.Reorder,
+			"social-links": repos.SocialLinks.Reorder,
+		},
+	}
+}
+
+func (s *reorderService) Reorder(ctx *gin.Context, resource string) ([]uuid.UUID, error) {
+	reorder, ok := s.reorderers[resource]
+	if !ok {
+		return nil, utils.NotFound(resource)
+	}
+
+	var req utils.ReorderRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	if err := reorder(req.IDs); err != nil {
+		return nil, err
+	}
+	return req.IDs, nil
+}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	expemodel "gintugas/modules/components/experiences/model"
+	experepo "gintugas/modules/components/experiences/repo"
+	expeservice "gintugas/modules/components/experiences/service"
+	"gintugas/modules/utils"
+	"hash"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// RESUME SERVICE
+// ============================
+// Resume/CV di-generate dari experiences, education, skills, certificates,
+// social links dan settings publik, jadi tidak lagi bergeser dari data
+// portfolio seperti PDF manual di cv_url. Export JSON Resume dan import-nya
+// ada di resume_json.go. Hasil render di-cache per format,
+// template, locale, timezone dan fingerprint data (updated_at terbaru plus
+// id/version setiap baris, sehingga data yang dihapus juga terdeteksi).
+
+const (
+	ResumeFormatPDF      = "pdf"
+	ResumeFormatMarkdown = "md"
+	ResumeFormatHTML     = "html"
+	ResumeFormatJSON     = "json"
+
+	maxResumeCacheEntries = 32
+)
+
+var resumeContentTypes = map[string]string{
+	ResumeFormatPDF:      "application/pdf",
+	ResumeFormatMarkdown: "text/markdown; charset=utf-8",
+	ResumeFormatHTML:     "text/html; charset=utf-8",
+	ResumeFormatJSON:     "application/json; charset=utf-8",
+}
+
+type ResumeService interface {
+	Render(ctx *gin.Context, format string) (*RenderedResume, error)
+	GetTemplates() []model.ResumeTemplateResponse
+	Import(ctx *gin.Context) (*model.ResumeImportReport, error)
+}
+
+type ResumeRepos struct {
+	Experiences  experepo.ExperiencesRepository
+	Education    repo.EducationRepository
+	Skills       repo.SkillRepository
+	Certificates repo.CertificateRepository
+	SocialLinks  repo.SocialLinkRepository
+	Settings     repo.SettingRepository
+
+	// Transaction menjalankan fn dengan repos yang terikat ke satu transaksi
+	// database (dipakai import JSON Resume)
+	Transaction func(fn func(tx ResumeRepos) error) error
+}
+
+type RenderedResume struct {
+	Body        []byte
+	ContentType string
+	Filename    string
+	UpdatedAt   time.Time
+}
+
+type resumeService struct {
+	repos     ResumeRepos
+	settings  *SettingsReader
+	localizer utils.Localizer
+
+	mu    sync.Mutex
+	cache map[string]*RenderedResume
+}
+
+func NewResumeService(repos ResumeRepos, localizer utils.Localizer) ResumeService {
+	return &resumeService{
+		repos:     repos,
+		settings:  NewSettingsReader(repos.Settings),
+		localizer: localizer,
+		cache:     make(map[string]*RenderedResume),
+	}
+}
+
+func (s *resumeService) Render(ctx *gin.Context, format string) (*RenderedResume, error) {
+	contentType, ok := resumeContentTypes[format]
+	if !ok {
+		return nil, utils.NotFound("resume format")
+	}
+
+	tmpl, err := s.resolveTemplate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := loadResumeSource(s.repos)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := map[string]utils.Translations{
+		utils.TranslatableExperience: utils.Localize(s.localizer, ctx, utils.TranslatableExperience),
+		utils.TranslatableEducation:  utils.Localize(s.localizer, ctx, utils.TranslatableEducation),
+	}
+	dates := utils.NewDateFormatter(ctx)
+
+	key := resumeCacheKey(format, tmpl.Name, dates, source.fingerprint, translations)
+	if cached := s.cached(key); cached != nil {
+		return cached, nil
+	}
+
+	resume := source.build(translations, dates)
+	labels := resumeLabelsFor(dates.Locale)
+
+	var body []byte
+	switch format {
+	case ResumeFormatJSON:
+		body, err = renderJSONResume(source.jsonResume(translations, dates))
+	case ResumeFormatPDF:
+		body, err = renderResumePDF(resume, tmpl, labels)
+	case ResumeFormatMarkdown:
+		body = renderResumeMarkdown(resume, tmpl, labels)
+	case ResumeFormatHTML:
+		body, err = renderResumeHTML(resume, tmpl, labels, dates.Locale)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &RenderedResume{
+		Body:        body,
+		ContentType: contentType,
+		Filename:    resumeFilename(resume.Name, format),
+		UpdatedAt:   source.updatedAt,
+	}
+	s.store(key, rendered)
+	return rendered, nil
+}
+
+func (s *resumeService) GetTemplates() []model.ResumeTemplateResponse {
+	defaultName := s.defaultTemplate()
+
+	templates := make([]model.ResumeTemplateResponse, 0, len(resumeTemplateOrder))
+	for _, name := range resumeTemplateOrder {
+		tmpl := resumeTemplates[name]
+		templates = append(templates, model.ResumeTemplateResponse{
+			Name:        tmpl.Name,
+			Label:       tmpl.Label,
+			Description: tmpl.Description,
+			IsDefault:   tmpl.Name == defaultName,
+		})
+	}
+	return templates
+}
+
+// resolveTemplate: ?template= > setting resume_template > classic
+func (s *resumeService) resolveTemplate(ctx *gin.Context) (resumeTemplate, error) {
+	name := strings.ToLower(strings.TrimSpace(ctx.Query("template")))
+	if name == "" {
+		name = s.defaultTemplate()
+	}
+
+	tmpl, ok := resumeTemplates[name]
+	if !ok {
+		return resumeTemplate{}, utils.FieldValidationError(ctx, "template", "oneof", strings.Join(resumeTemplateOrder, " "))
+	}
+	return tmpl, nil
+}
+
+func (s *resumeService) defaultTemplate() string {
+	name := strings.ToLower(strings.TrimSpace(SettingValue(s.settings, "resume_template", defaultResumeTemplate)))
+	if _, ok := resumeTemplates[name]; !ok {
+		return defaultResumeTemplate
+	}
+	return name
+}
+
+func (s *resumeService) cached(key string) *RenderedResume {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache[key]
+}
+
+// store membuang seluruh cache saat penuh; entry lama dengan fingerprint
+// usang memang tidak akan pernah dipakai lagi
+func (s *resumeService) store(key string, rendered *RenderedResume) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cache) >= maxResumeCacheEntries {
+		s.cache = make(map[string]*RenderedResume)
+	}
+	s.cache[key] = rendered
+}
+
+// ============================
+// RESUME SOURCE DATA
+// ============================
+
+type resumeSource struct {
+	experiences  []expemodel.ExperienceWithRelations
+	education    []model.Education
+	skills       []model.Skill
+	certificates []model.Certificate
+	socialLinks  []model.SocialLink
+	settings     map[string]*model.Setting // hanya setting publik
+
+	fingerprint string
+	updatedAt   time.Time
+}
+
+func loadResumeSource(repos ResumeRepos) (*resumeSource, error) {
+	source := &resumeSource{settings: make(map[string]*model.Setting)}
+	var err error
+
+	if source.experiences, err = repos.Experiences.GetAllExperiencesWithRelations(); err != nil {
+		return nil, err
+	}
+	if source.education, err = repos.Education.GetAllWithAchievements(); err != nil {
+		return nil, err
+	}
+	if source.skills, err = repos.Skills.GetAll(); err != nil {
+		return nil, err
+	}
+	if source.certificates, err = repos.Certificates.GetAll(); err != nil {
+		return nil, err
+	}
+	if source.socialLinks, err = repos.SocialLinks.GetAll(); err != nil {
+		return nil, err
+	}
+	settings, err := repos.Settings.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	track := func(kind, id string, version int, updatedAt time.Time) {
+		fmt.Fprintf(h, "%s:%s:%d:%d\n", kind, id, version, updatedAt.UnixNano())
+		if updatedAt.After(source.updatedAt) {
+			source.updatedAt = updatedAt
+		}
+	}
+
+	for _, exp := range source.experiences {
+		track("experience", exp.ID.String(), exp.Version, exp.UpdatedAt)
+	}
+	for _, edu := range source.education {
+		track("education", edu.ID.String(), edu.Version, edu.UpdatedAt)
+	}
+	for _, skill := range source.skills {
+		track("skill", skill.ID.String(), skill.Version, skill.UpdatedAt)
+	}
+	// Certificate tidak punya updated_at; perubahan terlihat dari version
+	for _, cert := range source.certificates {
+		track("certificate", cert.ID.String(), cert.Version, cert.CreatedAt)
+	}
+	for _, link := range source.socialLinks {
+		track("social_link", link.ID.String(), link.Version, link.UpdatedAt)
+	}
+	for i := range settings {
+		if !settings[i].IsPublic {
+			continue
+		}
+		source.settings[settings[i].Key] = &settings[i]
+		track("setting", settings[i].Key, settings[i].Version, settings[i].UpdatedAt)
+	}
+
+	source.fingerprint = hex.EncodeToString(h.Sum(nil))
+	return source, nil
+}
+
+func (src *resumeSource) setting(key string) string {
+	setting, ok := src.settings[key]
+	if !ok {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(settingJSON(setting), &value); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
+
+// build memetakan data mentah ke model.Resume dengan terjemahan dan format
+// tanggal request
+func (src *resumeSource) build(translations map[string]utils.Translations, dates utils.DateFormatter) *model.Resume {
+	resume := &model.Resume{
+		Name:         src.setting("resume_name"),
+		Headline:     src.setting("resume_headline"),
+		Summary:      src.setting("resume_summary"),
+		Email:        src.setting("contact_email"),
+		Phone:        src.setting("phone_number"),
+		Location:     src.setting("location"),
+		Links:        []model.ResumeLink{},
+		Experiences:  make([]model.ResumeExperience, 0, len(src.experiences)),
+		Education:    make([]model.ResumeEducation, 0, len(src.education)),
+		Skills:       []model.ResumeSkillGroup{},
+		Certificates: make([]model.ResumeCertificate, 0, len(src.certificates)),
+		UpdatedAt:    src.updatedAt,
+	}
+	if resume.Name == "" {
+		// site_title bawaan berformat "Nama - Portfolio"
+		resume.Name, _, _ = strings.Cut(src.setting("site_title"), " - ")
+	}
+
+	for _, link := range src.socialLinks {
+		if link.IsActive && !strings.HasPrefix(link.URL, "mailto:") {
+			resume.Links = append(resume.Links, model.ResumeLink{Platform: link.Platform, URL: link.URL})
+		}
+	}
+
+	for i := range src.experiences {
+		exp := expeservice.ConvertToResponse(&src.experiences[i], dates)
+		expeservice.LocalizeExperience(translations[utils.TranslatableExperience], exp)
+
+		item := model.ResumeExperience{
+			Title:      exp.Title,
+			Company:    exp.Company,
+			Location:   exp.Location,
+			Period:     exp.Period,
+			Highlights: []string{},
+			Skills:     []string{},
+		}
+		sort.SliceStable(exp.Responsibilities, func(a, b int) bool {
+			return exp.Responsibilities[a].DisplayOrder < exp.Responsibilities[b].DisplayOrder
+		})
+		for _, resp := range exp.Responsibilities {
+			item.Highlights = append(item.Highlights, resp.Description)
+		}
+		for _, skill := range exp.Skills {
+			item.Skills = append(item.Skills, skill.SkillName)
+		}
+		resume.Experiences = append(resume.Experiences, item)
+	}
+
+	for i := range src.education {
+		edu := convertEducationToResponse(&src.education[i], dates)
+		localizeEducation(translations[utils.TranslatableEducation], edu)
+
+		item := model.ResumeEducation{
+			School:       edu.School,
+			Major:        edu.Major,
+			Degree:       edu.Degree,
+			Period:       edu.Period,
+			Description:  edu.Description,
+			Achievements: []string{},
+		}
+		for _, ach := range edu.Achievements {
+			item.Achievements = append(item.Achievements, ach.Achievement)
+		}
+		resume.Education = append(resume.Education, item)
+	}
+
+	// Skill dikelompokkan per kategori sesuai urutan kemunculan pertama
+	groups := make(map[string]int)
+	for _, skill := range src.skills {
+		category := strings.TrimSpace(skill.Category)
+		index, ok := groups[category]
+		if !ok {
+			index = len(resume.Skills)
+			groups[category] = index
+			resume.Skills = append(resume.Skills, model.ResumeSkillGroup{Category: category})
+		}
+		resume.Skills[index].Skills = append(resume.Skills[index].Skills, skill.Name)
+	}
+
+	for _, cert := range src.certificates {
+		item := model.ResumeCertificate{Name: cert.Name, Issuer: cert.Issuer, URL: cert.CredentialURL}
+		if !cert.IssueDate.IsZero() {
+			issued := cert.IssueDate
+			item.Date = dates.MonthLabel(&issued, utils.DatePrecisionMonth)
+		}
+		resume.Certificates = append(resume.Certificates, item)
+	}
+
+	return resume
+}
+
+// resumeCacheKey ikut memasukkan bulan berjalan (durasi periode ongoing) dan
+// isi terjemahan yang dipakai, karena keduanya bisa berubah tanpa menyentuh
+// updated_at data utama
+func resumeCacheKey(format, template string, dates utils.DateFormatter, fingerprint string, translations map[string]utils.Translations) string {
+	h := sha256.New()
+	location := ""
+	if dates.Location != nil {
+		location = dates.Location.String()
+		dates.Now = dates.Now.In(dates.Location)
+	}
+	fmt.Fprintf(h, "%s|%s|%s|%s|%s|%s\n", format, template, dates.Locale, location, dates.Now.Format("2006-01"), fingerprint)
+
+	entityTypes := make([]string, 0, len(translations))
+	for entityType := range translations {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	for _, entityType := range entityTypes {
+		hashTranslations(h, entityType, translations[entityType])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hashTranslations(h hash.Hash, entityType string, translations utils.Translations) {
+	ids := make([]uuid.UUID, 0, len(translations))
+	for id := range translations {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	for _, id := range ids {
+		fields := make([]string, 0, len(translations[id]))
+		for field := range translations[id] {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Fprintf(h, "%s:%s:%s=%q\n", entityType, id, field, translations[id][field])
+		}
+	}
+}
+
+// resumeFilename misalnya "resume-muhammad-fathiir-farhansyah.pdf"
+func resumeFilename(name, format string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+			dash = false
+		} else if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimSuffix(sb.String(), "-")
+	if slug == "" {
+		return "resume." + format
+	}
+	return "resume-" + slug + "." + format
+}
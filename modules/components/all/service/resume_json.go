@@ -0,0 +1,910 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	expemodel "gintugas/modules/components/experiences/model"
+	expeservice "gintugas/modules/components/experiences/service"
+	"gintugas/modules/utils"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ============================
+// JSON RESUME EXPORT
+// ============================
+
+const (
+	jsonResumeSchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+	jsonResumeVersion   = "v1.0.0"
+)
+
+// Skill.Value (0-100) dipetakan ke level teks yang umum dipakai tema JSON
+// Resume; value dipakai saat import level teks membuat skill baru
+var jsonResumeSkillLevels = []struct {
+	label string
+	min   int
+	value int
+}{
+	{"Master", 90, 95},
+	{"Advanced", 75, 80},
+	{"Intermediate", 50, 60},
+	{"Beginner", 0, 30},
+}
+
+func jsonResumeSkillLevel(value int) string {
+	for _, level := range jsonResumeSkillLevels {
+		if value >= level.min {
+			return level.label
+		}
+	}
+	return jsonResumeSkillLevels[len(jsonResumeSkillLevels)-1].label
+}
+
+// jsonResume memetakan data mentah ke dokumen JSON Resume. Teks ikut
+// terjemahan request, tanggal tetap ISO 8601 (YYYY-MM atau YYYY).
+func (src *resumeSource) jsonResume(translations map[string]utils.Translations, dates utils.DateFormatter) *model.JSONResume {
+	doc := &model.JSONResume{
+		Schema: jsonResumeSchemaURL,
+		Basics: model.JSONResumeBasics{
+			Name:     src.setting("resume_name"),
+			Label:    src.setting("resume_headline"),
+			Email:    src.setting("contact_email"),
+			Phone:    src.setting("phone_number"),
+			Summary:  src.setting("resume_summary"),
+			Profiles: []model.JSONResumeProfile{},
+		},
+		Work:         make([]model.JSONResumeWork, 0, len(src.experiences)),
+		Education:    make([]model.JSONResumeEducation, 0, len(src.education)),
+		Skills:       make([]model.JSONResumeSkill, 0, len(src.skills)),
+		Certificates: make([]model.JSONResumeCertificate, 0, len(src.certificates)),
+		Meta:         &model.JSONResumeMeta{Version: jsonResumeVersion},
+	}
+	if doc.Basics.Name == "" {
+		doc.Basics.Name, _, _ = strings.Cut(src.setting("site_title"), " - ")
+	}
+	if location := src.setting("location"); location != "" {
+		doc.Basics.Location = &model.JSONResumeLocation{Address: location}
+	}
+	if !src.updatedAt.IsZero() {
+		doc.Meta.LastModified = src.updatedAt.UTC().Format(time.RFC3339)
+	}
+
+	// Email sudah ada di basics.email
+	for _, link := range src.socialLinks {
+		if link.IsActive && !strings.HasPrefix(link.URL, "mailto:") {
+			doc.Basics.Profiles = append(doc.Basics.Profiles, model.JSONResumeProfile{
+				Network:  link.Platform,
+				Username: profileUsername(link.URL),
+				URL:      link.URL,
+			})
+		}
+	}
+
+	for i := range src.experiences {
+		exp := expeservice.ConvertToResponse(&src.experiences[i], dates)
+		expeservice.LocalizeExperience(translations[utils.TranslatableExperience], exp)
+
+		work := model.JSONResumeWork{
+			Name:       exp.Company,
+			Position:   exp.Title,
+			Location:   exp.Location,
+			StartDate:  exp.StartDate,
+			Highlights: []string{},
+			Keywords:   []string{},
+		}
+		if exp.EndDate != nil {
+			work.EndDate = *exp.EndDate
+		}
+		sort.SliceStable(exp.Responsibilities, func(a, b int) bool {
+			return exp.Responsibilities[a].DisplayOrder < exp.Responsibilities[b].DisplayOrder
+		})
+		for _, resp := range exp.Responsibilities {
+			work.Highlights = append(work.Highlights, resp.Description)
+		}
+		for _, skill := range exp.Skills {
+			work.Keywords = append(work.Keywords, skill.SkillName)
+		}
+		doc.Work = append(doc.Work, work)
+	}
+
+	for i := range src.education {
+		edu := convertEducationToResponse(&src.education[i], dates)
+		localizeEducation(translations[utils.TranslatableEducation], edu)
+
+		item := model.JSONResumeEducation{
+			Institution: edu.School,
+			Area:        edu.Major,
+			StudyType:   edu.Degree,
+			StartDate:   edu.StartDate,
+			Summary:     edu.Description,
+			Highlights:  []string{},
+		}
+		if edu.EndDate != nil {
+			item.EndDate = *edu.EndDate
+		}
+		for _, ach := range edu.Achievements {
+			item.Highlights = append(item.Highlights, ach.Achievement)
+		}
+		doc.Education = append(doc.Education, item)
+	}
+
+	for _, skill := range src.skills {
+		item := model.JSONResumeSkill{
+			Name:     skill.Name,
+			Level:    jsonResumeSkillLevel(skill.Value),
+			Keywords: []string{},
+		}
+		if category := strings.TrimSpace(skill.Category); category != "" {
+			item.Keywords = append(item.Keywords, category)
+		}
+		doc.Skills = append(doc.Skills, item)
+	}
+
+	for _, cert := range src.certificates {
+		item := model.JSONResumeCertificate{Name: cert.Name, Issuer: cert.Issuer, URL: cert.CredentialURL}
+		if !cert.IssueDate.IsZero() {
+			item.Date = cert.IssueDate.Format("2006-01-02")
+		}
+		doc.Certificates = append(doc.Certificates, item)
+	}
+
+	return doc
+}
+
+func renderJSONResume(doc *model.JSONResume) ([]byte, error) {
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// profileUsername mengambil segmen terakhir path URL profil, misalnya
+// "https://github.com/fathiir" -> "fathiir"
+func profileUsername(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	path := strings.Trim(parsed.Path, "/")
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		path = path[i+1:]
+	}
+	return strings.TrimPrefix(path, "@")
+}
+
+// ============================
+// JSON RESUME IMPORT
+// ============================
+// Import meng-upsert data portfolio dari dokumen JSON Resume tanpa menghapus
+// apa pun. Entry dicocokkan lewat natural key: work lewat company + position
+// + bulan mulai, education lewat institution + area, skill lewat nama,
+// certificate lewat nama + issuer, profile lewat platform dan basics lewat
+// key setting. Seluruh dokumen divalidasi sebelum ada yang ditulis, lalu
+// penulisan memakai repo yang sama dengan endpoint admin lain (transaksi per
+// entry, read cache ikut terinvalidasi). Jika gagal di tengah jalan import
+// cukup diulang; entry yang sudah tersimpan akan tercatat unchanged.
+
+const (
+	resumeImportCreate    = "create"
+	resumeImportUpdate    = "update"
+	resumeImportUnchanged = "unchanged"
+)
+
+var resumeImportEntities = []string{"setting", "social_link", "experience", "education", "skill", "certificate"}
+
+// Setting yang diisi dari basics; nilai kosong di dokumen dilewati
+var jsonResumeSettings = []struct {
+	key   string
+	field string
+	value func(basics *model.JSONResumeBasics) string
+}{
+	{"resume_name", "basics.name", func(b *model.JSONResumeBasics) string { return b.Name }},
+	{"resume_headline", "basics.label", func(b *model.JSONResumeBasics) string { return b.Label }},
+	{"resume_summary", "basics.summary", func(b *model.JSONResumeBasics) string { return b.Summary }},
+	{"contact_email", "basics.email", func(b *model.JSONResumeBasics) string { return b.Email }},
+	{"phone_number", "basics.phone", func(b *model.JSONResumeBasics) string { return b.Phone }},
+	{"location", "basics.location", jsonResumeAddress},
+}
+
+func jsonResumeAddress(basics *model.JSONResumeBasics) string {
+	location := basics.Location
+	if location == nil {
+		return ""
+	}
+	if address := strings.TrimSpace(location.Address); address != "" {
+		return address
+	}
+	return joinNonEmpty(", ", strings.TrimSpace(location.City), strings.TrimSpace(location.Region), strings.TrimSpace(location.CountryCode))
+}
+
+// jsonResumeInput adalah dokumen yang sudah divalidasi dan di-parse
+type jsonResumeInput struct {
+	doc          *model.JSONResume
+	workDates    []utils.DateRange
+	eduDates     []utils.DateRange
+	skillLevels  []jsonResumeLevel
+	certificates []time.Time
+}
+
+type jsonResumeLevel struct {
+	value int
+	label string // kosong jika level berupa angka
+	set   bool
+}
+
+func (s *resumeService) Import(ctx *gin.Context) (*model.ResumeImportReport, error) {
+	var doc model.JSONResume
+	if err := utils.BindJSON(ctx, &doc); err != nil {
+		return nil, err
+	}
+
+	input, err := parseJSONResume(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := loadResumeSource(s.repos)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &resumeImportPlan{report: &model.ResumeImportReport{
+		DryRun:  ctx.Query("dry_run") == "true",
+		Summary: make(map[string]model.ResumeImportCount, len(resumeImportEntities)),
+		Changes: []model.ResumeImportChange{},
+	}}
+	for _, entity := range resumeImportEntities {
+		plan.report.Summary[entity] = model.ResumeImportCount{}
+	}
+
+	if err := s.planSettings(plan, &doc.Basics); err != nil {
+		return nil, err
+	}
+	s.planSocialLinks(plan, source.socialLinks, doc.Basics.Profiles)
+	s.planExperiences(plan, source.experiences, input)
+	s.planEducation(plan, source.education, input)
+	s.planSkills(plan, source.skills, input)
+	s.planCertificates(plan, source.certificates, input)
+
+	if plan.report.DryRun {
+		return plan.report, nil
+	}
+
+	if len(plan.writes) > 0 {
+		// Semua write dalam satu transaksi: import yang gagal di tengah
+		// tidak meninggalkan data setengah jadi
+		err := s.repos.Transaction(func(tx ResumeRepos) error {
+			for _, write := range plan.writes {
+				if err := write(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Fingerprint sudah berubah; hasil render lama tidak akan terpakai lagi
+		s.mu.Lock()
+		s.cache = make(map[string]*RenderedResume)
+		s.mu.Unlock()
+	}
+	return plan.report, nil
+}
+
+// parseJSONResume memvalidasi bagian yang tidak bisa dicek tag binding:
+// tanggal, URL profil per platform, level skill dan entry ganda
+func parseJSONResume(doc *model.JSONResume) (*jsonResumeInput, error) {
+	input := &jsonResumeInput{doc: doc}
+	var fields []utils.FieldError
+
+	platforms := make(map[string]bool)
+	for i, profile := range doc.Basics.Profiles {
+		prefix := fmt.Sprintf("basics.profiles[%d]", i)
+		key := normalizePlatform(profile.Network)
+		if platforms[key] {
+			fields = append(fields, duplicateImportEntry(prefix+".network"))
+		}
+		platforms[key] = true
+
+		if err := checkSocialLinkURL(profile.Network, strings.TrimSpace(profile.URL)); err != nil {
+			fields = append(fields, prefixFieldErrors(prefix, err)...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i, work := range doc.Work {
+		prefix := fmt.Sprintf("work[%d]", i)
+		dates, errs := jsonResumeDateRange(work.StartDate, work.EndDate, prefix)
+		fields = append(fields, errs...)
+		input.workDates = append(input.workDates, dates)
+
+		key := experienceImportKey(work.Name, work.Position, dates.Start)
+		if len(errs) == 0 && seen[key] {
+			fields = append(fields, duplicateImportEntry(prefix))
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for i, edu := range doc.Education {
+		prefix := fmt.Sprintf("education[%d]", i)
+		dates, errs := jsonResumeDateRange(edu.StartDate, edu.EndDate, prefix)
+		fields = append(fields, errs...)
+		input.eduDates = append(input.eduDates, dates)
+
+		key := educationImportKey(edu.Institution, edu.Area)
+		if seen[key] {
+			fields = append(fields, duplicateImportEntry(prefix))
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for i, skill := range doc.Skills {
+		prefix := fmt.Sprintf("skills[%d]", i)
+		level, ok := parseJSONResumeSkillLevel(skill.Level)
+		if !ok {
+			fields = append(fields, utils.NewFieldError(prefix+".level", "level", "resume_skill_level"))
+		}
+		input.skillLevels = append(input.skillLevels, level)
+
+		key := skillImportKey(skill.Name)
+		if seen[key] {
+			fields = append(fields, duplicateImportEntry(prefix+".name"))
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for i, cert := range doc.Certificates {
+		prefix := fmt.Sprintf("certificates[%d]", i)
+		date, ok := parseJSONResumeDate(cert.Date)
+		if !ok {
+			fields = append(fields, utils.NewFieldError(prefix+".date", "date", "resume_date"))
+		}
+		input.certificates = append(input.certificates, date)
+
+		key := certificateImportKey(cert.Name, cert.Issuer)
+		if seen[key] {
+			fields = append(fields, duplicateImportEntry(prefix))
+		}
+		seen[key] = true
+	}
+
+	if len(fields) > 0 {
+		return nil, utils.ValidationFailed(fields)
+	}
+	return input, nil
+}
+
+// jsonResumeDateRange: tanggal ISO 8601 dipotong ke bulan; endDate kosong
+// dengan startDate terisi berarti masih berjalan (konvensi JSON Resume)
+func jsonResumeDateRange(start, end, prefix string) (utils.DateRange, []utils.FieldError) {
+	startField, endField := prefix+".startDate", prefix+".endDate"
+	start, end = trimISODay(strings.TrimSpace(start)), trimISODay(strings.TrimSpace(end))
+
+	dates, err := utils.ParseDateRange(start, end, start != "" && end == "", startField, endField)
+	if err != nil {
+		return utils.DateRange{}, prefixFieldErrors("", err)
+	}
+	if dates.Start != nil && dates.End != nil && dates.End.Before(*dates.Start) {
+		return utils.DateRange{}, []utils.FieldError{utils.NewFieldError(endField, "yearrange", "yearrange")}
+	}
+	return dates, nil
+}
+
+func trimISODay(value string) string {
+	if _, err := time.Parse("2006-01-02", value); err == nil {
+		return value[:7]
+	}
+	return value
+}
+
+func parseJSONResumeDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, true
+	}
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func parseJSONResumeSkillLevel(level string) (jsonResumeLevel, bool) {
+	level = strings.TrimSpace(level)
+	if level == "" {
+		return jsonResumeLevel{}, true
+	}
+	if value, err := strconv.Atoi(strings.TrimSuffix(level, "%")); err == nil {
+		return jsonResumeLevel{value: value, set: true}, value >= 0 && value <= 100
+	}
+	for _, known := range jsonResumeSkillLevels {
+		if strings.EqualFold(level, known.label) {
+			return jsonResumeLevel{value: known.value, label: known.label, set: true}, true
+		}
+	}
+	return jsonResumeLevel{}, false
+}
+
+// prefixFieldErrors mengambil field error dari AppError validasi dan
+// menambahkan path entry di depannya
+func prefixFieldErrors(prefix string, err error) []utils.FieldError {
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) || len(appErr.Fields) == 0 {
+		return []utils.FieldError{{Field: prefix, Rule: "invalid", Message: err.Error()}}
+	}
+
+	fields := make([]utils.FieldError, 0, len(appErr.Fields))
+	for _, field := range appErr.Fields {
+		if prefix != "" {
+			field.Field = prefix + "." + field.Field
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func duplicateImportEntry(field string) utils.FieldError {
+	return utils.NewFieldError(field, "unique", "resume_duplicate")
+}
+
+func experienceImportKey(company, title string, start *time.Time) string {
+	month := ""
+	if start != nil {
+		month = start.Format("2006-01")
+	}
+	return importKey(company) + "|" + importKey(title) + "|" + month
+}
+
+func educationImportKey(school, major string) string {
+	return importKey(school) + "|" + importKey(major)
+}
+
+func skillImportKey(name string) string {
+	return importKey(name)
+}
+
+func certificateImportKey(name, issuer string) string {
+	return importKey(name) + "|" + importKey(issuer)
+}
+
+func importKey(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
+
+// ============================
+// IMPORT PLAN & DIFF
+// ============================
+
+type resumeImportPlan struct {
+	report *model.ResumeImportReport
+	writes []func(repos ResumeRepos) error
+}
+
+func (p *resumeImportPlan) add(entity, key string, id *uuid.UUID, diff *resumeFieldDiff, write func(repos ResumeRepos) error) {
+	change := model.ResumeImportChange{Entity: entity, Key: key, ID: id, Fields: diff.fields}
+
+	count := p.report.Summary[entity]
+	switch {
+	case id == nil:
+		change.Action = resumeImportCreate
+		count.Created++
+	case len(diff.fields) > 0:
+		change.Action = resumeImportUpdate
+		count.Updated++
+	default:
+		change.Action = resumeImportUnchanged
+		count.Unchanged++
+	}
+	p.report.Summary[entity] = count
+	p.report.Changes = append(p.report.Changes, change)
+
+	if change.Action != resumeImportUnchanged {
+		p.writes = append(p.writes, write)
+	}
+}
+
+// resumeFieldDiff mencatat field yang berubah; untuk entry baru old selalu
+// null dan field yang kosong tidak dicatat
+type resumeFieldDiff struct {
+	create bool
+	fields []model.ResumeImportFieldChange
+}
+
+func newResumeFieldDiff(create bool) *resumeFieldDiff {
+	return &resumeFieldDiff{create: create}
+}
+
+func (d *resumeFieldDiff) set(field string, old, new interface{}) {
+	if d.create {
+		if value := reflect.ValueOf(new); value.IsZero() || (value.Kind() == reflect.Slice && value.Len() == 0) {
+			return
+		}
+		old = nil
+	} else if reflect.DeepEqual(old, new) {
+		return
+	}
+	d.fields = append(d.fields, model.ResumeImportFieldChange{Field: field, Old: old, New: new})
+}
+
+func (d *resumeFieldDiff) dates(old, new utils.DateRange) {
+	d.set("start_date", utils.FormatMonthDate(old.Start, old.Precision), utils.FormatMonthDate(new.Start, new.Precision))
+	d.set("end_date", utils.FormatMonthDate(old.End, old.Precision), utils.FormatMonthDate(new.End, new.Precision))
+	d.set("is_ongoing", old.Ongoing, new.Ongoing)
+}
+
+// importText: string kosong di dokumen berarti nilai lama dipertahankan
+func importText(current, value string) string {
+	if value = strings.TrimSpace(value); value != "" {
+		return value
+	}
+	return current
+}
+
+// importList: array null di dokumen berarti isi lama dipertahankan, array
+// kosong berarti dikosongkan
+func importList(current, values []string) []string {
+	if values == nil {
+		return current
+	}
+	list := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !seen[value] {
+			seen[value] = true
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func nextDisplayOrder(orders ...int) int {
+	next := 0
+	for _, order := range orders {
+		if order >= next {
+			next = order + 1
+		}
+	}
+	return next
+}
+
+func uuidRef(id uuid.UUID) *uuid.UUID {
+	return &id
+}
+
+// ============================
+// IMPORT PER ENTITY
+// ============================
+
+func (s *resumeService) planSettings(plan *resumeImportPlan, basics *model.JSONResumeBasics) error {
+	var fields []utils.FieldError
+
+	for _, mapping := range jsonResumeSettings {
+		value := strings.TrimSpace(mapping.value(basics))
+		if value == "" {
+			continue
+		}
+
+		existing, err := s.repos.Settings.GetByKey(mapping.key)
+		created := errors.Is(err, gorm.ErrRecordNotFound)
+		if err != nil && !created {
+			return err
+		}
+		if !created && existing.DataType != model.SettingTypeString && existing.DataType != "" {
+			fields = append(fields, utils.NewFieldError(mapping.field, "data_type", "resume_setting_type",
+				"key", mapping.key, "data_type", existing.DataType))
+			continue
+		}
+
+		diff := newResumeFieldDiff(created)
+		if created {
+			setting := &model.Setting{
+				Key:         mapping.key,
+				Value:       value,
+				DataType:    model.SettingTypeString,
+				Description: "Diimpor dari JSON Resume",
+				IsPublic:    true,
+			}
+			diff.set("value", nil, value)
+			plan.add("setting", mapping.key, nil, diff, func(repos ResumeRepos) error { return repos.Settings.Create(setting) })
+			continue
+		}
+
+		setting := *existing
+		setting.Value = value
+		diff.set("value", existing.Value, value)
+		plan.add("setting", mapping.key, uuidRef(existing.ID), diff, func(repos ResumeRepos) error { return repos.Settings.Update(&setting) })
+	}
+
+	if len(fields) > 0 {
+		return utils.ValidationFailed(fields)
+	}
+	return nil
+}
+
+func (s *resumeService) planSocialLinks(plan *resumeImportPlan, links []model.SocialLink, profiles []model.JSONResumeProfile) {
+	existing := make(map[string]model.SocialLink, len(links))
+	orders := make([]int, 0, len(links))
+	for _, link := range links {
+		existing[normalizePlatform(link.Platform)] = link
+		orders = append(orders, link.DisplayOrder)
+	}
+	order := nextDisplayOrder(orders...)
+
+	for _, profile := range profiles {
+		platform := strings.TrimSpace(profile.Network)
+		rawURL := strings.TrimSpace(profile.URL)
+
+		current, found := existing[normalizePlatform(platform)]
+		diff := newResumeFieldDiff(!found)
+		if !found {
+			link := &model.SocialLink{
+				Platform:     platform,
+				URL:          rawURL,
+				IconName:     socialLinkIcon(platform),
+				DisplayOrder: order,
+				IsActive:     true,
+			}
+			order++
+			diff.set("platform", nil, link.Platform)
+			diff.set("url", nil, link.URL)
+			plan.add("social_link", platform, nil, diff, func(repos ResumeRepos) error { return repos.SocialLinks.Create(link) })
+			continue
+		}
+
+		link := current
+		link.URL = rawURL
+		diff.set("url", current.URL, link.URL)
+		plan.add("social_link", current.Platform, uuidRef(current.ID), diff, func(repos ResumeRepos) error { return repos.SocialLinks.Update(&link) })
+	}
+}
+
+func (s *resumeService) planExperiences(plan *resumeImportPlan, experiences []expemodel.ExperienceWithRelations, input *jsonResumeInput) {
+	existing := make(map[string]*expemodel.ExperienceWithRelations, len(experiences))
+	orders := make([]int, 0, len(experiences))
+	for i := range experiences {
+		exp := &experiences[i]
+		existing[experienceImportKey(exp.Company, exp.Title, exp.StartDate)] = exp
+		orders = append(orders, exp.DisplayOrder)
+	}
+	order := nextDisplayOrder(orders...)
+
+	for i, work := range input.doc.Work {
+		dates := input.workDates[i]
+		current := existing[experienceImportKey(work.Name, work.Position, dates.Start)]
+		key := fmt.Sprintf("%s @ %s (%s)", strings.TrimSpace(work.Position), strings.TrimSpace(work.Name), utils.FormatMonthDate(dates.Start, dates.Precision))
+
+		exp := &expemodel.ExperienceWithRelations{}
+		var oldDates utils.DateRange
+		var oldHighlights, oldSkills []string
+		if current != nil {
+			exp.Experience = current.Experience
+			oldDates = current.DateRange()
+			oldHighlights, oldSkills = experienceHighlights(current), experienceSkillNames(current)
+		} else {
+			exp.DisplayOrder = order
+			order++
+		}
+
+		exp.Title = strings.TrimSpace(work.Position)
+		exp.Company = strings.TrimSpace(work.Name)
+		exp.Location = importText(exp.Location, work.Location)
+		exp.StartDate, exp.EndDate, exp.IsOngoing, exp.DatePrecision = dates.Start, dates.End, dates.Ongoing, dates.Precision
+
+		highlights := importList(oldHighlights, work.Highlights)
+		skills := importList(oldSkills, work.Keywords)
+		for j, description := range highlights {
+			exp.Responsibilities = append(exp.Responsibilities, expemodel.ExperienceResponsibility{Description: description, DisplayOrder: j})
+		}
+		for _, name := range skills {
+			exp.Skills = append(exp.Skills, expemodel.ExperienceSkill{SkillName: name})
+		}
+
+		diff := newResumeFieldDiff(current == nil)
+		var old expemodel.Experience
+		if current != nil {
+			old = current.Experience
+		}
+		diff.set("title", old.Title, exp.Title)
+		diff.set("company", old.Company, exp.Company)
+		diff.set("location", old.Location, exp.Location)
+		diff.dates(oldDates, dates)
+		diff.set("responsibilities", emptyIfNil(oldHighlights), emptyIfNil(highlights))
+		diff.set("skills", emptyIfNil(oldSkills), emptyIfNil(skills))
+
+		if current == nil {
+			plan.add("experience", key, nil, diff, func(repos ResumeRepos) error { return repos.Experiences.CreateExperienceWithRelations(exp) })
+			continue
+		}
+		plan.add("experience", key, uuidRef(current.ID), diff, func(repos ResumeRepos) error { return repos.Experiences.UpdateExperienceWithRelations(exp) })
+	}
+}
+
+func (s *resumeService) planEducation(plan *resumeImportPlan, education []model.Education, input *jsonResumeInput) {
+	existing := make(map[string]*model.Education, len(education))
+	orders := make([]int, 0, len(education))
+	for i := range education {
+		edu := &education[i]
+		existing[educationImportKey(edu.School, edu.Major)] = edu
+		orders = append(orders, edu.DisplayOrder)
+	}
+	order := nextDisplayOrder(orders...)
+
+	for i, item := range input.doc.Education {
+		dates := input.eduDates[i]
+		current := existing[educationImportKey(item.Institution, item.Area)]
+		key := fmt.Sprintf("%s - %s", strings.TrimSpace(item.Institution), strings.TrimSpace(item.Area))
+
+		edu := &model.Education{}
+		var oldDates utils.DateRange
+		var oldAchievements []string
+		if current != nil {
+			*edu = *current
+			oldDates = current.DateRange()
+			for _, ach := range current.Achievements {
+				oldAchievements = append(oldAchievements, ach.Achievement)
+			}
+		} else {
+			edu.DisplayOrder = order
+			order++
+		}
+
+		edu.School = strings.TrimSpace(item.Institution)
+		edu.Major = strings.TrimSpace(item.Area)
+		edu.Degree = importText(edu.Degree, item.StudyType)
+		edu.Description = importText(edu.Description, item.Summary)
+		edu.StartDate, edu.EndDate, edu.IsOngoing, edu.DatePrecision = dates.Start, dates.End, dates.Ongoing, dates.Precision
+
+		achievements := importList(oldAchievements, item.Highlights)
+		edu.Achievements = make([]model.EducationAchievement, 0, len(achievements))
+		for j, achievement := range achievements {
+			edu.Achievements = append(edu.Achievements, model.EducationAchievement{Achievement: achievement, DisplayOrder: j})
+		}
+
+		diff := newResumeFieldDiff(current == nil)
+		old := model.Education{}
+		if current != nil {
+			old = *current
+		}
+		diff.set("school", old.School, edu.School)
+		diff.set("major", old.Major, edu.Major)
+		diff.set("degree", old.Degree, edu.Degree)
+		diff.dates(oldDates, dates)
+		diff.set("description", old.Description, edu.Description)
+		diff.set("achievements", emptyIfNil(oldAchievements), emptyIfNil(achievements))
+
+		if current == nil {
+			plan.add("education", key, nil, diff, func(repos ResumeRepos) error { return repos.Education.CreateWithAchievements(edu) })
+			continue
+		}
+		plan.add("education", key, uuidRef(current.ID), diff, func(repos ResumeRepos) error { return repos.Education.UpdateWithAchievements(edu) })
+	}
+}
+
+func (s *resumeService) planSkills(plan *resumeImportPlan, skills []model.Skill, input *jsonResumeInput) {
+	existing := make(map[string]model.Skill, len(skills))
+	orders := make([]int, 0, len(skills))
+	for _, skill := range skills {
+		existing[skillImportKey(skill.Name)] = skill
+		orders = append(orders, skill.DisplayOrder)
+	}
+	order := nextDisplayOrder(orders...)
+
+	for i, item := range input.doc.Skills {
+		level := input.skillLevels[i]
+		current, found := existing[skillImportKey(item.Name)]
+
+		skill := current
+		if !found {
+			skill = model.Skill{DisplayOrder: order}
+			order++
+		}
+		skill.Name = strings.TrimSpace(item.Name)
+		// Level teks yang masih sesuai dengan value lama tidak menimpa angkanya
+		if level.set && (!found || level.label == "" || level.label != jsonResumeSkillLevel(current.Value)) {
+			skill.Value = level.value
+		}
+		if len(item.Keywords) > 0 {
+			skill.Category = strings.TrimSpace(item.Keywords[0])
+		}
+
+		diff := newResumeFieldDiff(!found)
+		diff.set("name", current.Name, skill.Name)
+		diff.set("value", current.Value, skill.Value)
+		diff.set("category", current.Category, skill.Category)
+
+		if !found {
+			plan.add("skill", skill.Name, nil, diff, func(repos ResumeRepos) error { return repos.Skills.Create(&skill) })
+			continue
+		}
+		plan.add("skill", current.Name, uuidRef(current.ID), diff, func(repos ResumeRepos) error { return repos.Skills.Update(&skill) })
+	}
+}
+
+func (s *resumeService) planCertificates(plan *resumeImportPlan, certificates []model.Certificate, input *jsonResumeInput) {
+	existing := make(map[string]model.Certificate, len(certificates))
+	orders := make([]int, 0, len(certificates))
+	for _, cert := range certificates {
+		existing[certificateImportKey(cert.Name, cert.Issuer)] = cert
+		orders = append(orders, cert.DisplayOrder)
+	}
+	order := nextDisplayOrder(orders...)
+
+	for i, item := range input.doc.Certificates {
+		current, found := existing[certificateImportKey(item.Name, item.Issuer)]
+
+		cert := current
+		if !found {
+			cert = model.Certificate{DisplayOrder: order}
+			order++
+		}
+		cert.Name = strings.TrimSpace(item.Name)
+		cert.Issuer = strings.TrimSpace(item.Issuer)
+		cert.CredentialURL = importText(cert.CredentialURL, item.URL)
+		if date := input.certificates[i]; !date.IsZero() {
+			cert.IssueDate = date
+		}
+
+		diff := newResumeFieldDiff(!found)
+		diff.set("name", current.Name, cert.Name)
+		diff.set("issuer", current.Issuer, cert.Issuer)
+		diff.set("issue_date", certificateDate(current.IssueDate), certificateDate(cert.IssueDate))
+		diff.set("credential_url", current.CredentialURL, cert.CredentialURL)
+
+		key := joinNonEmpty(" - ", cert.Name, cert.Issuer)
+		if !found {
+			plan.add("certificate", key, nil, diff, func(repos ResumeRepos) error { return repos.Certificates.Create(&cert) })
+			continue
+		}
+		plan.add("certificate", key, uuidRef(current.ID), diff, func(repos ResumeRepos) error { return repos.Certificates.Update(&cert) })
+	}
+}
+
+func experienceHighlights(exp *expemodel.ExperienceWithRelations) []string {
+	responsibilities := append([]expemodel.ExperienceResponsibility(nil), exp.Responsibilities...)
+	sort.SliceStable(responsibilities, func(a, b int) bool {
+		return responsibilities[a].DisplayOrder < responsibilities[b].DisplayOrder
+	})
+
+	highlights := make([]string, 0, len(responsibilities))
+	for _, resp := range responsibilities {
+		highlights = append(highlights, resp.Description)
+	}
+	return highlights
+}
+
+func experienceSkillNames(exp *expemodel.ExperienceWithRelations) []string {
+	names := make([]string, 0, len(exp.Skills))
+	for _, skill := range exp.Skills {
+		names = append(names, skill.SkillName)
+	}
+	return names
+}
+
+func certificateDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	expemodel "gintugas/modules/components/experiences/model"
+	experepo "gintugas/modules/components/experiences/repo"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Fake repo hanya mengimplementasikan method yang dipakai import; method lain
+// dari interface yang di-embed akan panic kalau terpanggil
+
+type fakeResumeExperiences struct{ experepo.ExperiencesRepository }
+
+func (fakeResumeExperiences) GetAllExperiencesWithRelations() ([]expemodel.ExperienceWithRelations, error) {
+	return nil, nil
+}
+
+type fakeResumeEducation struct{ repo.EducationRepository }
+
+func (fakeResumeEducation) GetAllWithAchievements() ([]model.Education, error) { return nil, nil }
+
+type fakeResumeCertificates struct{ repo.CertificateRepository }
+
+func (fakeResumeCertificates) GetAll() ([]model.Certificate, error) { return nil, nil }
+
+type fakeResumeSettings struct{ repo.SettingRepository }
+
+func (fakeResumeSettings) GetAll() ([]model.Setting, error) { return nil, nil }
+
+type fakeResumeSkills struct {
+	repo.SkillRepository
+	skills  []model.Skill
+	written []model.Skill
+	err     error
+}
+
+func (r *fakeResumeSkills) GetAll() ([]model.Skill, error) { return r.skills, nil }
+
+func (r *fakeResumeSkills) Create(skill *model.Skill) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.written = append(r.written, *skill)
+	return nil
+}
+
+func (r *fakeResumeSkills) Update(skill *model.Skill) error { return r.Create(skill) }
+
+type fakeResumeSocialLinks struct {
+	repo.SocialLinkRepository
+	links   []model.SocialLink
+	written []model.SocialLink
+}
+
+func (r *fakeResumeSocialLinks) GetAll() ([]model.SocialLink, error) { return r.links, nil }
+
+func (r *fakeResumeSocialLinks) Create(link *model.SocialLink) error {
+	r.written = append(r.written, *link)
+	return nil
+}
+
+func (r *fakeResumeSocialLinks) Update(link *model.SocialLink) error { return r.Create(link) }
+
+type resumeImportFixture struct {
+	service      ResumeService
+	skills       *fakeResumeSkills
+	socialLinks  *fakeResumeSocialLinks
+	txSkills     *fakeResumeSkills
+	txSocial     *fakeResumeSocialLinks
+	transactions int
+}
+
+func newResumeImportFixture() *resumeImportFixture {
+	f := &resumeImportFixture{
+		skills: &fakeResumeSkills{skills: []model.Skill{
+			{ID: uuid.New(), Name: "Go", Value: 80, Category: "Backend", DisplayOrder: 1},
+		}},
+		socialLinks: &fakeResumeSocialLinks{links: []model.SocialLink{
+			{ID: uuid.New(), Platform: "GitHub", URL: "https://github.com/old", IconName: "FiGithub", DisplayOrder: 3, IsActive: true},
+		}},
+		txSkills: &fakeResumeSkills{},
+		txSocial: &fakeResumeSocialLinks{},
+	}
+
+	repos := ResumeRepos{
+		Experiences:  fakeResumeExperiences{},
+		Education:    fakeResumeEducation{},
+		Skills:       f.skills,
+		Certificates: fakeResumeCertificates{},
+		SocialLinks:  f.socialLinks,
+		Settings:     fakeResumeSettings{},
+	}
+	repos.Transaction = func(fn func(tx ResumeRepos) error) error {
+		f.transactions++
+		tx := repos
+		tx.Skills, tx.SocialLinks = f.txSkills, f.txSocial
+		return fn(tx)
+	}
+	f.service = NewResumeService(repos, nil)
+	return f
+}
+
+const resumeImportBody = `{
+	"basics": {"profiles": [
+		{"network": "GitHub", "url": "https://github.com/budi"},
+		{"network": "WhatsApp", "url": "https://wa.me/6281234567890"}
+	]},
+	"skills": [
+		{"name": "Go", "level": "Advanced", "keywords": ["Backend"]},
+		{"name": "Docker", "level": "Intermediate"}
+	]
+}`
+
+func resumeImportContext(query string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("POST", "/v1/resume/import"+query, strings.NewReader(resumeImportBody))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	return ctx
+}
+
+func findImportChange(report *model.ResumeImportReport, entity, key string) *model.ResumeImportChange {
+	for i := range report.Changes {
+		if report.Changes[i].Entity == entity && report.Changes[i].Key == key {
+			return &report.Changes[i]
+		}
+	}
+	return nil
+}
+
+func TestResumeImportDryRunReportsDiff(t *testing.T) {
+	f := newResumeImportFixture()
+
+	report, err := f.service.Import(resumeImportContext("?dry_run=true"))
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if f.transactions != 0 {
+		t.Fatal("dry run must not open a transaction")
+	}
+
+	if got := report.Summary["skill"]; got != (model.ResumeImportCount{Created: 1, Unchanged: 1}) {
+		t.Fatalf("skill summary = %+v", got)
+	}
+	if got := report.Summary["social_link"]; got != (model.ResumeImportCount{Created: 1, Updated: 1}) {
+		t.Fatalf("social_link summary = %+v", got)
+	}
+
+	github := findImportChange(report, "social_link", "GitHub")
+	if github == nil || github.Action != resumeImportUpdate || github.ID == nil {
+		t.Fatalf("GitHub change = %+v", github)
+	}
+	if len(github.Fields) != 1 || github.Fields[0].Old != "https://github.com/old" || github.Fields[0].New != "https://github.com/budi" {
+		t.Fatalf("GitHub fields = %+v", github.Fields)
+	}
+
+	docker := findImportChange(report, "skill", "Docker")
+	if docker == nil || docker.Action != resumeImportCreate {
+		t.Fatalf("Docker change = %+v", docker)
+	}
+	for _, field := range docker.Fields {
+		if field.Old != nil {
+			t.Fatalf("created entry must have null old values, got %+v", field)
+		}
+		if field.Field == "category" {
+			t.Fatal("empty fields of a created entry must not be reported")
+		}
+	}
+}
+
+func TestResumeImportWritesInTransaction(t *testing.T) {
+	f := newResumeImportFixture()
+
+	if _, err := f.service.Import(resumeImportContext("")); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if f.transactions != 1 {
+		t.Fatalf("transactions = %d, want 1", f.transactions)
+	}
+	if len(f.skills.written) != 0 || len(f.socialLinks.written) != 0 {
+		t.Fatal("writes must go through the transaction repos")
+	}
+
+	if len(f.txSkills.written) != 1 {
+		t.Fatalf("skill writes = %+v", f.txSkills.written)
+	}
+	if docker := f.txSkills.written[0]; docker.Name != "Docker" || docker.Value != 60 || docker.DisplayOrder != 2 {
+		t.Fatalf("created skill = %+v", docker)
+	}
+
+	if len(f.txSocial.written) != 2 {
+		t.Fatalf("social link writes = %+v", f.txSocial.written)
+	}
+	whatsapp := f.txSocial.written[1]
+	if whatsapp.Platform != "WhatsApp" || whatsapp.IconName != "FaWhatsapp" || whatsapp.DisplayOrder != 4 || !whatsapp.IsActive {
+		t.Fatalf("created social link = %+v", whatsapp)
+	}
+}
+
+func TestResumeImportWriteErrorReturnsNoReport(t *testing.T) {
+	f := newResumeImportFixture()
+	f.txSkills.err = errors.New("db down")
+
+	report, err := f.service.Import(resumeImportContext(""))
+	if err == nil || report != nil {
+		t.Fatalf("report = %+v, err = %v; want error without report", report, err)
+	}
+}
+
+func TestSocialLinkIcon(t *testing.T) {
+	tests := map[string]string{
+		"GitHub":      "FiGithub",
+		"linked-in":   "FiLinkedin",
+		"WhatsApp":    "FaWhatsapp",
+		"Email":       "FiMail",
+		"Mastodon":    "FiLink",
+		" Telegram  ": "FaTelegram",
+	}
+	for platform, want := range tests {
+		if got := socialLinkIcon(platform); got != want {
+			t.Errorf("socialLinkIcon(%q) = %q, want %q", platform, got, want)
+		}
+	}
+}
+
+func TestResumeFieldDiff(t *testing.T) {
+	create := newResumeFieldDiff(true)
+	create.set("name", "", "Go")
+	create.set("category", "", "")
+	create.set("keywords", []string(nil), []string{})
+	if len(create.fields) != 1 || create.fields[0].Old != nil {
+		t.Fatalf("create diff = %+v", create.fields)
+	}
+
+	update := newResumeFieldDiff(false)
+	update.set("name", "Go", "Go")
+	update.set("keywords", []string{"a"}, []string{"a"})
+	update.set("value", 60, 80)
+	if len(update.fields) != 1 || update.fields[0].Field != "value" || update.fields[0].Old != 60 {
+		t.Fatalf("update diff = %+v", update.fields)
+	}
+}
@@ -0,0 +1,590 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/utils"
+	"html/template"
+	"strings"
+)
+
+// ============================
+// RESUME TEMPLATES
+// ============================
+// Satu template menentukan tampilan di ketiga format: warna aksen, ukuran
+// huruf, jarak dan seberapa detail isi yang ditampilkan.
+
+const defaultResumeTemplate = "classic"
+
+type resumeTemplate struct {
+	Name        string
+	Label       string
+	Description string
+
+	Accent utils.PDFColor
+	Muted  utils.PDFColor
+
+	NameSize    float64
+	HeadingSize float64
+	BodySize    float64
+	Margin      float64
+
+	UppercaseHeadings bool
+	HeadingRule       bool
+	MaxHighlights     int  // 0 = semua responsibilities
+	ShowDetails       bool // deskripsi dan achievements pendidikan
+}
+
+var resumeTemplateOrder = []string{"classic", "modern", "compact"}
+
+var resumeTemplates = map[string]resumeTemplate{
+	"classic": {
+		Name: "classic", Label: "Classic",
+		Description: "Hitam-putih dengan judul section kapital dan garis pemisah",
+		Accent:      utils.PDFColor{R: 33, G: 37, B: 41}, Muted: utils.PDFColor{R: 108, G: 117, B: 125},
+		NameSize: 22, HeadingSize: 12, BodySize: 10, Margin: 50,
+		UppercaseHeadings: true, HeadingRule: true, ShowDetails: true,
+	},
+	"modern": {
+		Name: "modern", Label: "Modern",
+		Description: "Nama dan judul section berwarna aksen, tanpa garis",
+		Accent:      utils.PDFColor{R: 0, G: 121, B: 140}, Muted: utils.PDFColor{R: 90, G: 98, B: 110},
+		NameSize: 26, HeadingSize: 13, BodySize: 10, Margin: 46,
+		ShowDetails: true,
+	},
+	"compact": {
+		Name: "compact", Label: "Compact",
+		Description: "Huruf lebih kecil dan maksimal 3 poin per pengalaman, cocok untuk satu halaman",
+		Accent:      utils.PDFColor{R: 33, G: 37, B: 41}, Muted: utils.PDFColor{R: 108, G: 117, B: 125},
+		NameSize: 18, HeadingSize: 10.5, BodySize: 9, Margin: 36,
+		UppercaseHeadings: true, HeadingRule: true, MaxHighlights: 3,
+	},
+}
+
+func (t resumeTemplate) heading(text string) string {
+	if t.UppercaseHeadings {
+		return strings.ToUpper(text)
+	}
+	return text
+}
+
+func (t resumeTemplate) highlights(items []string) []string {
+	if t.MaxHighlights > 0 && len(items) > t.MaxHighlights {
+		return items[:t.MaxHighlights]
+	}
+	return items
+}
+
+func cssColor(c utils.PDFColor) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+type resumeLabels struct {
+	Summary      string
+	Experience   string
+	Education    string
+	Skills       string
+	Certificates string
+	Technologies string
+	Other        string
+	Credential   string
+}
+
+var resumeLabelsByLocale = map[string]resumeLabels{
+	utils.LocaleEN: {
+		Summary: "Summary", Experience: "Experience", Education: "Education", Skills: "Skills",
+		Certificates: "Certifications", Technologies: "Tech", Other: "Other", Credential: "credential",
+	},
+	utils.LocaleID: {
+		Summary: "Ringkasan", Experience: "Pengalaman", Education: "Pendidikan", Skills: "Keahlian",
+		Certificates: "Sertifikasi", Technologies: "Teknologi", Other: "Lainnya", Credential: "kredensial",
+	},
+}
+
+func resumeLabelsFor(locale string) resumeLabels {
+	if labels, ok := resumeLabelsByLocale[locale]; ok {
+		return labels
+	}
+	return resumeLabelsByLocale[utils.LocaleEN]
+}
+
+// Helper teks yang dipakai semua format
+
+func resumeContactLine(resume *model.Resume) []string {
+	var parts []string
+	for _, value := range []string{resume.Email, resume.Phone, resume.Location} {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return parts
+}
+
+func resumeEducationTitle(edu model.ResumeEducation) string {
+	if edu.Degree != "" {
+		return edu.Major + " (" + edu.Degree + ")"
+	}
+	return edu.Major
+}
+
+func resumeSkillCategory(group model.ResumeSkillGroup, labels resumeLabels) string {
+	if group.Category == "" {
+		return labels.Other
+	}
+	runes := []rune(group.Category)
+	return strings.ToUpper(string(runes[:1])) + string(runes[1:])
+}
+
+func displayURL(url string) string {
+	url = strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://")
+	return strings.TrimSuffix(strings.TrimPrefix(url, "www."), "/")
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// ============================
+// PDF
+// ============================
+
+type resumePDF struct {
+	doc   *utils.PDFDocument
+	tmpl  resumeTemplate
+	y     float64
+	left  float64
+	width float64
+}
+
+func renderResumePDF(resume *model.Resume, tmpl resumeTemplate, labels resumeLabels) ([]byte, error) {
+	p := &resumePDF{
+		doc:   utils.NewPDFDocument("Resume - "+resume.Name, resume.Name, resume.UpdatedAt),
+		tmpl:  tmpl,
+		y:     tmpl.Margin,
+		left:  tmpl.Margin,
+		width: utils.PDFPageWidth - 2*tmpl.Margin,
+	}
+	p.doc.AddPage()
+	black := utils.PDFColor{R: 33, G: 37, B: 41}
+
+	// Header
+	p.lines(utils.PDFBold, tmpl.NameSize, tmpl.Accent, resume.Name, 0)
+	if resume.Headline != "" {
+		p.lines(utils.PDFRegular, tmpl.BodySize+1.5, tmpl.Muted, resume.Headline, 0)
+	}
+	if contact := resumeContactLine(resume); len(contact) > 0 {
+		p.lines(utils.PDFRegular, tmpl.BodySize, black, strings.Join(contact, "  ·  "), 0)
+	}
+	p.links(resume.Links, black)
+
+	if resume.Summary != "" {
+		p.heading(labels.Summary)
+		p.lines(utils.PDFRegular, tmpl.BodySize, black, resume.Summary, 0)
+	}
+
+	if len(resume.Experiences) > 0 {
+		p.heading(labels.Experience)
+		for _, exp := range resume.Experiences {
+			p.entry(joinNonEmpty(" — ", exp.Title, exp.Company), exp.Period.Start+periodEnd(exp.Period))
+			if meta := joinNonEmpty("  ·  ", exp.Location, exp.Period.Duration); meta != "" {
+				p.lines(utils.PDFItalic, tmpl.BodySize-0.5, tmpl.Muted, meta, 0)
+			}
+			for _, highlight := range tmpl.highlights(exp.Highlights) {
+				p.bullet(highlight, black)
+			}
+			if len(exp.Skills) > 0 {
+				p.lines(utils.PDFRegular, tmpl.BodySize-0.5, tmpl.Muted, labels.Technologies+": "+strings.Join(exp.Skills, ", "), 0)
+			}
+			p.y += tmpl.BodySize * 0.6
+		}
+	}
+
+	if len(resume.Education) > 0 {
+		p.heading(labels.Education)
+		for _, edu := range resume.Education {
+			p.entry(resumeEducationTitle(edu), edu.Period.Start+periodEnd(edu.Period))
+			p.lines(utils.PDFItalic, tmpl.BodySize-0.5, tmpl.Muted, edu.School, 0)
+			if tmpl.ShowDetails {
+				if edu.Description != "" {
+					p.lines(utils.PDFRegular, tmpl.BodySize, black, edu.Description, 0)
+				}
+				for _, achievement := range edu.Achievements {
+					p.bullet(achievement, black)
+				}
+			}
+			p.y += tmpl.BodySize * 0.6
+		}
+	}
+
+	if len(resume.Skills) > 0 {
+		p.heading(labels.Skills)
+		for _, group := range resume.Skills {
+			label := resumeSkillCategory(group, labels) + ": "
+			labelWidth := utils.PDFTextWidth(utils.PDFBold, tmpl.BodySize, label)
+			p.ensure(tmpl.BodySize * 1.4)
+			p.doc.Text(p.left, p.y+tmpl.BodySize, utils.PDFBold, tmpl.BodySize, black, label)
+			p.lines(utils.PDFRegular, tmpl.BodySize, black, strings.Join(group.Skills, ", "), labelWidth)
+		}
+	}
+
+	if len(resume.Certificates) > 0 {
+		p.heading(labels.Certificates)
+		for _, cert := range resume.Certificates {
+			p.entry(cert.Name, cert.Date)
+			if cert.Issuer != "" {
+				p.lines(utils.PDFItalic, tmpl.BodySize-0.5, tmpl.Muted, cert.Issuer, 0)
+			}
+			if cert.URL != "" {
+				p.link(displayURL(cert.URL), cert.URL, tmpl.BodySize-0.5, tmpl.Accent)
+			}
+		}
+	}
+
+	return p.doc.Bytes()
+}
+
+// periodEnd menghasilkan " – Mar 2023" atau "" jika tidak ada akhir/sama
+func periodEnd(period utils.Period) string {
+	if period.End == "" || period.End == period.Start {
+		return ""
+	}
+	if period.Start == "" {
+		return period.End
+	}
+	return " – " + period.End
+}
+
+func (p *resumePDF) lineHeight(size float64) float64 {
+	return size * 1.4
+}
+
+func (p *resumePDF) ensure(height float64) {
+	if p.y+height > utils.PDFPageHeight-p.tmpl.Margin {
+		p.doc.AddPage()
+		p.y = p.tmpl.Margin
+	}
+}
+
+// lines menulis teks yang di-wrap; baris pertama bisa digeser indent (untuk
+// teks setelah label), baris berikutnya kembali ke margin kiri
+func (p *resumePDF) lines(font utils.PDFFont, size float64, color utils.PDFColor, text string, indent float64) {
+	first := true
+	for _, line := range utils.PDFWrapText(font, size, text, p.width-indent) {
+		p.ensure(p.lineHeight(size))
+		x := p.left
+		if first {
+			x += indent
+		}
+		p.doc.Text(x, p.y+size, font, size, color, line)
+		p.y += p.lineHeight(size)
+		first = false
+	}
+}
+
+func (p *resumePDF) heading(text string) {
+	size := p.tmpl.HeadingSize
+	p.y += size * 0.8
+	// Judul section tidak boleh tertinggal sendirian di bawah halaman
+	p.ensure(p.lineHeight(size) + p.lineHeight(p.tmpl.BodySize)*2)
+	p.doc.Text(p.left, p.y+size, utils.PDFBold, size, p.tmpl.Accent, p.tmpl.heading(text))
+	p.y += p.lineHeight(size)
+	if p.tmpl.HeadingRule {
+		p.doc.Line(p.left, p.y-size*0.2, p.left+p.width, p.y-size*0.2, 0.6, p.tmpl.Muted)
+		p.y += size * 0.3
+	}
+}
+
+// entry: judul tebal di kiri, periode rata kanan di baris yang sama
+func (p *resumePDF) entry(title, right string) {
+	size := p.tmpl.BodySize + 0.5
+	rightWidth := utils.PDFTextWidth(utils.PDFRegular, p.tmpl.BodySize, right)
+	p.ensure(p.lineHeight(size) * 2)
+	if right != "" {
+		p.doc.Text(p.left+p.width-rightWidth, p.y+size, utils.PDFRegular, p.tmpl.BodySize, p.tmpl.Muted, right)
+	}
+
+	for _, line := range utils.PDFWrapText(utils.PDFBold, size, title, p.width-rightWidth-12) {
+		p.ensure(p.lineHeight(size))
+		p.doc.Text(p.left, p.y+size, utils.PDFBold, size, utils.PDFColor{R: 33, G: 37, B: 41}, line)
+		p.y += p.lineHeight(size)
+	}
+}
+
+func (p *resumePDF) bullet(text string, color utils.PDFColor) {
+	size := p.tmpl.BodySize
+	indent := size * 1.2
+	first := true
+	for _, line := range utils.PDFWrapText(utils.PDFRegular, size, text, p.width-indent) {
+		p.ensure(p.lineHeight(size))
+		if first {
+			p.doc.Text(p.left+size*0.2, p.y+size, utils.PDFRegular, size, color, "•")
+			first = false
+		}
+		p.doc.Text(p.left+indent, p.y+size, utils.PDFRegular, size, color, line)
+		p.y += p.lineHeight(size)
+	}
+}
+
+func (p *resumePDF) link(text, url string, size float64, color utils.PDFColor) {
+	p.ensure(p.lineHeight(size))
+	p.doc.Text(p.left, p.y+size, utils.PDFRegular, size, color, text)
+	p.doc.Link(p.left, p.y, utils.PDFTextWidth(utils.PDFRegular, size, text), p.lineHeight(size), url)
+	p.y += p.lineHeight(size)
+}
+
+// links menulis social links dalam satu baris (wrap jika perlu), masing-masing
+// bisa diklik
+func (p *resumePDF) links(links []model.ResumeLink, color utils.PDFColor) {
+	if len(links) == 0 {
+		return
+	}
+	size := p.tmpl.BodySize
+	separator := "  ·  "
+	x := p.left
+	p.ensure(p.lineHeight(size))
+	for i, link := range links {
+		text := displayURL(link.URL)
+		width := utils.PDFTextWidth(utils.PDFRegular, size, text)
+		if i > 0 {
+			sepWidth := utils.PDFTextWidth(utils.PDFRegular, size, separator)
+			if x+sepWidth+width > p.left+p.width {
+				p.y += p.lineHeight(size)
+				p.ensure(p.lineHeight(size))
+				x = p.left
+			} else {
+				p.doc.Text(x, p.y+size, utils.PDFRegular, size, color, separator)
+				x += sepWidth
+			}
+		}
+		p.doc.Text(x, p.y+size, utils.PDFRegular, size, p.tmpl.Accent, text)
+		p.doc.Link(x, p.y, width, p.lineHeight(size), link.URL)
+		x += width
+	}
+	p.y += p.lineHeight(size)
+}
+
+// ============================
+// MARKDOWN
+// ============================
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`, "#", `\#`)
+
+func md(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+func renderResumeMarkdown(resume *model.Resume, tmpl resumeTemplate, labels resumeLabels) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", md(resume.Name))
+	if resume.Headline != "" {
+		fmt.Fprintf(&b, "**%s**\n\n", md(resume.Headline))
+	}
+	if contact := resumeContactLine(resume); len(contact) > 0 {
+		for i := range contact {
+			contact[i] = md(contact[i])
+		}
+		b.WriteString(strings.Join(contact, " · ") + "  \n")
+	}
+	if len(resume.Links) > 0 {
+		links := make([]string, 0, len(resume.Links))
+		for _, link := range resume.Links {
+			links = append(links, fmt.Sprintf("[%s](%s)", md(link.Platform), link.URL))
+		}
+		b.WriteString(strings.Join(links, " · ") + "\n")
+	}
+	b.WriteString("\n")
+
+	if resume.Summary != "" {
+		fmt.Fprintf(&b, "## %s\n\n%s\n\n", tmpl.heading(labels.Summary), md(resume.Summary))
+	}
+
+	if len(resume.Experiences) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", tmpl.heading(labels.Experience))
+		for _, exp := range resume.Experiences {
+			fmt.Fprintf(&b, "### %s\n\n", md(joinNonEmpty(" — ", exp.Title, exp.Company)))
+			if meta := joinNonEmpty(" · ", exp.Location, exp.Period.Label); meta != "" {
+				fmt.Fprintf(&b, "*%s*\n\n", md(meta))
+			}
+			highlights := tmpl.highlights(exp.Highlights)
+			for _, highlight := range highlights {
+				fmt.Fprintf(&b, "- %s\n", md(highlight))
+			}
+			if len(highlights) > 0 {
+				b.WriteString("\n")
+			}
+			if len(exp.Skills) > 0 {
+				fmt.Fprintf(&b, "**%s:** %s\n\n", labels.Technologies, md(strings.Join(exp.Skills, ", ")))
+			}
+		}
+	}
+
+	if len(resume.Education) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", tmpl.heading(labels.Education))
+		for _, edu := range resume.Education {
+			fmt.Fprintf(&b, "### %s\n\n", md(joinNonEmpty(" — ", resumeEducationTitle(edu), edu.School)))
+			if edu.Period.Label != "" {
+				fmt.Fprintf(&b, "*%s*\n\n", md(edu.Period.Label))
+			}
+			if tmpl.ShowDetails {
+				if edu.Description != "" {
+					fmt.Fprintf(&b, "%s\n\n", md(edu.Description))
+				}
+				for _, achievement := range edu.Achievements {
+					fmt.Fprintf(&b, "- %s\n", md(achievement))
+				}
+				if len(edu.Achievements) > 0 {
+					b.WriteString("\n")
+				}
+			}
+		}
+	}
+
+	if len(resume.Skills) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", tmpl.heading(labels.Skills))
+		for _, group := range resume.Skills {
+			fmt.Fprintf(&b, "- **%s:** %s\n", md(resumeSkillCategory(group, labels)), md(strings.Join(group.Skills, ", ")))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(resume.Certificates) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n", tmpl.heading(labels.Certificates))
+		for _, cert := range resume.Certificates {
+			line := "- **" + md(cert.Name) + "**"
+			if detail := joinNonEmpty(", ", cert.Issuer, cert.Date); detail != "" {
+				line += " — " + md(detail)
+			}
+			if cert.URL != "" {
+				line += fmt.Sprintf(" ([%s](%s))", labels.Credential, cert.URL)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	return []byte(strings.TrimRight(b.String(), "\n") + "\n")
+}
+
+// ============================
+// HTML
+// ============================
+
+var resumeHTMLTemplate = template.Must(template.New("resume").Funcs(template.FuncMap{
+	"join":       strings.Join,
+	"displayURL": displayURL,
+}).Parse(`<!DOCTYPE html>
+<html lang="{{.Locale}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Resume - {{.Resume.Name}}</title>
+<style>
+  :root { --accent: {{.Accent}}; --muted: {{.Muted}}; --base: {{.BodySize}}pt; }
+  * { box-sizing: border-box; }
+  body { font-family: Helvetica, Arial, sans-serif; font-size: var(--base); line-height: 1.4; color: #212529; max-width: 800px; margin: 0 auto; padding: {{.Margin}}px; }
+  h1 { font-size: {{.NameSize}}pt; color: var(--accent); margin: 0; }
+  h2 { font-size: {{.HeadingSize}}pt; color: var(--accent); margin: 1.4em 0 .5em;{{if .Template.UppercaseHeadings}} text-transform: uppercase; letter-spacing: .04em;{{end}}{{if .Template.HeadingRule}} border-bottom: 1px solid var(--muted); padding-bottom: .2em;{{end}} }
+  h3 { font-size: 1.05em; margin: 0; }
+  .headline, .meta, .issuer { color: var(--muted); }
+  .meta, .issuer { font-style: italic; }
+  .entry { margin-bottom: .9em; }
+  .entry-header { display: flex; justify-content: space-between; gap: 1em; }
+  .period { color: var(--muted); white-space: nowrap; }
+  ul { margin: .3em 0; padding-left: 1.2em; }
+  a { color: var(--accent); text-decoration: none; }
+  .links a + a::before { content: " · "; color: #212529; }
+  @media print { body { padding: 0; } }
+</style>
+</head>
+<body>
+<header>
+  <h1>{{.Resume.Name}}</h1>
+  {{with .Resume.Headline}}<div class="headline">{{.}}</div>{{end}}
+  {{with .Contact}}<div class="contact">{{join . " · "}}</div>{{end}}
+  {{with .Resume.Links}}<div class="links">{{range .}}<a href="{{.URL}}">{{displayURL .URL}}</a>{{end}}</div>{{end}}
+</header>
+{{with .Resume.Summary}}<section><h2>{{$.Labels.Summary}}</h2><p>{{.}}</p></section>{{end}}
+{{with .Experiences}}<section>
+  <h2>{{$.Labels.Experience}}</h2>
+  {{range .}}<div class="entry">
+    <div class="entry-header"><h3>{{.Title}}{{with .Company}} — {{.}}{{end}}</h3><span class="period">{{.Period.Start}}{{.PeriodEnd}}</span></div>
+    {{with .Meta}}<div class="meta">{{.}}</div>{{end}}
+    {{with .Highlights}}<ul>{{range .}}<li>{{.}}</li>{{end}}</ul>{{end}}
+    {{with .Skills}}<div class="meta">{{$.Labels.Technologies}}: {{join . ", "}}</div>{{end}}
+  </div>{{end}}
+</section>{{end}}
+{{with .Resume.Education}}<section>
+  <h2>{{$.Labels.Education}}</h2>
+  {{range .}}<div class="entry">
+    <div class="entry-header"><h3>{{.Major}}{{with .Degree}} ({{.}}){{end}}</h3><span class="period">{{.Period.Label}}</span></div>
+    <div class="issuer">{{.School}}</div>
+    {{if $.Template.ShowDetails}}{{with .Description}}<p>{{.}}</p>{{end}}
+    {{with .Achievements}}<ul>{{range .}}<li>{{.}}</li>{{end}}</ul>{{end}}{{end}}
+  </div>{{end}}
+</section>{{end}}
+{{with .Skills}}<section>
+  <h2>{{$.Labels.Skills}}</h2>
+  <ul>{{range .}}<li><strong>{{.Category}}:</strong> {{join .Skills ", "}}</li>{{end}}</ul>
+</section>{{end}}
+{{with .Resume.Certificates}}<section>
+  <h2>{{$.Labels.Certificates}}</h2>
+  {{range .}}<div class="entry">
+    <div class="entry-header"><h3>{{.Name}}</h3><span class="period">{{.Date}}</span></div>
+    {{with .Issuer}}<div class="issuer">{{.}}</div>{{end}}
+    {{with .URL}}<a href="{{.}}">{{displayURL .}}</a>{{end}}
+  </div>{{end}}
+</section>{{end}}
+</body>
+</html>
+`))
+
+type resumeHTMLExperience struct {
+	model.ResumeExperience
+	Meta      string
+	PeriodEnd string
+}
+
+func renderResumeHTML(resume *model.Resume, tmpl resumeTemplate, labels resumeLabels, locale string) ([]byte, error) {
+	experiences := make([]resumeHTMLExperience, 0, len(resume.Experiences))
+	for _, exp := range resume.Experiences {
+		exp.Highlights = tmpl.highlights(exp.Highlights)
+		experiences = append(experiences, resumeHTMLExperience{
+			ResumeExperience: exp,
+			Meta:             joinNonEmpty(" · ", exp.Location, exp.Period.Duration),
+			PeriodEnd:        periodEnd(exp.Period),
+		})
+	}
+
+	skills := make([]model.ResumeSkillGroup, 0, len(resume.Skills))
+	for _, group := range resume.Skills {
+		skills = append(skills, model.ResumeSkillGroup{Category: resumeSkillCategory(group, labels), Skills: group.Skills})
+	}
+
+	var buf bytes.Buffer
+	err := resumeHTMLTemplate.Execute(&buf, map[string]interface{}{
+		"Locale":      locale,
+		"Resume":      resume,
+		"Template":    tmpl,
+		"Labels":      labels,
+		"Contact":     resumeContactLine(resume),
+		"Experiences": experiences,
+		"Skills":      skills,
+		"Accent":      template.CSS(cssColor(tmpl.Accent)),
+		"Muted":       template.CSS(cssColor(tmpl.Muted)),
+		"BodySize":    tmpl.BodySize,
+		"NameSize":    tmpl.NameSize,
+		"HeadingSize": tmpl.HeadingSize,
+		"Margin":      tmpl.Margin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
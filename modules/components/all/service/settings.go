@@ -0,0 +1,348 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	"gintugas/modules/utils"
+	"log"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ============================
+// SETTINGS SERVICE
+// ============================
+// Setting disimpan sebagai teks, tapi API menerima dan mengembalikan nilai
+// bertipe sesuai data_type. Setting private (default) hanya terlihat oleh
+// admin; list publik dan snapshot portfolio hanya berisi is_public = true.
+
+var settingKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+type SettingService interface {
+	Create(ctx *gin.Context) (*model.SettingResponse, error)
+	GetByKey(ctx *gin.Context) (*model.SettingResponse, error)
+	Upsert(ctx *gin.Context) (*model.SettingResponse, bool, error)
+	Delete(ctx *gin.Context) error
+	GetAll(ctx *gin.Context) ([]model.SettingResponse, error)
+}
+
+type settingService struct {
+	repo repo.SettingRepository
+}
+
+func NewSettingService(repo repo.SettingRepository) SettingService {
+	return &settingService{repo: repo}
+}
+
+func (s *settingService) Create(ctx *gin.Context) (*model.SettingResponse, error) {
+	var req model.SettingRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	if err := checkSettingKey(req.Key); err != nil {
+		return nil, err
+	}
+
+	setting := &model.Setting{
+		Key:         req.Key,
+		DataType:    req.DataType,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	}
+	if setting.DataType == "" {
+		setting.DataType = model.SettingTypeString
+	}
+
+	if err := applySettingValue(ctx, setting, req.Value, req.JSONSchema); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(setting); err != nil {
+		return nil, err
+	}
+
+	return convertSettingToResponse(setting), nil
+}
+
+func (s *settingService) GetByKey(ctx *gin.Context) (*model.SettingResponse, error) {
+	setting, err := s.repo.GetByKey(ctx.Param("key"))
+	if err != nil {
+		return nil, utils.NotFound("setting")
+	}
+
+	if !setting.IsPublic && !authmiddleware.IsAdmin(ctx) {
+		return nil, utils.NotFound("setting")
+	}
+
+	return convertSettingToResponse(setting), nil
+}
+
+// Upsert membuat setting baru atau mengganti seluruh isi setting yang ada.
+// Nilai bool kedua true jika setting baru dibuat.
+func (s *settingService) Upsert(ctx *gin.Context) (*model.SettingResponse, bool, error) {
+	key := ctx.Param("key")
+	if err := checkSettingKey(key); err != nil {
+		return nil, false, err
+	}
+
+	var req model.SettingUpsertRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := s.repo.GetByKey(key)
+	created := errors.Is(err, gorm.ErrRecordNotFound)
+	if err != nil && !created {
+		return nil, false, err
+	}
+
+	if created {
+		existing = &model.Setting{Key: key, DataType: model.SettingTypeString}
+	} else if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return nil, false, err
+	}
+
+	if req.DataType != "" {
+		existing.DataType = req.DataType
+	}
+	existing.Description = req.Description
+	existing.IsPublic = req.IsPublic
+
+	if err := applySettingValue(ctx, existing, req.Value, req.JSONSchema); err != nil {
+		return nil, false, err
+	}
+
+	if created {
+		err = s.repo.Create(existing)
+	} else {
+		err = s.repo.Update(existing)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return convertSettingToResponse(existing), created, nil
+}
+
+func (s *settingService) Delete(ctx *gin.Context) error {
+	if err := s.repo.Delete(ctx.Param("key")); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.NotFound("setting")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *settingService) GetAll(ctx *gin.Context) ([]model.SettingResponse, error) {
+	settings, err := s.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	isAdmin := authmiddleware.IsAdmin(ctx)
+
+	responses := make([]model.SettingResponse, 0, len(settings))
+	for i := range settings {
+		if settings[i].IsPublic || isAdmin {
+			responses = append(responses, *convertSettingToResponse(&settings[i]))
+		}
+	}
+
+	return responses, nil
+}
+
+func checkSettingKey(key string) error {
+	if len(key) > 100 || !settingKeyPattern.MatchString(key) {
+		return utils.ValidationFailed([]utils.FieldError{utils.NewFieldError("key", "pattern", "setting_key")})
+	}
+	return nil
+}
+
+// applySettingValue memvalidasi value (dan json_schema untuk data_type json)
+// lalu menulis bentuk ter-normalisasinya ke setting. json_schema yang tidak
+// dikirim berarti schema lama tetap dipakai; json_schema: null menghapusnya.
+func applySettingValue(ctx *gin.Context, setting *model.Setting, raw, rawSchema json.RawMessage) error {
+	value, err := normalizeSettingValue(setting.DataType, raw)
+	if err != nil {
+		return utils.FieldValidationError(ctx, "value", "type", setting.DataType)
+	}
+
+	rawSchema = bytes.TrimSpace(rawSchema)
+	switch {
+	case string(rawSchema) == "null":
+		setting.JSONSchema = nil
+	case len(rawSchema) > 0:
+		if setting.DataType != model.SettingTypeJSON {
+			return utils.ValidationFailed([]utils.FieldError{utils.NewFieldError("json_schema", "data_type", "schema_data_type")})
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, rawSchema); err != nil {
+			return utils.BadRequestKey("json", "field", "json_schema")
+		}
+		schemaText := compact.String()
+		setting.JSONSchema = &schemaText
+	case setting.DataType != model.SettingTypeJSON:
+		// data_type diganti dari json: schema lama tidak berlaku lagi
+		setting.JSONSchema = nil
+	}
+
+	if setting.JSONSchema != nil {
+		schema, err := utils.ParseJSONSchema([]byte(*setting.JSONSchema))
+		if err != nil {
+			return err
+		}
+		if fields := schema.Validate([]byte(value), "value"); len(fields) > 0 {
+			return utils.ValidationFailed(fields).WithMessage("schema_mismatch")
+		}
+	}
+
+	setting.Value = value
+	return nil
+}
+
+// normalizeSettingValue mengubah nilai JSON dari request menjadi teks yang
+// disimpan. Untuk number/boolean/json, string berisi literal yang valid
+// (misalnya "42" atau "{\"a\":1}") juga diterima demi kompatibilitas client
+// lama yang selalu mengirim value sebagai string.
+func normalizeSettingValue(dataType string, raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		if dataType == model.SettingTypeString {
+			return "", nil
+		}
+		return "", fmt.Errorf("value is required")
+	}
+
+	var text string
+	isString := json.Unmarshal(raw, &text) == nil
+
+	switch dataType {
+	case model.SettingTypeString:
+		if !isString {
+			return "", fmt.Errorf("value must be a string")
+		}
+		return text, nil
+
+	case model.SettingTypeNumber:
+		literal := string(raw)
+		if isString {
+			literal = strings.TrimSpace(text)
+		}
+		var number json.Number
+		if err := json.Unmarshal([]byte(literal), &number); err != nil {
+			return "", fmt.Errorf("value must be a number")
+		}
+		if f, err := number.Float64(); err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", fmt.Errorf("value must be a finite number")
+		}
+		return number.String(), nil
+
+	case model.SettingTypeBoolean:
+		literal := string(raw)
+		if isString {
+			literal = strings.TrimSpace(text)
+		}
+		b, err := strconv.ParseBool(literal)
+		if err != nil {
+			return "", fmt.Errorf("value must be a boolean")
+		}
+		return strconv.FormatBool(b), nil
+
+	case model.SettingTypeJSON:
+		document := []byte(raw)
+		if trimmed := strings.TrimSpace(text); isString && (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+			document = []byte(trimmed)
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, document); err != nil {
+			return "", fmt.Errorf("value must be valid JSON")
+		}
+		return compact.String(), nil
+	}
+
+	return "", fmt.Errorf("unknown data_type %q", dataType)
+}
+
+// settingJSON mengembalikan value sebagai dokumen JSON. Data lama yang tidak
+// sesuai data_type-nya dikembalikan sebagai string JSON.
+func settingJSON(setting *model.Setting) json.RawMessage {
+	if setting.DataType != model.SettingTypeString && setting.DataType != "" {
+		if value, err := normalizeSettingValue(setting.DataType, json.RawMessage(setting.Value)); err == nil {
+			return json.RawMessage(value)
+		}
+	}
+	encoded, _ := json.Marshal(setting.Value)
+	return encoded
+}
+
+func convertSettingToResponse(setting *model.Setting) *model.SettingResponse {
+	response := &model.SettingResponse{
+		ID:          setting.ID,
+		Key:         setting.Key,
+		Value:       settingJSON(setting),
+		DataType:    setting.DataType,
+		Description: setting.Description,
+		IsPublic:    setting.IsPublic,
+		Version:     setting.Version,
+		CreatedAt:   setting.CreatedAt,
+		UpdatedAt:   setting.UpdatedAt,
+	}
+	if setting.JSONSchema != nil {
+		response.JSONSchema = json.RawMessage(*setting.JSONSchema)
+	}
+	return response
+}
+
+// ============================
+// SETTINGS READER
+// ============================
+// Akses bertipe untuk service lain, misalnya:
+//   locale := SettingValue(settings, "default_locale", "en")
+//   maxUpload := SettingValue(settings, "max_upload_mb", 5)
+// Membaca lewat SettingRepository, jadi ikut read cache jika aktif.
+
+type SettingsReader struct {
+	repo repo.SettingRepository
+}
+
+func NewSettingsReader(repo repo.SettingRepository) *SettingsReader {
+	return &SettingsReader{repo: repo}
+}
+
+// Lookup men-decode setting ke target. Error gorm.ErrRecordNotFound jika
+// setting tidak ada.
+func (r *SettingsReader) Lookup(key string, target interface{}) error {
+	setting, err := r.repo.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(settingJSON(setting), target); err != nil {
+		return fmt.Errorf("setting %s (%s): %w", key, setting.DataType, err)
+	}
+	return nil
+}
+
+// SettingValue mengembalikan nilai setting dengan tipe T, atau fallback jika
+// setting tidak ada atau tipenya tidak cocok
+func SettingValue[T any](r *SettingsReader, key string, fallback T) T {
+	var value T
+	if err := r.Lookup(key, &value); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("⚠️ Warning: gagal membaca setting %s: %v", key, err)
+		}
+		return fallback
+	}
+	return value
+}
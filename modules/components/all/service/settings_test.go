@@ -0,0 +1,60 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	model "gintugas/modules/components/all/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+func settingTestContext() *gin.Context {
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("PUT", "/settings/theme", nil)
+	return ctx
+}
+
+func TestApplySettingValueKeepsSchema(t *testing.T) {
+	schema := `{"type":"object","required":["mode"]}`
+
+	tests := []struct {
+		name       string
+		dataType   string
+		value      string
+		jsonSchema json.RawMessage
+		wantSchema *string
+		wantErr    bool
+	}{
+		{"schema omitted keeps existing", model.SettingTypeJSON, `{"mode":"dark"}`, nil, &schema, false},
+		{"existing schema still validates", model.SettingTypeJSON, `{"color":"red"}`, nil, &schema, true},
+		{"null clears schema", model.SettingTypeJSON, `{"color":"red"}`, json.RawMessage(`null`), nil, false},
+		{"new schema replaces", model.SettingTypeJSON, `{"color":"red"}`, json.RawMessage(`{"type": "object"}`), strPtr(`{"type":"object"}`), false},
+		{"data type changed drops schema", model.SettingTypeString, `"dark"`, nil, nil, false},
+		{"schema on non-json type", model.SettingTypeString, `"dark"`, json.RawMessage(`{"type":"string"}`), &schema, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := schema
+			setting := &model.Setting{Key: "theme", DataType: tt.dataType, JSONSchema: &existing}
+
+			err := applySettingValue(settingTestContext(), setting, json.RawMessage(tt.value), tt.jsonSchema)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (setting.JSONSchema == nil) != (tt.wantSchema == nil) ||
+				(setting.JSONSchema != nil && *setting.JSONSchema != *tt.wantSchema) {
+				t.Fatalf("JSONSchema = %v, want %v", setting.JSONSchema, tt.wantSchema)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
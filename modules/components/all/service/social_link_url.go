@@ -0,0 +1,130 @@
+package service
+
+import (
+	"gintugas/modules/utils"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ============================
+// SOCIAL LINK URL VALIDATION
+// ============================
+// Platform yang dikenal punya format URL sendiri supaya link di footer tidak
+// salah arah (misalnya Email tanpa mailto: atau WhatsApp ke nomor kosong).
+// Platform lain cukup URL http(s) yang valid.
+
+var (
+	whatsappPathPattern = regexp.MustCompile(`^/[0-9]{6,15}$`)
+	phonePattern        = regexp.MustCompile(`^\+?[0-9]{6,15}$`)
+)
+
+// socialLinkHosts memetakan platform (huruf kecil, tanpa spasi/tanda hubung)
+// ke host https yang diizinkan
+var socialLinkHosts = map[string][]string{
+	"github":    {"github.com"},
+	"gitlab":    {"gitlab.com"},
+	"linkedin":  {"linkedin.com", "www.linkedin.com"},
+	"instagram": {"instagram.com", "www.instagram.com"},
+	"facebook":  {"facebook.com", "www.facebook.com", "fb.me"},
+	"twitter":   {"twitter.com", "x.com"},
+	"x":         {"x.com", "twitter.com"},
+	"youtube":   {"youtube.com", "www.youtube.com", "youtu.be"},
+	"tiktok":    {"tiktok.com", "www.tiktok.com"},
+	"telegram":  {"t.me"},
+	"line":      {"line.me"},
+	"whatsapp":  {"wa.me"},
+	"dribbble":  {"dribbble.com"},
+	"medium":    {"medium.com"},
+}
+
+// socialLinkIcons memetakan platform ke nama komponen react-icons yang
+// dirender frontend, mengikuti data seed portfolio_social_links
+var socialLinkIcons = map[string]string{
+	"github":    "FiGithub",
+	"gitlab":    "FiGitlab",
+	"linkedin":  "FiLinkedin",
+	"instagram": "FiInstagram",
+	"facebook":  "FiFacebook",
+	"twitter":   "FiTwitter",
+	"x":         "FiTwitter",
+	"youtube":   "FiYoutube",
+	"tiktok":    "FaTiktok",
+	"telegram":  "FaTelegram",
+	"line":      "FaLine",
+	"whatsapp":  "FaWhatsapp",
+	"dribbble":  "FiDribbble",
+	"medium":    "FaMedium",
+	"email":     "FiMail",
+	"mail":      "FiMail",
+	"phone":     "FiPhone",
+	"telepon":   "FiPhone",
+}
+
+// socialLinkIcon: platform yang tidak dikenal memakai icon link generik
+func socialLinkIcon(platform string) string {
+	if icon, ok := socialLinkIcons[normalizePlatform(platform)]; ok {
+		return icon
+	}
+	return "FiLink"
+}
+
+func normalizePlatform(platform string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(strings.TrimSpace(platform)))
+}
+
+func checkSocialLinkURL(platform, rawURL string) error {
+	key := normalizePlatform(platform)
+
+	switch key {
+	case "email", "mail":
+		address, ok := strings.CutPrefix(rawURL, "mailto:")
+		if !ok {
+			return socialLinkURLError("url_mailto_platform", "platform", platform)
+		}
+		if _, err := mail.ParseAddress(strings.SplitN(address, "?", 2)[0]); err != nil {
+			return socialLinkURLError("url_mailto")
+		}
+		return nil
+
+	case "phone", "telepon":
+		number, ok := strings.CutPrefix(rawURL, "tel:")
+		if !ok || !phonePattern.MatchString(number) {
+			return socialLinkURLError("url_tel")
+		}
+		return nil
+	}
+
+	if !utils.IsHTTPURL(rawURL) {
+		return socialLinkURLError("httpurl")
+	}
+
+	hosts, known := socialLinkHosts[key]
+	if !known {
+		return nil
+	}
+
+	u, _ := url.Parse(rawURL)
+	if u.Scheme != "https" || !containsHost(hosts, strings.ToLower(u.Hostname())) {
+		return socialLinkURLError("url_platform_host", "platform", platform, "host", hosts[0])
+	}
+
+	if key == "whatsapp" && !whatsappPathPattern.MatchString(u.Path) {
+		return socialLinkURLError("url_whatsapp", "platform", platform)
+	}
+	return nil
+}
+
+func containsHost(hosts []string, host string) bool {
+	for _, candidate := range hosts {
+		if candidate == host {
+			return true
+		}
+	}
+	return false
+}
+
+func socialLinkURLError(key string, params ...string) error {
+	return utils.ValidationFailed([]utils.FieldError{utils.NewFieldError("url", "platform_url", key, params...)})
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"gintugas/modules/utils"
+)
+
+func TestCheckSocialLinkURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		url      string
+		want     string // pesan en; kosong berarti valid
+	}{
+		{"email ok", "Email", "mailto:budi@example.com", ""},
+		{"email with subject", "E-mail", "mailto:budi@example.com?subject=Halo", ""},
+		{"email without mailto", "Email", "budi@example.com", "url must start with mailto: for Email"},
+		{"email https", "Email", "https://mail.example.com", "url must start with mailto: for Email"},
+		{"email invalid address", "Email", "mailto:bukan-email", "url must be mailto: followed by a valid email address"},
+		{"phone ok", "Phone", "tel:+628123456789", ""},
+		{"phone letters", "Telepon", "tel:halo", "url must be tel: followed by a phone number, e.g. tel:+628123456789"},
+		{"whatsapp ok", "WhatsApp", "https://wa.me/6281234567890", ""},
+		{"whatsapp without number", "WhatsApp", "https://wa.me/", "url for WhatsApp must be https://wa.me/ followed by the phone number in international format"},
+		{"whatsapp local format", "whats-app", "https://wa.me/0812-3456", "url for whats-app must be https://wa.me/ followed by the phone number in international format"},
+		{"whatsapp other host", "WhatsApp", "https://api.whatsapp.com/send?phone=62812", "url for WhatsApp must start with https://wa.me/"},
+		{"github ok", "GitHub", "https://github.com/budi", ""},
+		{"github host mismatch", "GitHub", "https://gitlab.com/budi", "url for GitHub must start with https://github.com/"},
+		{"github lookalike host", "GitHub", "https://github.com.evil.example/budi", "url for GitHub must start with https://github.com/"},
+		{"github over http", "GitHub", "http://github.com/budi", "url for GitHub must start with https://github.com/"},
+		{"linkedin www", "LinkedIn", "https://www.linkedin.com/in/budi", ""},
+		{"x accepts twitter.com", "X", "https://twitter.com/budi", ""},
+		{"unknown platform any https", "Blog", "https://budi.dev", ""},
+		{"unknown platform not a url", "Blog", "budi.dev", "url must be a valid http(s) URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSocialLinkURL(tt.platform, tt.url)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var appErr *utils.AppError
+			if !errors.As(err, &appErr) || len(appErr.Fields) != 1 {
+				t.Fatalf("err = %v, want one field error", err)
+			}
+			if field := appErr.Fields[0]; field.Field != "url" || field.Message != tt.want {
+				t.Fatalf("field error = %+v, want %q", field, tt.want)
+			}
+		})
+	}
+}
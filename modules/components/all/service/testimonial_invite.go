@@ -0,0 +1,231 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/utils"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ============================
+// TESTIMONIAL INVITES
+// ============================
+// Admin membuat invite untuk klien tertentu (opsional terikat ke project) lalu
+// membagikan link berisi token. Token hanya ditampilkan sekali dan hanya
+// berlaku untuk satu submit. Testimoni dari invite ditandai verified dan
+// otomatis tertaut ke project, tapi tetap pending sampai dimoderasi.
+
+const defaultTestimonialInviteTTL = 14 * 24 * time.Hour
+
+func (s *testimonialService) CreateInvite(ctx *gin.Context) (*model.TestimonialInviteResponse, error) {
+	var req model.TestimonialInviteRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProject(req.ProjectID); err != nil {
+		return nil, err
+	}
+
+	ttl := defaultTestimonialInviteTTL
+	if req.ExpiresInHours > 0 {
+		ttl = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	plainToken, err := newSecretToken()
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuat invite token: %w", err)
+	}
+
+	invite := &model.TestimonialInvite{
+		TokenHash:     hashSecretToken(plainToken),
+		ClientName:    strings.TrimSpace(req.ClientName),
+		ClientCompany: strings.TrimSpace(req.ClientCompany),
+		ClientEmail:   strings.TrimSpace(req.ClientEmail),
+		ProjectID:     req.ProjectID,
+		Note:          req.Note,
+		ExpiresAt:     time.Now().Add(ttl),
+	}
+
+	if err := s.invites.Create(invite); err != nil {
+		return nil, err
+	}
+
+	response := convertTestimonialInviteToResponse(invite)
+	response.Token = plainToken
+	return &response, nil
+}
+
+func (s *testimonialService) GetInvites(ctx *gin.Context) ([]model.TestimonialInviteResponse, error) {
+	var projectID *uuid.UUID
+	if idStr := ctx.Query("project_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, utils.InvalidID("project")
+		}
+		projectID = &id
+	}
+
+	invites, err := s.invites.GetAll(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.TestimonialInviteResponse, 0, len(invites))
+	for i := range invites {
+		responses = append(responses, convertTestimonialInviteToResponse(&invites[i]))
+	}
+
+	return responses, nil
+}
+
+// RevokeInvite hanya berlaku untuk invite yang belum dipakai
+func (s *testimonialService) RevokeInvite(ctx *gin.Context) error {
+	id, err := uuid.Parse(ctx.Param("invite_id"))
+	if err != nil {
+		return utils.InvalidID("invite")
+	}
+
+	if err := s.invites.Revoke(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.NotFound("invite")
+		}
+		return err
+	}
+	return nil
+}
+
+// GetInvitePrefill dipanggil halaman form klien untuk mengisi nama,
+// perusahaan dan project sebelum testimoni ditulis
+func (s *testimonialService) GetInvitePrefill(ctx *gin.Context) (*model.TestimonialInvitePrefill, error) {
+	invite, err := s.findInvite(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefill := &model.TestimonialInvitePrefill{
+		ClientName:    invite.ClientName,
+		ClientCompany: invite.ClientCompany,
+		ExpiresAt:     invite.ExpiresAt,
+	}
+
+	if invite.ProjectID != nil {
+		if project, err := s.projectRepo.GetProjekRepository(*invite.ProjectID); err == nil {
+			prefill.Project = &model.TestimonialInviteProject{ID: project.ID, Title: project.Title}
+		}
+	}
+
+	return prefill, nil
+}
+
+// SubmitWithInvite menyimpan testimoni dari pemegang invite. Invite di-claim
+// lebih dulu supaya submit ganda dengan token yang sama ditolak, lalu
+// dilepas lagi jika testimoni gagal disimpan.
+func (s *testimonialService) SubmitWithInvite(ctx *gin.Context) (*model.TestimonialSubmissionResponse, error) {
+	invite, err := s.findInvite(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var form model.TestimonialInviteSubmissionForm
+	if err := utils.Bind(ctx, &form); err != nil {
+		return nil, err
+	}
+
+	test := &model.Testimonial{
+		Name:       firstNonEmpty(strings.TrimSpace(form.Name), invite.ClientName),
+		Title:      firstNonEmpty(strings.TrimSpace(form.Title), invite.ClientCompany),
+		Message:    strings.TrimSpace(form.Message),
+		Rating:     form.Rating,
+		Email:      firstNonEmpty(strings.TrimSpace(form.Email), invite.ClientEmail),
+		Status:     "pending",
+		Source:     "invite",
+		ProjectID:  invite.ProjectID,
+		IsVerified: true,
+		UserAgent:  ctx.Request.UserAgent(),
+	}
+
+	if test.Message == "" {
+		return nil, utils.RequireFields("message", test.Message)
+	}
+
+	if err := s.invites.Claim(invite.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ConflictKey("invite_unavailable")
+		}
+		return nil, err
+	}
+
+	if err := s.saveSubmission(ctx, test); err != nil {
+		if releaseErr := s.invites.Release(invite.ID); releaseErr != nil {
+			log.Printf("⚠️ Warning: gagal melepas invite testimonial %s: %v", invite.ID, releaseErr)
+		}
+		return nil, err
+	}
+
+	if err := s.invites.AttachTestimonial(invite.ID, test.ID); err != nil {
+		log.Printf("⚠️ Warning: gagal menautkan testimonial %s ke invite %s: %v", test.ID, invite.ID, err)
+	}
+
+	return &model.TestimonialSubmissionResponse{
+		ID:        test.ID,
+		Status:    test.Status,
+		CreatedAt: test.CreatedAt,
+	}, nil
+}
+
+func (s *testimonialService) findInvite(ctx *gin.Context) (*model.TestimonialInvite, error) {
+	plainToken := ctx.Param("token")
+	if plainToken == "" {
+		return nil, utils.NotFound("invite")
+	}
+
+	invite, err := s.invites.FindActive(hashSecretToken(plainToken))
+	if err != nil {
+		return nil, utils.NotFound("invite")
+	}
+	return invite, nil
+}
+
+func convertTestimonialInviteToResponse(invite *model.TestimonialInvite) model.TestimonialInviteResponse {
+	status := "active"
+	switch {
+	case invite.UsedAt != nil:
+		status = "used"
+	case invite.RevokedAt != nil:
+		status = "revoked"
+	case !invite.ExpiresAt.After(time.Now()):
+		status = "expired"
+	}
+
+	return model.TestimonialInviteResponse{
+		ID:            invite.ID,
+		ClientName:    invite.ClientName,
+		ClientCompany: invite.ClientCompany,
+		ClientEmail:   invite.ClientEmail,
+		ProjectID:     invite.ProjectID,
+		Note:          invite.Note,
+		Status:        status,
+		ExpiresAt:     invite.ExpiresAt,
+		UsedAt:        invite.UsedAt,
+		RevokedAt:     invite.RevokedAt,
+		TestimonialID: invite.TestimonialID,
+		CreatedAt:     invite.CreatedAt,
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,431 @@
+package service
+
+import (
+	"fmt"
+	model "gintugas/modules/components/all/models"
+	"gintugas/modules/components/all/repo"
+	"gintugas/modules/utils"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// CONTENT TRANSLATIONS SERVICE
+// ============================
+// Konten dasar setiap entity ditulis dalam default_locale. Untuk locale lain,
+// field yang terdaftar di translatableEntities bisa diberi terjemahan; field
+// tanpa terjemahan tetap menampilkan konten dasar. Service ini juga menjadi
+// utils.Localizer untuk service lain yang menampilkan konten tersebut.
+
+type translatableEntity struct {
+	table      string
+	labelField string // ditampilkan di laporan missing, sudah termasuk di fields
+	fields     []string
+}
+
+var translatableEntities = map[string]translatableEntity{
+	utils.TranslatableProject:    {table: "portfolio_projects", labelField: "title", fields: []string{"title", "description"}},
+	utils.TranslatableBlogPost:   {table: "portfolio_blog_posts", labelField: "title", fields: []string{"title", "excerpt", "content"}},
+	utils.TranslatableExperience: {table: "portfolio_experiences", labelField: "title", fields: []string{"title", "location"}},
+	utils.TranslatableEducation:  {table: "portfolio_education", labelField: "major", fields: []string{"major", "degree", "description"}},
+	utils.TranslatableSection:    {table: "portfolio_sections", labelField: "label", fields: []string{"label"}},
+}
+
+// Urutan entity di laporan missing
+var translatableEntityTypes = []string{
+	utils.TranslatableSection,
+	utils.TranslatableProject,
+	utils.TranslatableExperience,
+	utils.TranslatableEducation,
+	utils.TranslatableBlogPost,
+}
+
+type TranslationService interface {
+	utils.Localizer
+	GetAll(ctx *gin.Context) ([]model.TranslationResponse, error)
+	GetEntity(ctx *gin.Context) (*model.TranslationResponse, error)
+	Upsert(ctx *gin.Context) (*model.TranslationResponse, error)
+	Delete(ctx *gin.Context) error
+	GetMissing(ctx *gin.Context) (*model.MissingTranslationReport, error)
+}
+
+type translationService struct {
+	repo     repo.TranslationRepository
+	settings *SettingsReader
+}
+
+func NewTranslationService(repo repo.TranslationRepository, settings *SettingsReader) TranslationService {
+	return &translationService{repo: repo, settings: settings}
+}
+
+// Translations mengembalikan nil jika request memakai default locale, atau
+// jika terjemahan gagal dibaca (konten dasar tetap lebih baik daripada error)
+func (s *translationService) Translations(ctx *gin.Context, entityType string) utils.Translations {
+	locale, ok := utils.GetContentLocale(ctx)
+	if !ok || locale.IsDefault() {
+		return nil
+	}
+
+	rows, err := s.repo.GetByLocale(entityType, locale.Locale)
+	if err != nil {
+		log.Printf("⚠️ Warning: gagal membaca terjemahan %s (%s): %v", entityType, locale.Locale, err)
+		return nil
+	}
+
+	translations := make(utils.Translations)
+	for _, row := range rows {
+		if translations[row.EntityID] == nil {
+			translations[row.EntityID] = make(map[string]string)
+		}
+		translations[row.EntityID][row.Field] = row.Value
+	}
+	return translations
+}
+
+func (s *translationService) GetAll(ctx *gin.Context) ([]model.TranslationResponse, error) {
+	filter := repo.TranslationFilter{
+		EntityType: ctx.Query("entity_type"),
+		Locale:     strings.ToLower(ctx.Query("locale")),
+	}
+	if filter.EntityType != "" {
+		if _, err := lookupTranslatable(filter.EntityType); err != nil {
+			return nil, err
+		}
+	}
+	if idStr := ctx.Query("entity_id"); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, utils.InvalidID("entity")
+		}
+		filter.EntityID = &id
+	}
+
+	rows, err := s.repo.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupTranslations(rows), nil
+}
+
+func (s *translationService) GetEntity(ctx *gin.Context) (*model.TranslationResponse, error) {
+	entityType, entityID, locale, err := s.parseTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.loadEntity(entityType, entityID, locale)
+}
+
+// Upsert mengganti field yang dikirim saja; field lain yang sudah
+// diterjemahkan tidak berubah. Value kosong menghapus terjemahan field itu.
+func (s *translationService) Upsert(ctx *gin.Context) (*model.TranslationResponse, error) {
+	entityType, entityID, locale, err := s.parseTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var req model.TranslationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	entity := translatableEntities[entityType]
+	values := make(map[string]string, len(req.Fields))
+	var removed, unknown []string
+	for field, value := range req.Fields {
+		switch {
+		case !containsString(entity.fields, field):
+			unknown = append(unknown, field)
+		case strings.TrimSpace(value) == "":
+			removed = append(removed, field)
+		default:
+			values[field] = strings.TrimSpace(value)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		fields := make([]utils.FieldError, 0, len(unknown))
+		for _, field := range unknown {
+			fields = append(fields, utils.FieldError{
+				Field:   "fields." + field,
+				Rule:    "translatable",
+				Message: fmt.Sprintf("%s has no translatable field %q (allowed: %s)", entityType, field, strings.Join(entity.fields, ", ")),
+			})
+		}
+		return nil, utils.ValidationFailed(fields)
+	}
+
+	if err := s.repo.Save(entityType, entityID, locale, values, removed); err != nil {
+		return nil, err
+	}
+
+	return s.loadEntity(entityType, entityID, locale)
+}
+
+func (s *translationService) Delete(ctx *gin.Context) error {
+	entityType, entityID, locale, err := s.parseTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(entityType, entityID, locale)
+}
+
+// GetMissing melaporkan entity yang punya konten dasar tapi belum
+// diterjemahkan ke locale tertentu (default: locale pertama selain default)
+func (s *translationService) GetMissing(ctx *gin.Context) (*model.MissingTranslationReport, error) {
+	defaultLocale, supported := s.settings.Locales()
+
+	locale := strings.ToLower(strings.TrimSpace(ctx.Query("locale")))
+	if locale == "" {
+		for _, candidate := range supported {
+			if candidate != defaultLocale {
+				locale = candidate
+				break
+			}
+		}
+		if locale == "" {
+			return nil, utils.BadRequestKey("translation_no_locale")
+		}
+	} else if err := checkTranslationLocale(locale, defaultLocale, supported); err != nil {
+		return nil, err
+	}
+
+	entityTypes := translatableEntityTypes
+	if entityType := ctx.Query("entity_type"); entityType != "" {
+		if _, err := lookupTranslatable(entityType); err != nil {
+			return nil, err
+		}
+		entityTypes = []string{entityType}
+	}
+
+	report := &model.MissingTranslationReport{
+		Locale:        locale,
+		DefaultLocale: defaultLocale,
+		Items:         []model.MissingTranslation{},
+	}
+
+	for _, entityType := range entityTypes {
+		entity := translatableEntities[entityType]
+
+		rows, err := s.repo.SourceRows(entity.table, entity.fields)
+		if err != nil {
+			return nil, err
+		}
+		translations, err := s.repo.GetByLocale(entityType, locale)
+		if err != nil {
+			return nil, err
+		}
+
+		translated := make(map[uuid.UUID]map[string]bool)
+		for _, t := range translations {
+			if translated[t.EntityID] == nil {
+				translated[t.EntityID] = make(map[string]bool)
+			}
+			translated[t.EntityID][t.Field] = true
+		}
+
+		for _, row := range rows {
+			id, err := uuid.Parse(columnText(row["id"]))
+			if err != nil {
+				continue
+			}
+			report.Checked++
+
+			var missing []string
+			for _, field := range entity.fields {
+				if strings.TrimSpace(columnText(row[field])) != "" && !translated[id][field] {
+					missing = append(missing, field)
+				}
+			}
+			if len(missing) == 0 {
+				continue
+			}
+
+			report.Incomplete++
+			report.MissingFields += len(missing)
+			report.Items = append(report.Items, model.MissingTranslation{
+				EntityType:    entityType,
+				EntityID:      id,
+				Label:         columnText(row[entity.labelField]),
+				MissingFields: missing,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// parseTarget membaca :entity_type, :entity_id dan :locale lalu memastikan
+// entity-nya ada dan locale-nya bukan default_locale
+func (s *translationService) parseTarget(ctx *gin.Context) (string, uuid.UUID, string, error) {
+	entityType := ctx.Param("entity_type")
+	entity, err := lookupTranslatable(entityType)
+	if err != nil {
+		return "", uuid.Nil, "", err
+	}
+
+	entityID, err := uuid.Parse(ctx.Param("entity_id"))
+	if err != nil {
+		return "", uuid.Nil, "", utils.InvalidID(entityType)
+	}
+
+	locale := strings.ToLower(ctx.Param("locale"))
+	defaultLocale, supported := s.settings.Locales()
+	if err := checkTranslationLocale(locale, defaultLocale, supported); err != nil {
+		return "", uuid.Nil, "", err
+	}
+
+	exists, err := s.repo.EntityExists(entity.table, entityID)
+	if err != nil {
+		return "", uuid.Nil, "", err
+	}
+	if !exists {
+		return "", uuid.Nil, "", utils.NotFound(strings.ReplaceAll(entityType, "_", " "))
+	}
+
+	return entityType, entityID, locale, nil
+}
+
+func (s *translationService) loadEntity(entityType string, entityID uuid.UUID, locale string) (*model.TranslationResponse, error) {
+	rows, err := s.repo.List(repo.TranslationFilter{EntityType: entityType, EntityID: &entityID, Locale: locale})
+	if err != nil {
+		return nil, err
+	}
+
+	if grouped := groupTranslations(rows); len(grouped) > 0 {
+		return &grouped[0], nil
+	}
+	return &model.TranslationResponse{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Locale:     locale,
+		Fields:     map[string]string{},
+	}, nil
+}
+
+func lookupTranslatable(entityType string) (translatableEntity, error) {
+	entity, ok := translatableEntities[entityType]
+	if !ok {
+		return translatableEntity{}, utils.ValidationFailed([]utils.FieldError{
+			utils.NewFieldError("entity_type", "oneof", "oneof", "param", strings.Join(translatableEntityTypes, ", ")),
+		})
+	}
+	return entity, nil
+}
+
+// checkTranslationLocale: terjemahan untuk default_locale ditolak karena
+// konten dasar sudah memakai bahasa itu
+func checkTranslationLocale(locale, defaultLocale string, supported []string) error {
+	if !containsString(supported, locale) {
+		return utils.ValidationFailed([]utils.FieldError{
+			utils.NewFieldError("locale", "oneof", "oneof", "param", strings.Join(supported, ", ")),
+		})
+	}
+	if locale == defaultLocale {
+		return utils.ValidationFailed([]utils.FieldError{
+			utils.NewFieldError("locale", "default_locale", "translation_default_locale", "param", locale),
+		})
+	}
+	return nil
+}
+
+// groupTranslations mengelompokkan baris (sudah urut entity, locale, field)
+// menjadi satu response per entity + locale
+func groupTranslations(rows []model.ContentTranslation) []model.TranslationResponse {
+	responses := []model.TranslationResponse{}
+	for _, row := range rows {
+		last := len(responses) - 1
+		if last < 0 || responses[last].EntityType != row.EntityType || responses[last].EntityID != row.EntityID || responses[last].Locale != row.Locale {
+			responses = append(responses, model.TranslationResponse{
+				EntityType: row.EntityType,
+				EntityID:   row.EntityID,
+				Locale:     row.Locale,
+				Fields:     map[string]string{},
+			})
+			last++
+		}
+		responses[last].Fields[row.Field] = row.Value
+		if row.UpdatedAt.After(responses[last].UpdatedAt) {
+			responses[last].UpdatedAt = row.UpdatedAt
+		}
+	}
+	return responses
+}
+
+// columnText mengubah nilai kolom dari query map (string, []byte atau NULL)
+func columnText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+func localizeEducation(translations utils.Translations, edu *model.EducationResponse) {
+	translations.Apply(edu.ID, "major", &edu.Major)
+	translations.Apply(edu.ID, "degree", &edu.Degree)
+	translations.Apply(edu.ID, "description", &edu.Description)
+}
+
+func localizeBlogPost(translations utils.Translations, post *model.BlogPostResponse) {
+	translations.Apply(post.ID, "title", &post.Title)
+	translations.Apply(post.ID, "excerpt", &post.Excerpt)
+	translations.Apply(post.ID, "content", &post.Content)
+}
+
+// ============================
+// LOCALE SETTINGS
+// ============================
+
+// LocaleConfig membaca default_locale, supported_locales dan
+// default_timezone. default_locale selalu termasuk supported meskipun tidak
+// tercantum di setting.
+func (r *SettingsReader) LocaleConfig() utils.LocaleConfig {
+	defaultLocale, supported := r.Locales()
+
+	location, ok := utils.LoadTimeZone(SettingValue(r, "default_timezone", utils.DefaultTimeZone))
+	if !ok {
+		location, _ = utils.LoadTimeZone(utils.DefaultTimeZone)
+	}
+
+	return utils.LocaleConfig{DefaultLocale: defaultLocale, Supported: supported, TimeZone: location}
+}
+
+func (r *SettingsReader) Locales() (string, []string) {
+	defaultLocale := strings.ToLower(strings.TrimSpace(SettingValue(r, "default_locale", utils.LocaleID)))
+	if defaultLocale == "" {
+		defaultLocale = utils.LocaleID
+	}
+
+	supported := []string{defaultLocale}
+	for _, locale := range SettingValue(r, "supported_locales", []string{utils.LocaleID, utils.LocaleEN}) {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if locale != "" && !containsString(supported, locale) {
+			supported = append(supported, locale)
+		}
+	}
+	return defaultLocale, supported
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"gintugas/modules/utils"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,16 +12,22 @@ import (
 // ============================
 
 type Experience struct {
-	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Title        string    `json:"title" gorm:"type:varchar(200);not null"`
-	Company      string    `json:"company" gorm:"type:varchar(150);not null"`
-	Location     string    `json:"location" gorm:"type:varchar(200);not null"`
-	StartYears   string    `json:"start_year" gorm:"column:start_year;type:varchar(20);not null"`
-	EndYears     string    `json:"end_year" gorm:"column:end_year;type:varchar(20);not null"`
-	CurrentJob   bool      `json:"current_job" gorm:"column:current_job;type:boolean;default:false"`
-	DisplayOrder int       `json:"display_order" gorm:"type:integer;default:0"`
-	CreatedAt    time.Time `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
-	UpdatedAt    time.Time `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Title         string     `json:"title" gorm:"type:varchar(200);not null"`
+	Company       string     `json:"company" gorm:"type:varchar(150);not null"`
+	Location      string     `json:"location" gorm:"type:varchar(200);not null"`
+	StartDate     *time.Time `json:"start_date" gorm:"type:date"`
+	EndDate       *time.Time `json:"end_date" gorm:"type:date"` // nil jika is_ongoing
+	IsOngoing     bool       `json:"is_ongoing" gorm:"type:boolean;not null;default:false"`
+	DatePrecision string     `json:"date_precision" gorm:"type:varchar(10);not null;default:'month'"` // month, year
+	DisplayOrder  int        `json:"display_order" gorm:"type:integer;default:0"`
+	Version       int        `json:"version" gorm:"not null;default:1"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+func (e Experience) DateRange() utils.DateRange {
+	return utils.DateRange{Start: e.StartDate, End: e.EndDate, Ongoing: e.IsOngoing, Precision: e.DatePrecision}
 }
 
 type ExperienceWithRelations struct {
@@ -71,24 +78,12 @@ type ExperienceRequest struct {
 	Title            string                  `json:"title" binding:"required"`
 	Company          string                  `json:"company" binding:"required"`
 	Location         string                  `json:"location" binding:"required"`
-	StartYears       string                  `json:"start_year" binding:"required"`
-	EndYears         string                  `json:"end_year" binding:"required"`
-	CurrentJob       bool                    `json:"current_job"`
-	DisplayOrder     int                     `json:"display_order"`
-	Responsibilities []ResponsibilityRequest `json:"responsibilities"`
-	Skills           []SkillRequest          `json:"skills"`
-}
-
-type ExperienceUpdateRequest struct {
-	Title            string                  `json:"title"`
-	Company          string                  `json:"company"`
-	Location         string                  `json:"location"`
-	StartYears       string                  `json:"start_year"`
-	EndYears         string                  `json:"end_year"`
-	CurrentJob       bool                    `json:"current_job"`
+	StartDate        string                  `json:"start_date" binding:"required,year"`                        // YYYY-MM atau YYYY
+	EndDate          string                  `json:"end_date" binding:"omitempty,year_end,yearrange=StartDate"` // kosong jika is_ongoing
+	IsOngoing        bool                    `json:"is_ongoing"`
 	DisplayOrder     int                     `json:"display_order"`
-	Responsibilities []ResponsibilityRequest `json:"responsibilities"`
-	Skills           []SkillRequest          `json:"skills"`
+	Responsibilities []ResponsibilityRequest `json:"responsibilities" binding:"omitempty,dive"`
+	Skills           []SkillRequest          `json:"skills" binding:"omitempty,dive"`
 }
 
 type ResponsibilityRequest struct {
@@ -109,12 +104,15 @@ type ExperienceResponse struct {
 	Title            string                   `json:"title"`
 	Company          string                   `json:"company"`
 	Location         string                   `json:"location"`
-	StartYears       string                   `json:"start_year"`
-	EndYears         string                   `json:"end_year"`
-	CurrentJob       bool                     `json:"current_job"`
+	StartDate        string                   `json:"start_date"`
+	EndDate          *string                  `json:"end_date"`
+	IsOngoing        bool                     `json:"is_ongoing"`
+	DatePrecision    string                   `json:"date_precision"`
+	Period           utils.Period             `json:"period"`
 	DisplayOrder     int                      `json:"display_order"`
 	Responsibilities []ResponsibilityResponse `json:"responsibilities"`
 	Skills           []SkillResponse          `json:"skills"`
+	Version          int                      `json:"version"`
 	CreatedAt        time.Time                `json:"created_at"`
 	UpdatedAt        time.Time                `json:"updated_at"`
 }
@@ -1,7 +1,9 @@
 package repo
 
 import (
+	"context"
 	"gintugas/modules/components/experiences/model"
+	"gintugas/modules/utils"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -12,8 +14,11 @@ type ExperiencesRepository interface {
 	CreateExperienceWithRelations(experience *model.ExperienceWithRelations) error
 	GetExperienceByIDWithRelations(experienceID uuid.UUID) (*model.ExperienceWithRelations, error)
 	UpdateExperienceWithRelations(experience *model.ExperienceWithRelations) error
-	DeleteExperienceWithRelations(experienceID uuid.UUID) error
+	DeleteExperienceWithRelations(experienceID uuid.UUID, version int) error
 	GetAllExperiencesWithRelations() ([]model.ExperienceWithRelations, error)
+	ListExperiencesWithRelations(q *utils.ListQuery) ([]model.ExperienceWithRelations, utils.PageInfo, error)
+	ReorderExperiences(ids []uuid.UUID) error
+	WithContext(ctx context.Context) ExperiencesRepository
 }
 
 type experienceRepository struct {
@@ -26,6 +31,12 @@ func NewExpeGormRepository(db *gorm.DB) ExperiencesRepository {
 	}
 }
 
+// WithContext mengikat semua query ke ctx, sehingga query ikut dibatalkan
+// saat request selesai atau timeout
+func (r *experienceRepository) WithContext(ctx context.Context) ExperiencesRepository {
+	return &experienceRepository{db: r.db.WithContext(ctx)}
+}
+
 func (r *experienceRepository) CreateExperienceWithRelations(experience *model.ExperienceWithRelations) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Create main experience first
@@ -109,6 +120,38 @@ func (r *experienceRepository) GetAllExperiencesWithRelations() ([]model.Experie
 		return nil, err
 	}
 
+	return r.loadRelations(experiences)
+}
+
+var ExperienceListSpec = utils.ListSpec{
+	DefaultSort: "display_order,-created_at",
+	Fields: map[string]utils.ListField{
+		"title":         {Column: "title", Type: utils.FieldText, Sortable: true},
+		"company":       {Column: "company", Type: utils.FieldText, Sortable: true, Filterable: true},
+		"start_date":    {Column: "start_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"end_date":      {Column: "end_date", Type: utils.FieldDate, Sortable: true, Filterable: true},
+		"is_ongoing":    {Column: "is_ongoing", Type: utils.FieldBool, Filterable: true},
+		"display_order": {Column: "display_order", Type: utils.FieldInt, Sortable: true},
+		"created_at":    {Column: "created_at", Type: utils.FieldTimestamp, Sortable: true},
+	},
+}
+
+func (r *experienceRepository) ListExperiencesWithRelations(q *utils.ListQuery) ([]model.ExperienceWithRelations, utils.PageInfo, error) {
+	experiences, page, err := utils.FindPage[model.Experience](r.db, q)
+	if err != nil {
+		return nil, page, err
+	}
+
+	result, err := r.loadRelations(experiences)
+	return result, page, err
+}
+
+func (r *experienceRepository) ReorderExperiences(ids []uuid.UUID) error {
+	return utils.ReorderByIDs(r.db, &model.Experience{}, ids)
+}
+
+// loadRelations memuat responsibilities dan skills untuk banyak experience sekaligus
+func (r *experienceRepository) loadRelations(experiences []model.Experience) ([]model.ExperienceWithRelations, error) {
 	if len(experiences) == 0 {
 		return []model.ExperienceWithRelations{}, nil
 	}
@@ -121,7 +164,7 @@ func (r *experienceRepository) GetAllExperiencesWithRelations() ([]model.Experie
 
 	// Load all responsibilities for these experiences
 	var allResponsibilities []model.ExperienceResponsibility
-	err = r.db.Where("experience_id IN (?)", experienceIDs).
+	err := r.db.Where("experience_id IN (?)", experienceIDs).
 		Order("experience_id, display_order ASC").
 		Find(&allResponsibilities).Error
 	if err != nil {
@@ -163,7 +206,7 @@ func (r *experienceRepository) GetAllExperiencesWithRelations() ([]model.Experie
 func (r *experienceRepository) UpdateExperienceWithRelations(experience *model.ExperienceWithRelations) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Update main experience
-		if err := tx.Save(&experience.Experience).Error; err != nil {
+		if err := utils.SaveVersioned(tx, &experience.Experience, &experience.Version); err != nil {
 			return err
 		}
 
@@ -202,7 +245,7 @@ func (r *experienceRepository) UpdateExperienceWithRelations(experience *model.E
 	})
 }
 
-func (r *experienceRepository) DeleteExperienceWithRelations(experienceID uuid.UUID) error {
+func (r *experienceRepository) DeleteExperienceWithRelations(experienceID uuid.UUID, version int) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Delete skills
 		if err := tx.Where("experience_id = ?", experienceID).Delete(&model.ExperienceSkill{}).Error; err != nil {
@@ -214,7 +257,7 @@ func (r *experienceRepository) DeleteExperienceWithRelations(experienceID uuid.U
 			return err
 		}
 
-		// Delete main experience
-		return tx.Where("id = ?", experienceID).Delete(&model.Experience{}).Error
+		// Delete main experience; gagal 412 (dan rollback) jika version berubah
+		return utils.DeleteVersioned(tx, &model.Experience{}, experienceID, version)
 	})
 }
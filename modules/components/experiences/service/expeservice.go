@@ -1,9 +1,9 @@
 package service
 
 import (
-	"errors"
 	"gintugas/modules/components/experiences/model"
 	"gintugas/modules/components/experiences/repo"
+	"gintugas/modules/utils"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,35 +14,44 @@ type ExperiencesService interface {
 	CreateExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error)
 	GetExperienceByIDWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error)
 	UpdateExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error)
+	PatchExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error)
 	DeleteExperienceWithRelations(ctx *gin.Context) error
-	GetAllExperiencesWithRelations(ctx *gin.Context) ([]model.ExperienceResponse, error)
+	GetAllExperiencesWithRelations(ctx *gin.Context) ([]model.ExperienceResponse, *utils.PageInfo, error)
 }
 
 type experiencesService struct {
 	experienceRepo repo.ExperiencesRepository
+	localizer      utils.Localizer
 }
 
-func NewExpeService(experienceRepo repo.ExperiencesRepository) ExperiencesService {
+func NewExpeService(experienceRepo repo.ExperiencesRepository, localizer utils.Localizer) ExperiencesService {
 	return &experiencesService{
 		experienceRepo: experienceRepo,
+		localizer:      localizer,
 	}
 }
 
 func (s *experiencesService) CreateExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error) {
 	var experienceReq model.ExperienceRequest
-	if err := ctx.ShouldBindJSON(&experienceReq); err != nil {
+	if err := utils.BindJSON(ctx, &experienceReq); err != nil {
+		return nil, err
+	}
+
+	dates, err := experienceDates(experienceReq)
+	if err != nil {
 		return nil, err
 	}
 
 	experience := &model.ExperienceWithRelations{
 		Experience: model.Experience{
-			Title:        experienceReq.Title,
-			Company:      experienceReq.Company,
-			Location:     experienceReq.Location,
-			StartYears:   experienceReq.StartYears,
-			EndYears:     experienceReq.EndYears,
-			CurrentJob:   experienceReq.CurrentJob,
-			DisplayOrder: experienceReq.DisplayOrder,
+			Title:         experienceReq.Title,
+			Company:       experienceReq.Company,
+			Location:      experienceReq.Location,
+			StartDate:     dates.Start,
+			EndDate:       dates.End,
+			IsOngoing:     dates.Ongoing,
+			DatePrecision: dates.Precision,
+			DisplayOrder:  experienceReq.DisplayOrder,
 		},
 	}
 
@@ -65,14 +74,14 @@ func (s *experiencesService) CreateExperienceWithRelations(ctx *gin.Context) (*m
 		return nil, err
 	}
 
-	return s.convertToResponse(experience), nil
+	return ConvertToResponse(experience, utils.NewDateFormatter(ctx)), nil
 }
 
 func (s *experiencesService) GetExperienceByIDWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error) {
 	experienceID := ctx.Param("id")
 	experienceUUID, err := uuid.Parse(experienceID)
 	if err != nil {
-		return nil, errors.New("format experience ID tidak valid")
+		return nil, utils.InvalidID("experience")
 	}
 
 	experience, err := s.experienceRepo.GetExperienceByIDWithRelations(experienceUUID)
@@ -80,14 +89,16 @@ func (s *experiencesService) GetExperienceByIDWithRelations(ctx *gin.Context) (*
 		return nil, err
 	}
 
-	return s.convertToResponse(experience), nil
+	response := ConvertToResponse(experience, utils.NewDateFormatter(ctx))
+	LocalizeExperience(utils.Localize(s.localizer, ctx, utils.TranslatableExperience), response)
+	return response, nil
 }
 
 func (s *experiencesService) UpdateExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error) {
 	experienceID := ctx.Param("id")
 	experienceUUID, err := uuid.Parse(experienceID)
 	if err != nil {
-		return nil, errors.New("format experience ID tidak valid")
+		return nil, utils.InvalidID("experience")
 	}
 
 	// Get existing experience
@@ -95,29 +106,56 @@ func (s *experiencesService) UpdateExperienceWithRelations(ctx *gin.Context) (*m
 	if err != nil {
 		return nil, err
 	}
+	if err := utils.CheckIfMatch(ctx, existingExperience.Version); err != nil {
+		return nil, err
+	}
 
-	var experienceReq model.ExperienceUpdateRequest
-	if err := ctx.ShouldBindJSON(&experienceReq); err != nil {
+	// PUT mengganti seluruh field termasuk responsibilities dan skills
+	var experienceReq model.ExperienceRequest
+	if err := utils.BindJSON(ctx, &experienceReq); err != nil {
 		return nil, err
 	}
 
-	// Update main fields
-	if experienceReq.Title != "" {
-		existingExperience.Title = experienceReq.Title
+	return s.replaceExperience(ctx, existingExperience, experienceReq)
+}
+
+// PatchExperienceWithRelations menerapkan JSON Merge Patch; responsibilities
+// dan skills yang dikirim menggantikan seluruh daftar lama
+func (s *experiencesService) PatchExperienceWithRelations(ctx *gin.Context) (*model.ExperienceResponse, error) {
+	experienceUUID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, utils.InvalidID("experience")
 	}
-	if experienceReq.Company != "" {
-		existingExperience.Company = experienceReq.Company
+
+	existingExperience, err := s.experienceRepo.GetExperienceByIDWithRelations(experienceUUID)
+	if err != nil {
+		return nil, err
 	}
-	if experienceReq.Location != "" {
-		existingExperience.Location = experienceReq.Location
+	if err := utils.CheckIfMatch(ctx, existingExperience.Version); err != nil {
+		return nil, err
 	}
-	if experienceReq.StartYears != "" {
-		existingExperience.StartYears = experienceReq.StartYears
+
+	experienceReq := experienceToRequest(existingExperience)
+	if err := utils.BindMergePatch(ctx, &experienceReq); err != nil {
+		return nil, err
 	}
-	if experienceReq.EndYears != "" {
-		existingExperience.EndYears = experienceReq.EndYears
+
+	return s.replaceExperience(ctx, existingExperience, experienceReq)
+}
+
+func (s *experiencesService) replaceExperience(ctx *gin.Context, existingExperience *model.ExperienceWithRelations, experienceReq model.ExperienceRequest) (*model.ExperienceResponse, error) {
+	dates, err := experienceDates(experienceReq)
+	if err != nil {
+		return nil, err
 	}
-	existingExperience.CurrentJob = experienceReq.CurrentJob
+
+	existingExperience.Title = experienceReq.Title
+	existingExperience.Company = experienceReq.Company
+	existingExperience.Location = experienceReq.Location
+	existingExperience.StartDate = dates.Start
+	existingExperience.EndDate = dates.End
+	existingExperience.IsOngoing = dates.Ongoing
+	existingExperience.DatePrecision = dates.Precision
 	existingExperience.DisplayOrder = experienceReq.DisplayOrder
 	existingExperience.UpdatedAt = time.Now()
 
@@ -142,34 +180,66 @@ func (s *experiencesService) UpdateExperienceWithRelations(ctx *gin.Context) (*m
 		return nil, err
 	}
 
-	return s.convertToResponse(existingExperience), nil
+	return ConvertToResponse(existingExperience, utils.NewDateFormatter(ctx)), nil
 }
 
 func (s *experiencesService) DeleteExperienceWithRelations(ctx *gin.Context) error {
 	experienceID := ctx.Param("id")
 	experienceUUID, err := uuid.Parse(experienceID)
 	if err != nil {
-		return errors.New("format experience ID tidak valid")
+		return utils.InvalidID("experience")
 	}
 
-	return s.experienceRepo.DeleteExperienceWithRelations(experienceUUID)
+	existing, err := s.experienceRepo.GetExperienceByIDWithRelations(experienceUUID)
+	if err != nil {
+		return utils.NotFound("experience")
+	}
+	if err := utils.CheckIfMatch(ctx, existing.Version); err != nil {
+		return err
+	}
+
+	return s.experienceRepo.DeleteExperienceWithRelations(experienceUUID, existing.Version)
 }
 
-func (s *experiencesService) GetAllExperiencesWithRelations(ctx *gin.Context) ([]model.ExperienceResponse, error) {
-	experiences, err := s.experienceRepo.GetAllExperiencesWithRelations()
+func (s *experiencesService) GetAllExperiencesWithRelations(ctx *gin.Context) ([]model.ExperienceResponse, *utils.PageInfo, error) {
+	q, err := utils.ParseListQuery(ctx, repo.ExperienceListSpec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var responses []model.ExperienceResponse
+	experiences, page, err := s.experienceRepo.ListExperiencesWithRelations(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	translations := utils.Localize(s.localizer, ctx, utils.TranslatableExperience)
+	formatter := utils.NewDateFormatter(ctx)
+
+	responses := make([]model.ExperienceResponse, 0, len(experiences))
 	for _, exp := range experiences {
-		responses = append(responses, *s.convertToResponse(&exp))
+		response := ConvertToResponse(&exp, formatter)
+		LocalizeExperience(translations, response)
+		responses = append(responses, *response)
 	}
 
-	return responses, nil
+	return responses, &page, nil
+}
+
+// LocalizeExperience menerapkan terjemahan title dan location (nama
+// perusahaan tidak diterjemahkan)
+func LocalizeExperience(translations utils.Translations, experience *model.ExperienceResponse) {
+	translations.Apply(experience.ID, "title", &experience.Title)
+	translations.Apply(experience.ID, "location", &experience.Location)
+}
+
+// experienceDates memvalidasi start_date/end_date/is_ongoing dari request
+func experienceDates(experienceReq model.ExperienceRequest) (utils.DateRange, error) {
+	return utils.ParseDateRange(experienceReq.StartDate, experienceReq.EndDate, experienceReq.IsOngoing, "start_date", "end_date")
 }
 
-func (s *experiencesService) convertToResponse(experience *model.ExperienceWithRelations) *model.ExperienceResponse {
+// ConvertToResponse juga dipakai oleh endpoint snapshot portfolio. Timestamp
+// dan period dirender sesuai locale/timezone pengunjung lewat formatter.
+func ConvertToResponse(experience *model.ExperienceWithRelations, dates utils.DateFormatter) *model.ExperienceResponse {
 	// Convert responsibilities
 	var respResponses []model.ResponsibilityResponse
 	for _, resp := range experience.Responsibilities {
@@ -178,7 +248,7 @@ func (s *experiencesService) convertToResponse(experience *model.ExperienceWithR
 			ExperienceID: resp.ExperienceID,
 			Description:  resp.Description,
 			DisplayOrder: resp.DisplayOrder,
-			CreatedAt:    resp.CreatedAt,
+			CreatedAt:    dates.Time(resp.CreatedAt),
 		})
 	}
 
@@ -196,13 +266,53 @@ func (s *experiencesService) convertToResponse(experience *model.ExperienceWithR
 		Title:            experience.Title,
 		Company:          experience.Company,
 		Location:         experience.Location,
-		StartYears:       experience.StartYears,
-		EndYears:         experience.EndYears,
-		CurrentJob:       experience.CurrentJob,
+		StartDate:        utils.FormatMonthDate(experience.StartDate, experience.DatePrecision),
+		EndDate:          optionalMonthDate(experience.EndDate, experience.DatePrecision),
+		IsOngoing:        experience.IsOngoing,
+		DatePrecision:    experience.DatePrecision,
+		Period:           dates.Period(experience.DateRange()),
 		DisplayOrder:     experience.DisplayOrder,
 		Responsibilities: respResponses,
 		Skills:           skillResponses,
-		CreatedAt:        experience.CreatedAt,
-		UpdatedAt:        experience.UpdatedAt,
+		Version:          experience.Version,
+		CreatedAt:        dates.Time(experience.CreatedAt),
+		UpdatedAt:        dates.Time(experience.UpdatedAt),
+	}
+}
+
+func experienceToRequest(experience *model.ExperienceWithRelations) model.ExperienceRequest {
+	responsibilities := make([]model.ResponsibilityRequest, 0, len(experience.Responsibilities))
+	for _, resp := range experience.Responsibilities {
+		responsibilities = append(responsibilities, model.ResponsibilityRequest{
+			Description:  resp.Description,
+			DisplayOrder: resp.DisplayOrder,
+		})
+	}
+
+	skills := make([]model.SkillRequest, 0, len(experience.Skills))
+	for _, skill := range experience.Skills {
+		skills = append(skills, model.SkillRequest{SkillName: skill.SkillName})
+	}
+
+	return model.ExperienceRequest{
+		Title:            experience.Title,
+		Company:          experience.Company,
+		Location:         experience.Location,
+		StartDate:        utils.FormatMonthDate(experience.StartDate, experience.DatePrecision),
+		EndDate:          utils.FormatMonthDate(experience.EndDate, experience.DatePrecision),
+		IsOngoing:        experience.IsOngoing,
+		DisplayOrder:     experience.DisplayOrder,
+		Responsibilities: responsibilities,
+		Skills:           skills,
+	}
+}
+
+// optionalMonthDate: end_date null untuk periode ongoing atau yang tidak
+// diketahui akhirnya
+func optionalMonthDate(date *time.Time, precision string) *string {
+	if date == nil {
+		return nil
 	}
+	value := utils.FormatMonthDate(date, precision)
+	return &value
 }
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"gintugas/modules/utils"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// REQUEST ID & ERROR HANDLER (RFC 7807)
+// ============================
+// Handler cukup memanggil c.Error(err) lalu return; ErrorHandler merender error
+// terakhir sebagai application/problem+json dengan code dan request_id yang
+// sama seperti header X-Request-ID.
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	requestIDKey    = "request_id"
+)
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{8,64}$`)
+
+type Problem struct {
+	Type      string             `json:"type"`
+	Title     string             `json:"title"`
+	Status    int                `json:"status"`
+	Detail    string             `json:"detail"`
+	Instance  string             `json:"instance"`
+	Code      string             `json:"code"`
+	RequestID string             `json:"request_id"`
+	Errors    []utils.FieldError `json:"errors,omitempty"`
+}
+
+// RequestID memakai X-Request-ID dari client/proxy jika formatnya wajar,
+// selain itu dibuatkan UUID baru
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			id = uuid.NewString()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		// Error biasa yang belum dibungkus handler dianggap 500
+		appErr := utils.AsAppError(c.Errors.Last().Err, http.StatusInternalServerError)
+		if appErr.Status >= http.StatusInternalServerError {
+			log.Printf("❌ [%s] %s %s: %v", GetRequestID(c), c.Request.Method, c.Request.URL.Path, appErr)
+		} else if appErr.Cause != nil {
+			// Cause tidak dikirim ke client, jadi hanya terlihat di log
+			log.Printf("⚠️ Warning: [%s] %s %s: %s: %v", GetRequestID(c), c.Request.Method, c.Request.URL.Path, appErr.Message, appErr.Cause)
+		}
+
+		RenderProblem(c, appErr)
+	}
+}
+
+// RenderProblem menulis AppError sebagai problem+json. Detail dan pesan field
+// dirender dalam bahasa request (id/en) jika error dibuat dari katalog.
+func RenderProblem(c *gin.Context, appErr *utils.AppError) {
+	locale := utils.RequestLocale(c)
+	c.Header("Content-Type", "application/problem+json")
+	c.AbortWithStatusJSON(appErr.Status, Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(appErr.Status),
+		Status:    appErr.Status,
+		Detail:    appErr.Localize(locale),
+		Instance:  c.Request.URL.Path,
+		Code:      appErr.Code,
+		RequestID: GetRequestID(c),
+		Errors:    appErr.LocalizedFields(locale),
+	})
+}
@@ -0,0 +1,270 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gintugas/modules/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// HTTP CACHE (ETag, Last-Modified, Cache-Control)
+// ============================
+// Response GET/HEAD di-buffer lalu diberi ETag kuat (hash isi body, diawali
+// version jika handler memanggil utils.SetResourceVersion) dan
+// Last-Modified (updated_at/created_at terbaru di body JSON, atau nilai yang
+// di-set handler lewat SetLastModified). Request dengan If-None-Match /
+// If-Modified-Since yang cocok dijawab 304 tanpa body.
+
+const lastModifiedKey = "http_cache_last_modified"
+
+type CachePolicy struct {
+	MaxAge               int // detik, untuk browser
+	SMaxAge              int // detik, untuk shared cache (Vercel edge)
+	StaleWhileRevalidate int // detik
+	NoCache              bool
+}
+
+// Preset policy yang dipakai router
+var (
+	// Data yang jarang berubah (sections, settings, social links)
+	CacheStatic = CachePolicy{MaxAge: 60, SMaxAge: 600, StaleWhileRevalidate: 86400}
+	// List konten (projects, skills, blog list)
+	CacheContent = CachePolicy{MaxAge: 30, SMaxAge: 300, StaleWhileRevalidate: 3600}
+	// Selalu revalidasi ke server (misalnya detail blog yang menghitung view)
+	CacheRevalidate = CachePolicy{NoCache: true}
+)
+
+// Header mengubah policy menjadi nilai Cache-Control
+func (p CachePolicy) Header() string {
+	if p.NoCache {
+		return "public, no-cache"
+	}
+
+	parts := []string{"public", "max-age=" + strconv.Itoa(p.MaxAge)}
+	if p.SMaxAge > 0 {
+		parts = append(parts, "s-maxage="+strconv.Itoa(p.SMaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		parts = append(parts, "stale-while-revalidate="+strconv.Itoa(p.StaleWhileRevalidate))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CachePolicyFromEnv membaca override policy dari env, format:
+// "max-age=60,s-maxage=300,stale-while-revalidate=600" atau "no-cache"
+func CachePolicyFromEnv(key string, fallback CachePolicy) CachePolicy {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+
+	policy := CachePolicy{}
+	for _, part := range strings.Split(value, ",") {
+		name, raw, _ := strings.Cut(strings.TrimSpace(part), "=")
+		seconds, err := strconv.Atoi(raw)
+		switch {
+		case name == "no-cache":
+			policy.NoCache = true
+		case err != nil || seconds < 0:
+			fmt.Printf("⚠️ Warning: nilai %s tidak valid (%q), memakai default\n", key, part)
+			return fallback
+		case name == "max-age":
+			policy.MaxAge = seconds
+		case name == "s-maxage":
+			policy.SMaxAge = seconds
+		case name == "stale-while-revalidate":
+			policy.StaleWhileRevalidate = seconds
+		}
+	}
+	return policy
+}
+
+// SetLastModified dipakai handler yang tahu timestamp datanya secara pasti
+func SetLastModified(c *gin.Context, t time.Time) {
+	c.Set(lastModifiedKey, t)
+}
+
+func HTTPCache(policy CachePolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &cacheWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		// Error dirender oleh ErrorHandler, jangan di-cache
+		if len(c.Errors) > 0 && writer.body.Len() == 0 {
+			return
+		}
+
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		body := writer.body.Bytes()
+		header := original.Header()
+
+		// Satu version resource bisa punya banyak representasi (locale,
+		// with_tags, timezone, view_count), jadi hash body selalu ikut di
+		// ETag; version di depannya supaya ETag bisa dipakai untuk If-Match
+		sum := sha256.Sum256(body)
+		hash := hex.EncodeToString(sum[:16])
+		etag := `"` + hash + `"`
+		if version, err := strconv.Atoi(header.Get(utils.ResourceVersionHeader)); err == nil {
+			etag = utils.ResourceETag(version, hash)
+		}
+		header.Set("ETag", etag)
+
+		lastModified := responseLastModified(c, header, body)
+		if !lastModified.IsZero() {
+			header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+
+		// Response untuk admin atau lewat preview token tidak boleh disimpan shared cache
+		if c.GetHeader("Authorization") != "" || c.Query("preview_token") != "" || c.GetHeader("X-Preview-Token") != "" {
+			header.Set("Cache-Control", "private, no-cache")
+		} else {
+			header.Set("Cache-Control", policy.Header())
+		}
+		header.Add("Vary", "Authorization")
+
+		if notModified(c.Request, etag, lastModified) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		writer.flush()
+	}
+}
+
+// notModified mengikuti RFC 9110: If-None-Match didahulukan, If-Modified-Since
+// hanya dipakai jika If-None-Match tidak ada
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+func responseLastModified(c *gin.Context, header http.Header, body []byte) time.Time {
+	if value, ok := c.Get(lastModifiedKey); ok {
+		if t, ok := value.(time.Time); ok {
+			return t
+		}
+	}
+
+	if !strings.Contains(header.Get("Content-Type"), "json") {
+		return time.Time{}
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return time.Time{}
+	}
+	return latestTimestamp(payload)
+}
+
+// latestTimestamp mencari updated_at/created_at terbaru di seluruh body JSON
+func latestTimestamp(value interface{}) time.Time {
+	var latest time.Time
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "updated_at" || key == "created_at" {
+				if s, ok := child.(string); ok {
+					if t, err := time.Parse(time.RFC3339Nano, s); err == nil && t.After(latest) {
+						latest = t
+					}
+				}
+				continue
+			}
+			if t := latestTimestamp(child); t.After(latest) {
+				latest = t
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if t := latestTimestamp(child); t.After(latest) {
+				latest = t
+			}
+		}
+	}
+	return latest
+}
+
+// cacheWriter menahan status dan body sampai ETag selesai dihitung
+type cacheWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *cacheWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *cacheWriter) WriteHeaderNow() {}
+
+func (w *cacheWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *cacheWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *cacheWriter) Status() int {
+	return w.status
+}
+
+func (w *cacheWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *cacheWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+func (w *cacheWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() > 0 {
+		w.ResponseWriter.Write(w.body.Bytes())
+	} else {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gintugas/modules/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newCacheTestRouter(body *string) *gin.Engine {
+	version := 3
+	return newVersionedTestRouter(body, &version)
+}
+
+func newVersionedTestRouter(body *string, version *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/items/1", HTTPCache(CacheContent), func(c *gin.Context) {
+		utils.SetResourceVersion(c, *version)
+		c.String(http.StatusOK, *body)
+	})
+	router.PUT("/items/1", func(c *gin.Context) {
+		if err := utils.CheckIfMatch(c, *version); err != nil {
+			c.Error(err)
+			return
+		}
+		*version++
+		utils.SetResourceVersion(c, *version)
+		c.String(http.StatusOK, *body)
+	})
+	return router
+}
+
+func cacheTestRequest(router *gin.Engine, ifNoneMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestHTTPCacheUsesBodyHashAsETag(t *testing.T) {
+	body := `{"name":"Go"}`
+	router := newCacheTestRouter(&body)
+
+	first := cacheTestRequest(router, "")
+	etag := first.Header().Get("ETag")
+	if !strings.HasPrefix(etag, `"v3-`) || len(etag) <= len(`"v3-"`) {
+		t.Fatalf("ETag = %q, want version and body hash", etag)
+	}
+	if got := first.Header().Get(utils.ResourceVersionHeader); got != "3" {
+		t.Fatalf("%s = %q", utils.ResourceVersionHeader, got)
+	}
+
+	if res := cacheTestRequest(router, etag); res.Code != http.StatusNotModified {
+		t.Fatalf("same body: status = %d, want 304", res.Code)
+	}
+
+	// Body berubah tanpa version naik (misalnya tag ditambah)
+	body = `{"name":"Go","tags":["backend"]}`
+	res := cacheTestRequest(router, etag)
+	if res.Code != http.StatusOK || res.Header().Get("ETag") == etag {
+		t.Fatalf("changed body: status = %d, ETag = %q", res.Code, res.Header().Get("ETag"))
+	}
+}
+
+func TestHTTPCacheIgnoresVersionInIfNoneMatch(t *testing.T) {
+	body := `{"name":"Go"}`
+	router := newCacheTestRouter(&body)
+
+	if res := cacheTestRequest(router, `"v3"`); res.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", res.Code)
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"exact", `"abc"`, true},
+		{"weak form", `W/"abc"`, true},
+		{"list", `"x", "abc"`, true},
+		{"wildcard", "*", true},
+		{"different", `"xyz"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			if got := notModified(req, `"abc"`, time.Time{}); got != tt.want {
+				t.Fatalf("notModified(%q) = %v", tt.ifNoneMatch, got)
+			}
+		})
+	}
+}
+
+func TestETagFromGETSatisfiesIfMatch(t *testing.T) {
+	body := `{"name":"Go"}`
+	version := 3
+	router := newVersionedTestRouter(&body, &version)
+
+	etag := cacheTestRequest(router, "").Header().Get("ETag")
+
+	put := func() int {
+		req := httptest.NewRequest(http.MethodPut, "/items/1", nil)
+		req.Header.Set("If-Match", etag)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	if code := put(); code != http.StatusOK {
+		t.Fatalf("PUT with ETag from GET: status = %d, want 200", code)
+	}
+	// ETag yang sama sudah basi setelah update pertama
+	if code := put(); code != http.StatusPreconditionFailed {
+		t.Fatalf("PUT with stale ETag: status = %d, want 412", code)
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	"gintugas/modules/utils"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// CONTENT LOCALE & TIMEZONE
+// ============================
+// Menentukan bahasa konten untuk setiap request:
+//   1. ?lang=en (diabaikan jika tidak termasuk supported locales)
+//   2. Accept-Language, kecuali untuk admin: form edit admin harus selalu
+//      menerima konten dasar supaya terjemahan tidak tersimpan sebagai
+//      konten dasar. Admin yang ingin melihat terjemahan memakai ?lang=.
+//   3. default locale dari settings
+// Timezone pengunjung diambil dari ?tz= atau header X-Timezone (nama IANA,
+// misalnya Asia/Makassar); nilai yang tidak dikenal diabaikan.
+// Hasilnya disimpan di context dan dikirim sebagai Content-Language.
+
+const TimeZoneHeader = "X-Timezone"
+
+type LocaleSettings func() utils.LocaleConfig
+
+func ContentLocale(settings LocaleSettings) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		config := settings()
+		locale := utils.ContentLocale{
+			Locale:   config.DefaultLocale,
+			Default:  config.DefaultLocale,
+			Source:   utils.LocaleSourceDefault,
+			TimeZone: config.TimeZone,
+		}
+
+		if matched := utils.PreferredLocale(strings.TrimSpace(c.Query("lang")), config.Supported, ""); matched != "" {
+			locale.Locale, locale.Source = matched, utils.LocaleSourceQuery
+		} else if header := c.GetHeader("Accept-Language"); header != "" && !authmiddleware.IsAdmin(c) {
+			if matched := utils.PreferredLocale(header, config.Supported, ""); matched != "" {
+				locale.Locale, locale.Source = matched, utils.LocaleSourceHeader
+			}
+		}
+
+		if location, ok := utils.LoadTimeZone(c.Query("tz")); ok {
+			locale.TimeZone = location
+		} else if location, ok := utils.LoadTimeZone(c.GetHeader(TimeZoneHeader)); ok {
+			locale.TimeZone = location
+		}
+
+		utils.SetContentLocale(c, locale)
+		c.Header("Content-Language", locale.Locale)
+		c.Writer.Header().Add("Vary", "Accept-Language, "+TimeZoneHeader)
+		c.Next()
+	}
+}
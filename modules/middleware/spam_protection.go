@@ -0,0 +1,360 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"gintugas/modules/utils"
+	"io"
+	"log"
+	"math"
+	"mime"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// SPAM PROTECTION
+// ============================
+// Middleware untuk endpoint submit publik (kontak, komentar, testimoni).
+// Urutan pemeriksaan dari yang paling murah:
+//   1. token bucket per IP
+//   2. honeypot (field tersembunyi yang hanya diisi bot)
+//   3. form token bertanda tangan HMAC: form harus diisi minimal MinFillTime
+//   4. jumlah link di semua field teks
+//   5. CAPTCHA (opsional)
+// Body JSON dibaca lalu dikembalikan, jadi handler tetap bisa bind seperti biasa.
+
+const (
+	CodeSpamRejected   = "spam_rejected"
+	CodeFormToken      = "invalid_form_token"
+	CodeTooManyLinks   = "too_many_links"
+	CodeCaptchaFailed  = "captcha_failed"
+	FormTokenHeader    = "X-Form-Token"
+	CaptchaTokenHeader = "X-Captcha-Token"
+
+	maxInspectedBody = 1 << 20
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.|\[url)`)
+
+// Nama field token CAPTCHA bawaan widget Turnstile dan hCaptcha ikut diterima
+var captchaFields = []string{"captcha_token", "cf-turnstile-response", "h-captcha-response"}
+
+type SpamProtectionConfig struct {
+	// Rate dalam token per detik, Burst jumlah submit beruntun yang diizinkan
+	Rate  float64
+	Burst int
+
+	HoneypotFields []string
+
+	// MinFillTime 0 = form token tidak diwajibkan
+	MinFillTime time.Duration
+	MaxFormAge  time.Duration
+	Secret      []byte
+
+	// MaxLinks < 0 = tidak dibatasi
+	MaxLinks int
+
+	Captcha CaptchaVerifier
+}
+
+var (
+	generatedSecretOnce sync.Once
+	generatedSecret     []byte
+)
+
+// SpamProtectionConfigFromEnv membaca FORM_TOKEN_SECRET, SPAM_MIN_FILL_SECONDS,
+// SPAM_MAX_LINKS dan konfigurasi CAPTCHA. Tanpa FORM_TOKEN_SECRET dibuatkan
+// secret acak, sehingga form token lama tidak berlaku setelah restart.
+func SpamProtectionConfigFromEnv() SpamProtectionConfig {
+	config := SpamProtectionConfig{
+		Rate:           5.0 / 600,
+		Burst:          5,
+		HoneypotFields: []string{"website"},
+		MinFillTime:    3 * time.Second,
+		MaxFormAge:     2 * time.Hour,
+		MaxLinks:       2,
+		Captcha:        CaptchaVerifierFromEnv(),
+	}
+
+	if secret := os.Getenv("FORM_TOKEN_SECRET"); secret != "" {
+		config.Secret = []byte(secret)
+	} else {
+		generatedSecretOnce.Do(func() {
+			generatedSecret = make([]byte, 32)
+			if _, err := rand.Read(generatedSecret); err != nil {
+				log.Fatalf("failed to generate form token secret: %v", err)
+			}
+		})
+		config.Secret = generatedSecret
+	}
+
+	if seconds, err := strconv.Atoi(os.Getenv("SPAM_MIN_FILL_SECONDS")); err == nil && seconds >= 0 {
+		config.MinFillTime = time.Duration(seconds) * time.Second
+	}
+	if links, err := strconv.Atoi(os.Getenv("SPAM_MAX_LINKS")); err == nil {
+		config.MaxLinks = links
+	}
+
+	return config
+}
+
+// WithRateLimit mengembalikan salinan config dengan limit submit per jendela
+// waktu, misalnya WithRateLimit(3, 10*time.Minute)
+func (c SpamProtectionConfig) WithRateLimit(limit int, per time.Duration) SpamProtectionConfig {
+	c.Rate = float64(limit) / per.Seconds()
+	c.Burst = limit
+	return c
+}
+
+func SpamProtection(config SpamProtectionConfig) gin.HandlerFunc {
+	limiter := newTokenBucketLimiter(config.Rate, config.Burst)
+
+	return func(c *gin.Context) {
+		if ok, retryAfter := limiter.allow(c.ClientIP(), time.Now()); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			abortWithError(c, utils.LocalizedError(http.StatusTooManyRequests, utils.CodeTooManyRequests, "too_many_submissions"))
+			return
+		}
+
+		fields, err := submittedFields(c)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		// Honeypot terisi: balas seolah berhasil supaya bot tidak belajar
+		for _, name := range config.HoneypotFields {
+			if strings.TrimSpace(fields[name]) != "" {
+				log.Printf("🛡️ [%s] honeypot %q terisi dari %s, submit diabaikan", GetRequestID(c), name, c.ClientIP())
+				c.AbortWithStatusJSON(http.StatusCreated, gin.H{"message": "Submission received"})
+				return
+			}
+		}
+
+		if config.MinFillTime > 0 {
+			token := c.GetHeader(FormTokenHeader)
+			if token == "" {
+				token = fields["form_token"]
+			}
+			if err := verifyFormToken(config, token, time.Now()); err != nil {
+				abortWithError(c, err)
+				return
+			}
+		}
+
+		if config.MaxLinks >= 0 {
+			links := 0
+			for _, value := range fields {
+				links += len(linkPattern.FindAllStringIndex(value, -1))
+			}
+			if links > config.MaxLinks {
+				abortWithError(c, utils.LocalizedError(http.StatusUnprocessableEntity, CodeTooManyLinks, "too_many_links"))
+				return
+			}
+		}
+
+		if config.Captcha != nil {
+			token := c.GetHeader(CaptchaTokenHeader)
+			for _, name := range captchaFields {
+				if token == "" {
+					token = fields[name]
+				}
+			}
+			if err := config.Captcha.Verify(c.Request.Context(), token, c.ClientIP()); err != nil {
+				abortWithError(c, utils.LocalizedError(http.StatusBadRequest, CodeCaptchaFailed, "captcha_failed").WithCause(err))
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+func abortWithError(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// submittedFields mengambil field teks level atas dari body JSON atau form.
+// Body JSON dikembalikan ke request supaya bisa di-bind ulang oleh handler.
+func submittedFields(c *gin.Context) (map[string]string, error) {
+	fields := make(map[string]string)
+
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case gin.MIMEMultipartPOSTForm, gin.MIMEPOSTForm:
+		if err := c.Request.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return nil, utils.BadRequestKey("invalid_form").WithCause(err)
+		}
+		for name, values := range c.Request.PostForm {
+			if len(values) > 0 {
+				fields[name] = values[0]
+			}
+		}
+		return fields, nil
+	}
+
+	if c.Request.Body == nil {
+		return fields, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxInspectedBody+1))
+	if err != nil {
+		return nil, utils.BadRequestKey("read_body_failed").WithCause(err)
+	}
+	if len(body) > maxInspectedBody {
+		return nil, utils.LocalizedError(http.StatusRequestEntityTooLarge, "payload_too_large", "payload_too_large")
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Body yang bukan object JSON dibiarkan; binding di handler yang akan menolaknya
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fields, nil
+	}
+	for name, value := range raw {
+		if text, ok := value.(string); ok {
+			fields[name] = text
+		}
+	}
+	return fields, nil
+}
+
+// ============================
+// FORM TOKEN (signed timestamp)
+// ============================
+// Format: <unix detik>.<base64url HMAC-SHA256(unix detik)>. Frontend mengambil
+// token saat form dirender lalu mengirimnya kembali bersama submit.
+
+func NewFormToken(secret []byte, issuedAt time.Time) string {
+	timestamp := strconv.FormatInt(issuedAt.Unix(), 10)
+	return timestamp + "." + signFormTimestamp(secret, timestamp)
+}
+
+func signFormTimestamp(secret []byte, timestamp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func verifyFormToken(config SpamProtectionConfig, token string, now time.Time) error {
+	timestamp, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signFormTimestamp(config.Secret, timestamp))) {
+		return utils.LocalizedError(http.StatusBadRequest, CodeFormToken, "form_token_invalid")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return utils.LocalizedError(http.StatusBadRequest, CodeFormToken, "form_token_invalid")
+	}
+
+	elapsed := now.Sub(time.Unix(unix, 0))
+	if elapsed < config.MinFillTime {
+		return utils.LocalizedError(http.StatusBadRequest, CodeSpamRejected, "form_too_fast")
+	}
+	if config.MaxFormAge > 0 && elapsed > config.MaxFormAge {
+		return utils.LocalizedError(http.StatusBadRequest, CodeFormToken, "form_token_expired")
+	}
+	return nil
+}
+
+// FormTokenHandler menerbitkan form token untuk GET /forms/token
+func FormTokenHandler(config SpamProtectionConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		now := time.Now()
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Form token issued successfully",
+			"data": gin.H{
+				"form_token":    NewFormToken(config.Secret, now),
+				"min_fill_time": config.MinFillTime.Seconds(),
+				"expires_at":    now.Add(config.MaxFormAge),
+			},
+		})
+	}
+}
+
+// ============================
+// TOKEN BUCKET LIMITER
+// ============================
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type tokenBucketLimiter struct {
+	rate      float64
+	burst     float64
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newTokenBucketLimiter(rate float64, burst int) *tokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucketLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow mengambil satu token untuk key; jika habis, kembalikan perkiraan
+// waktu sampai token berikutnya tersedia
+func (l *tokenBucketLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	if l.rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.updated).Seconds()*l.rate)
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+		return false, wait
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// sweep membuang bucket yang sudah penuh kembali supaya map tidak terus tumbuh
+func (l *tokenBucketLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.updated) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gintugas/modules/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestFakeCaptchaVerifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier FakeCaptchaVerifier
+		token    string
+		wantErr  error
+		ok       bool
+	}{
+		{"default token", FakeCaptchaVerifier{}, "pass", nil, true},
+		{"custom token", FakeCaptchaVerifier{ValidToken: "secret"}, "secret", nil, true},
+		{"default token rejected when custom set", FakeCaptchaVerifier{ValidToken: "secret"}, "pass", nil, false},
+		{"missing token", FakeCaptchaVerifier{}, "", ErrCaptchaMissing, false},
+		{"wrong token", FakeCaptchaVerifier{}, "nope", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.verifier.Verify(context.Background(), tt.token, "203.0.113.1")
+			if tt.ok != (err == nil) {
+				t.Fatalf("Verify(%q) error = %v, want ok = %v", tt.token, err, tt.ok)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify(%q) error = %v, want %v", tt.token, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// 3 submit per menit
+	limiter := newTokenBucketLimiter(3.0/60, 3)
+	for i := 0; i < 3; i++ {
+		if ok, _ := limiter.allow("203.0.113.1", start); !ok {
+			t.Fatalf("request %d within burst was rejected", i+1)
+		}
+	}
+
+	ok, retryAfter := limiter.allow("203.0.113.1", start)
+	if ok {
+		t.Fatal("request over burst was allowed")
+	}
+	if retryAfter <= 0 || retryAfter > 20*time.Second {
+		t.Fatalf("retryAfter = %v, want (0, 20s]", retryAfter)
+	}
+
+	if ok, _ := limiter.allow("203.0.113.2", start); !ok {
+		t.Fatal("other IP must have its own bucket")
+	}
+	if ok, _ := limiter.allow("203.0.113.1", start.Add(retryAfter)); !ok {
+		t.Fatal("token should be refilled after retryAfter")
+	}
+}
+
+func TestTokenBucketLimiterDisabled(t *testing.T) {
+	limiter := newTokenBucketLimiter(0, 1)
+	for i := 0; i < 10; i++ {
+		if ok, _ := limiter.allow("203.0.113.1", time.Now()); !ok {
+			t.Fatal("rate 0 must not limit")
+		}
+	}
+}
+
+func TestVerifyFormToken(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	config := SpamProtectionConfig{
+		Secret:      []byte("test-secret"),
+		MinFillTime: 3 * time.Second,
+		MaxFormAge:  time.Hour,
+	}
+	valid := NewFormToken(config.Secret, now.Add(-10*time.Second))
+	timestamp, _, _ := strings.Cut(valid, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		code  string
+	}{
+		{"valid", valid, ""},
+		{"empty", "", CodeFormToken},
+		{"no signature", timestamp, CodeFormToken},
+		{"tampered timestamp", "1" + valid, CodeFormToken},
+		{"other secret", NewFormToken([]byte("other"), now.Add(-10*time.Second)), CodeFormToken},
+		{"too fast", NewFormToken(config.Secret, now.Add(-time.Second)), CodeSpamRejected},
+		{"expired", NewFormToken(config.Secret, now.Add(-2*time.Hour)), CodeFormToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyFormToken(config, tt.token, now)
+			if tt.code == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var appErr *utils.AppError
+			if !errors.As(err, &appErr) || appErr.Code != tt.code {
+				t.Fatalf("err = %v, want code %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func newSpamTestRouter(config SpamProtectionConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	_ = router.SetTrustedProxies(nil)
+	router.Use(ErrorHandler())
+	router.POST("/contact", SpamProtection(config), func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"message": "ok"})
+	})
+	return router
+}
+
+func postSpamTest(router *gin.Engine, body string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(body))
+	req.RemoteAddr = "198.51.100.7:4321"
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range header {
+		req.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestSpamProtectionIgnoresSpoofedForwardedFor(t *testing.T) {
+	router := newSpamTestRouter(SpamProtectionConfig{Rate: 1.0 / 60, Burst: 1, MaxLinks: -1})
+
+	if res := postSpamTest(router, `{"name":"a"}`, map[string]string{"X-Forwarded-For": "203.0.113.1"}); res.Code != http.StatusCreated {
+		t.Fatalf("first submit: status = %d", res.Code)
+	}
+	res := postSpamTest(router, `{"name":"a"}`, map[string]string{"X-Forwarded-For": "203.0.113.2"})
+	if res.Code != http.StatusTooManyRequests {
+		t.Fatalf("spoofed X-Forwarded-For: status = %d, want 429", res.Code)
+	}
+	if res.Header().Get("Retry-After") == "" {
+		t.Fatal("missing Retry-After")
+	}
+}
+
+func TestSpamProtectionChecks(t *testing.T) {
+	config := SpamProtectionConfig{
+		HoneypotFields: []string{"website"},
+		MaxLinks:       1,
+		Captcha:        FakeCaptchaVerifier{},
+	}
+	router := newSpamTestRouter(config)
+
+	tests := []struct {
+		name   string
+		body   string
+		header map[string]string
+		status int
+	}{
+		{"clean", `{"message":"halo","captcha_token":"pass"}`, nil, http.StatusCreated},
+		{"captcha via header", `{"message":"halo"}`, map[string]string{CaptchaTokenHeader: "pass"}, http.StatusCreated},
+		{"honeypot looks successful", `{"message":"halo","website":"http://spam"}`, nil, http.StatusCreated},
+		{"too many links", `{"message":"https://a.example www.b.example","captcha_token":"pass"}`, nil, http.StatusUnprocessableEntity},
+		{"missing captcha", `{"message":"halo"}`, nil, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := postSpamTest(router, tt.body, tt.header); res.Code != tt.status {
+				t.Fatalf("status = %d, want %d (%s)", res.Code, tt.status, res.Body.String())
+			}
+		})
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	handlers "gintugas/modules/ServiceRoute"
 	serviceroute "gintugas/modules/ServiceRoute"
+	authmiddleware "gintugas/modules/components/Auth/middleware"
+	middlewarerole "gintugas/modules/components/Auth/middleware/middlewarerole"
 	projectRPO "gintugas/modules/components/Project/repository"
 	repositoryprojek "gintugas/modules/components/Project/repository"
 	projectServsc "gintugas/modules/components/Project/service"
 	"gintugas/modules/components/experiences/repo"
 	"gintugas/modules/components/experiences/service"
+	httpmiddleware "gintugas/modules/middleware"
 	"gintugas/modules/utils"
 	"log"
 	"os"
@@ -28,15 +31,25 @@ import (
 )
 
 func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
+	// ClientIP dipakai rate limit dan hash visitor, jadi X-Forwarded-For
+	// hanya dipercaya dari proxy/platform yang dikonfigurasi
+	configureTrustedProxies(router)
+
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Accept", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length", "Content-Disposition"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Accept", "X-Requested-With", "If-Match", "If-None-Match", "If-Modified-Since", "X-Preview-Token", "X-Request-ID", "X-Form-Token", "X-Captcha-Token", "X-Timezone"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Disposition", "Content-Language", "ETag", "Last-Modified", "X-Request-ID", "X-Resource-Version"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// Validator custom (httpurl, slug, year, ...) untuk tag binding DTO
+	utils.RegisterValidators()
+
+	// Semua error handler dirender sebagai problem+json dengan request ID
+	router.Use(httpmiddleware.RequestID(), httpmiddleware.ErrorHandler())
+
 	// ⭐ CEK DATABASE STATUS
 	dbAvailable := db != nil && gormDB != nil
 	fmt.Printf("\n🔍 Database Status: available=%v\n", dbAvailable)
@@ -83,6 +96,7 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 	// API ROUTES
 	// ============================
 	api := router.Group("/api")
+	api.Use(authmiddleware.OptionalAuth())
 	{
 		// Health check
 		api.GET("/health", func(c *gin.Context) {
@@ -117,13 +131,40 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 
 		// PROJECT SERVICES
 		projectRepo := projectRPO.NewRepository(db)
+		blogRepo := portfolioRepo.NewBlogRepository(gormDB)
+
+		// Read cache in-process untuk data portfolio yang jarang berubah
+		readCacheConfig := portfolioRepo.ReadCacheConfigFromEnv()
+		readCacheEnabled := os.Getenv("READ_CACHE_DISABLED") != "true"
+
+		settingRepo := portfolioRepo.NewSettingRepository(gormDB)
+		if readCacheEnabled {
+			settingRepo = portfolioRepo.NewCachedSettingRepository(settingRepo, readCacheConfig)
+		}
+		settingsReader := portfolioService.NewSettingsReader(settingRepo)
+
+		// CONTENT TRANSLATIONS (i18n): locale di-resolve untuk semua route
+		// setelah ini, terjemahan diterapkan oleh service konten
+		translationRepo := portfolioRepo.NewTranslationRepository(gormDB)
+		if readCacheEnabled {
+			translationRepo = portfolioRepo.NewCachedTranslationRepository(translationRepo, readCacheConfig)
+		}
+		translationService := portfolioService.NewTranslationService(translationRepo, settingsReader)
+		translationHandler := handlers.NewTranslationHandler(translationService)
+		api.Use(httpmiddleware.ContentLocale(settingsReader.LocaleConfig))
+
+		// PREVIEW TOKEN SERVICES (draft blog post & project)
+		previewRepo := portfolioRepo.NewPreviewTokenRepository(gormDB)
+		previewService := portfolioService.NewPreviewTokenService(previewRepo, blogRepo, projectRepo)
+		previewHandler := handlers.NewPreviewTokenHandler(previewService)
+
 		var projectService projectServsc.Service
 		if uploadProvider == "supabase" && supabaseUploadService != nil {
 			supabaseWrapper := projectServsc.NewSupabaseUploadWrapper(supabaseUploadService)
-			projectService = projectServsc.NewServiceWithUpload(projectRepo, supabaseWrapper, "projects")
+			projectService = projectServsc.NewServiceWithUpload(projectRepo, supabaseWrapper, "projects", previewService, translationService)
 		} else {
 			localPath := filepath.Join(uploadBasePath, "projects")
-			projectService = projectServsc.NewService(projectRepo, localPath)
+			projectService = projectServsc.NewService(projectRepo, localPath, previewService, translationService)
 		}
 		projectHandler := handlers.NewProjectHandler(projectService)
 
@@ -136,11 +177,14 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 
 		// EXPERIENCE SERVICES
 		expeRepo := repo.NewExpeGormRepository(gormDB)
-		expeService := service.NewExpeService(expeRepo)
+		expeService := service.NewExpeService(expeRepo, translationService)
 		expeHandler := serviceroute.NewGormExpeHandler(expeService)
 
 		// PORTFOLIO SERVICES
 		skillRepo := portfolioRepo.NewSkillRepository(gormDB)
+		if readCacheEnabled {
+			skillRepo = portfolioRepo.NewCachedSkillRepository(skillRepo, readCacheConfig)
+		}
 		var skillService portfolioService.SkillService
 		if uploadProvider == "supabase" && supabaseUploadService != nil {
 			supabaseWrapper := portfolioService.NewSupabaseUploadWrapper(supabaseUploadService)
@@ -152,6 +196,9 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		skillHandler := handlers.NewSkillHandler(skillService)
 
 		certRepo := portfolioRepo.NewCertificateRepository(gormDB)
+		if readCacheEnabled {
+			certRepo = portfolioRepo.NewCachedCertificateRepository(certRepo, readCacheConfig)
+		}
 		var certService portfolioService.CertificateService
 		if uploadProvider == "supabase" && supabaseUploadService != nil {
 			supabaseWrapper := portfolioService.NewSupabaseUploadWrapper(supabaseUploadService)
@@ -163,29 +210,123 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		certHandler := handlers.NewCertificateHandler(certService)
 
 		eduRepo := portfolioRepo.NewEducationRepository(gormDB)
-		eduService := portfolioService.NewEducationService(eduRepo)
+		if readCacheEnabled {
+			eduRepo = portfolioRepo.NewCachedEducationRepository(eduRepo, readCacheConfig)
+		}
+		eduService := portfolioService.NewEducationService(eduRepo, translationService)
 		eduHandler := handlers.NewEducationHandler(eduService)
 
 		testRepo := portfolioRepo.NewTestimonialRepository(gormDB)
-		testService := portfolioService.NewTestimonialService(testRepo)
+		if readCacheEnabled {
+			testRepo = portfolioRepo.NewCachedTestimonialRepository(testRepo, readCacheConfig)
+		}
+		testInviteRepo := portfolioRepo.NewTestimonialInviteRepository(gormDB)
+		var testService portfolioService.TestimonialService
+		if uploadProvider == "supabase" && supabaseUploadService != nil {
+			supabaseWrapper := portfolioService.NewSupabaseUploadWrapper(supabaseUploadService)
+			testService = portfolioService.NewTestimonialServiceWithUpload(testRepo, testInviteRepo, projectRepo, supabaseWrapper, "testimonials")
+		} else {
+			localPath := filepath.Join(uploadBasePath, "testimonials")
+			testService = portfolioService.NewTestimonialService(testRepo, testInviteRepo, projectRepo, localPath)
+		}
 		testHandler := handlers.NewTestimonialHandler(testService)
 
-		blogRepo := portfolioRepo.NewBlogRepository(gormDB)
-		blogService := portfolioService.NewBlogService(blogRepo)
+		blogViewRepo := portfolioRepo.NewBlogViewRepository(gormDB)
+		blogViewTracker := portfolioService.NewBlogViewTracker(blogViewRepo)
+		blogSeriesRepo := portfolioRepo.NewBlogSeriesRepository(gormDB)
+		blogService := portfolioService.NewBlogService(blogRepo, blogSeriesRepo, blogViewTracker, previewService, translationService)
 		blogHandler := handlers.NewBlogHandler(blogService)
 
+		blogSeriesService := portfolioService.NewBlogSeriesService(blogSeriesRepo, blogRepo)
+		blogSeriesHandler := handlers.NewBlogSeriesHandler(blogSeriesService)
+
+		blogCommentRepo := portfolioRepo.NewBlogCommentRepository(gormDB)
+		blogCommentService := portfolioService.NewBlogCommentService(blogCommentRepo, blogRepo)
+		blogCommentHandler := handlers.NewBlogCommentHandler(blogCommentService)
+
 		sectionRepo := portfolioRepo.NewSectionRepository(gormDB)
-		sectionService := portfolioService.NewSectionService(sectionRepo)
+		if readCacheEnabled {
+			sectionRepo = portfolioRepo.NewCachedSectionRepository(sectionRepo, readCacheConfig)
+		}
+		sectionService := portfolioService.NewSectionService(sectionRepo, translationService)
 		sectionHandler := handlers.NewSectionHandler(sectionService)
 
 		socialLinkRepo := portfolioRepo.NewSocialLinkRepository(gormDB)
+		if readCacheEnabled {
+			socialLinkRepo = portfolioRepo.NewCachedSocialLinkRepository(socialLinkRepo, readCacheConfig)
+		}
 		socialLinkService := portfolioService.NewSocialLinkService(socialLinkRepo)
 		socialLinkHandler := handlers.NewSocialLinkHandler(socialLinkService)
 
-		settingRepo := portfolioRepo.NewSettingRepository(gormDB)
 		settingService := portfolioService.NewSettingService(settingRepo)
 		settingHandler := handlers.NewSettingHandler(settingService)
 
+		contactRepo := portfolioRepo.NewContactMessageRepository(gormDB)
+		contactService := portfolioService.NewContactService(contactRepo, portfolioService.NewContactNotifierFromEnv())
+		contactHandler := handlers.NewContactHandler(contactService)
+
+		searchRepo := portfolioRepo.NewSearchRepository(gormDB)
+		searchService := portfolioService.NewSearchService(searchRepo)
+		searchHandler := handlers.NewSearchHandler(searchService)
+
+		snapshotService := portfolioService.NewPortfolioSnapshotService(portfolioService.PortfolioSnapshotRepos{
+			Sections:     sectionRepo,
+			Projects:     projectRepo,
+			Experiences:  expeRepo,
+			Skills:       skillRepo,
+			Education:    eduRepo,
+			Certificates: certRepo,
+			Testimonials: testRepo,
+			Blog:         blogRepo,
+			SocialLinks:  socialLinkRepo,
+			Settings:     settingRepo,
+		}, translationService)
+		snapshotHandler := handlers.NewPortfolioSnapshotHandler(snapshotService)
+
+		resumeService := portfolioService.NewResumeService(portfolioService.ResumeRepos{
+			Experiences:  expeRepo,
+			Education:    eduRepo,
+			Skills:       skillRepo,
+			Certificates: certRepo,
+			SocialLinks:  socialLinkRepo,
+			Settings:     settingRepo,
+			Transaction:  resumeTransaction(gormDB),
+		}, translationService)
+		resumeHandler := handlers.NewResumeHandler(resumeService)
+
+		reorderService := portfolioService.NewReorderService(portfolioService.ReorderRepos{
+			Projects:     projectRepo,
+			Skills:       skillRepo,
+			Certificates: certRepo,
+			Education:    eduRepo,
+			Experiences:  expeRepo,
+			Testimonials: testRepo,
+			Sections:     sectionRepo,
+			SocialLinks:  socialLinkRepo,
+		})
+		reorderHandler := handlers.NewReorderHandler(reorderService)
+
+		// ============================
+		// HTTP CACHE POLICIES (bisa di-override lewat env)
+		// ============================
+		cacheStatic := httpmiddleware.HTTPCache(httpmiddleware.CachePolicyFromEnv("CACHE_POLICY_STATIC", httpmiddleware.CacheStatic))
+		cacheContent := httpmiddleware.HTTPCache(httpmiddleware.CachePolicyFromEnv("CACHE_POLICY_CONTENT", httpmiddleware.CacheContent))
+		cacheRevalidate := httpmiddleware.HTTPCache(httpmiddleware.CachePolicyFromEnv("CACHE_POLICY_REVALIDATE", httpmiddleware.CacheRevalidate))
+
+		// ============================
+		// ADMIN GUARD (JWT wajib + role admin)
+		// ============================
+		requireAuth := authmiddleware.AuthMiddleware()
+		requireAdmin := middlewarerole.RequireRole("admin")
+
+		// ============================
+		// SPAM PROTECTION (endpoint submit publik)
+		// ============================
+		spamConfig := httpmiddleware.SpamProtectionConfigFromEnv()
+		commentGuard := httpmiddleware.SpamProtection(spamConfig.WithRateLimit(5, 10*time.Minute))
+		contactGuard := httpmiddleware.SpamProtection(spamConfig.WithRateLimit(3, 10*time.Minute))
+		testimonialGuard := httpmiddleware.SpamProtection(spamConfig.WithRateLimit(3, time.Hour))
+
 		// ============================
 		// REGISTER ALL ROUTES
 		// ============================
@@ -193,10 +334,13 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		// PROJECT ROUTES
 		projectRoutes := api.Group("/v1/projects")
 		{
-			projectRoutes.GET("", projectHandler.GetAllProjects)
-			projectRoutes.GET("/:id", projectHandler.GetProject)
+			projectRoutes.GET("", cacheContent, projectHandler.GetAllProjects)
+			projectRoutes.GET("/:id", cacheContent, projectHandler.GetProject)
+			projectRoutes.GET("/:id/testimonials", cacheContent, testHandler.GetByProject)
 			projectRoutes.POST("/with-image", projectHandler.CreateProjectWithImage)
+			projectRoutes.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("projects"))
 			projectRoutes.PUT("/:id", projectHandler.UpdateProject)
+			projectRoutes.PATCH("/:id", requireAuth, requireAdmin, projectHandler.PatchProject)
 			projectRoutes.DELETE("/:id", projectHandler.DeleteProject)
 		}
 
@@ -204,23 +348,32 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		{
 			projects.POST("/:project_id/tags", memberService.AddTag)
 			projects.DELETE("/:project_id/tags/:tag_id", memberService.RemoveTag)
-			projects.GET("/:project_id/tags", memberService.GetProjectTags)
+			projects.GET("/:project_id/tags", cacheContent, memberService.GetProjectTags)
+		}
+
+		previews := api.Group("/v1/previews", requireAuth, requireAdmin)
+		{
+			previews.POST("", previewHandler.Create)
+			previews.GET("", previewHandler.GetAll)
+			previews.DELETE("/:token_id", previewHandler.Revoke)
 		}
 
 		tags := api.Group("/v1/tags")
 		{
 			tags.POST("", tagsHandler.CreateTags)
-			tags.GET("", projectHandler.GetAllTags)
+			tags.GET("", cacheStatic, projectHandler.GetAllTags)
 		}
 
 		// EXPERIENCE ROUTES
 		expeRoutes := api.Group("/v1")
 		{
 			expeRoutes.POST("/experiences/with-relations", expeHandler.CreateExperiencesWithRelations)
-			expeRoutes.GET("/experiences/with-relations", expeHandler.GetAllExperiencesWithRelations)
-			expeRoutes.GET("/experiences/with-relations/:id", expeHandler.GetExperiencesByIDWithRelations)
+			expeRoutes.GET("/experiences/with-relations", cacheContent, expeHandler.GetAllExperiencesWithRelations)
+			expeRoutes.GET("/experiences/with-relations/:id", cacheContent, expeHandler.GetExperiencesByIDWithRelations)
 			expeRoutes.PUT("/experiences/with-relations/:id", expeHandler.UpdateExperiencesWithRelations)
+			expeRoutes.PATCH("/experiences/with-relations/:id", requireAuth, requireAdmin, expeHandler.PatchExperiencesWithRelations)
 			expeRoutes.DELETE("/experiences/with-relations/:id", expeHandler.DeleteExperiencesWithRelations)
+			expeRoutes.POST("/experiences/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("experiences"))
 		}
 
 		// PORTFOLIO ROUTES
@@ -230,12 +383,14 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		{
 			skills.POST("", skillHandler.Create)
 			skills.POST("/with-icon", skillHandler.CreateWithIcon)
+			skills.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("skills"))
 			skills.PUT("/:id/with-icon", skillHandler.UpdateWithIcon)
-			skills.GET("", skillHandler.GetAll)
-			skills.GET("/featured", skillHandler.GetFeatured)
-			skills.GET("/category/:category", skillHandler.GetByCategory)
-			skills.GET("/:id", skillHandler.GetByID)
+			skills.GET("", cacheContent, skillHandler.GetAll)
+			skills.GET("/featured", cacheContent, skillHandler.GetFeatured)
+			skills.GET("/category/:category", cacheContent, skillHandler.GetByCategory)
+			skills.GET("/:id", cacheContent, skillHandler.GetByID)
 			skills.PUT("/:id", skillHandler.Update)
+			skills.PATCH("/:id", requireAuth, requireAdmin, skillHandler.Patch)
 			skills.DELETE("/:id", skillHandler.Delete)
 		}
 
@@ -243,64 +398,149 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 		{
 			certificates.POST("", certHandler.Create)
 			certificates.POST("/with-image", certHandler.CreateWithImage)
-			certificates.GET("", certHandler.GetAll)
-			certificates.GET("/:id", certHandler.GetByID)
+			certificates.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("certificates"))
+			certificates.GET("", cacheContent, certHandler.GetAll)
+			certificates.GET("/:id", cacheContent, certHandler.GetByID)
 			certificates.PUT("/:id", certHandler.Update)
+			certificates.PATCH("/:id", requireAuth, requireAdmin, certHandler.Patch)
 			certificates.DELETE("/:id", certHandler.Delete)
 		}
 
 		education := v1.Group("/education")
 		{
 			education.POST("", eduHandler.CreateWithAchievements)
-			education.GET("", eduHandler.GetAllWithAchievements)
-			education.GET("/:id", eduHandler.GetByIDWithAchievements)
+			education.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("education"))
+			education.GET("", cacheContent, eduHandler.GetAllWithAchievements)
+			education.GET("/:id", cacheContent, eduHandler.GetByIDWithAchievements)
 			education.PUT("/:id", eduHandler.UpdateWithAchievements)
+			education.PATCH("/:id", requireAuth, requireAdmin, eduHandler.PatchWithAchievements)
 			education.DELETE("/:id", eduHandler.DeleteWithAchievements)
 		}
 
 		testimonials := v1.Group("/testimonials")
 		{
-			testimonials.POST("", testHandler.Create)
-			testimonials.GET("", testHandler.GetAll)
-			testimonials.GET("/featured", testHandler.GetFeatured)
-			testimonials.GET("/status/:status", testHandler.GetByStatus)
-			testimonials.GET("/:id", testHandler.GetByID)
-			testimonials.PUT("/:id", testHandler.Update)
-			testimonials.DELETE("/:id", testHandler.Delete)
+			testimonials.POST("", requireAuth, requireAdmin, testHandler.Create)
+			testimonials.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("testimonials"))
+			testimonials.POST("/submit", testimonialGuard, testHandler.Submit)
+			testimonials.PUT("/:id/status", requireAuth, requireAdmin, testHandler.Moderate)
+			testimonials.POST("/invites", requireAuth, requireAdmin, testHandler.CreateInvite)
+			testimonials.GET("/invites", requireAuth, requireAdmin, testHandler.GetInvites)
+			testimonials.DELETE("/invites/:invite_id", requireAuth, requireAdmin, testHandler.RevokeInvite)
+			testimonials.GET("/invites/:token", testHandler.GetInvitePrefill)
+			testimonials.POST("/invites/:token/submit", testHandler.SubmitWithInvite)
+			testimonials.GET("", cacheContent, testHandler.GetAll)
+			testimonials.GET("/featured", cacheContent, testHandler.GetFeatured)
+			testimonials.GET("/status/:status", requireAuth, requireAdmin, testHandler.GetByStatus)
+			testimonials.GET("/:id", cacheContent, testHandler.GetByID)
+			testimonials.PUT("/:id", requireAuth, requireAdmin, testHandler.Update)
+			testimonials.PATCH("/:id", requireAuth, requireAdmin, testHandler.Patch)
+			testimonials.DELETE("/:id", requireAuth, requireAdmin, testHandler.Delete)
 		}
 
 		blog := v1.Group("/blog")
 		{
 			blog.POST("", blogHandler.CreateWithTags)
-			blog.GET("", blogHandler.GetAllWithTags)
-			blog.GET("/published", blogHandler.GetPublishedWithTags)
-			blog.GET("/tags", blogHandler.GetAllTags)
-			blog.GET("/:id", blogHandler.GetByIDWithTags)
-			blog.GET("/slug/:slug", blogHandler.GetBySlugWithTags)
+			blog.GET("", cacheContent, blogHandler.GetAllWithTags)
+			blog.GET("/published", cacheContent, blogHandler.GetPublishedWithTags)
+			blog.GET("/tags", cacheContent, blogHandler.GetAllTags)
+			blog.GET("/tags/:name", cacheContent, blogHandler.GetPublishedByTag)
+			blog.POST("/tags/merge", requireAuth, requireAdmin, blogHandler.MergeTags)
+			blog.PUT("/tags/:tag_id", requireAuth, requireAdmin, blogHandler.RenameTag)
+			blog.DELETE("/tags/:tag_id", requireAuth, requireAdmin, blogHandler.DeleteTag)
+			blog.GET("/:id", cacheRevalidate, blogHandler.GetByIDWithTags)
+			blog.GET("/:id/views", requireAuth, requireAdmin, blogHandler.GetDailyViews)
+			blog.GET("/:id/comments", cacheRevalidate, blogCommentHandler.GetApprovedByPost)
+			blog.POST("/:id/comments", commentGuard, blogCommentHandler.Create)
+			blog.GET("/comments/status/:status", requireAuth, requireAdmin, blogCommentHandler.GetByStatus)
+			blog.PUT("/comments/:comment_id/status", requireAuth, requireAdmin, blogCommentHandler.UpdateStatus)
+			blog.DELETE("/comments/:comment_id", requireAuth, requireAdmin, blogCommentHandler.Delete)
+			blog.POST("/series", requireAuth, requireAdmin, blogSeriesHandler.Create)
+			blog.GET("/series", cacheContent, blogSeriesHandler.GetAll)
+			blog.GET("/series/:series_id", cacheContent, blogSeriesHandler.GetByID)
+			blog.PUT("/series/:series_id", requireAuth, requireAdmin, blogSeriesHandler.Update)
+			blog.PATCH("/series/:series_id", requireAuth, requireAdmin, blogSeriesHandler.Patch)
+			blog.PUT("/series/:series_id/posts", requireAuth, requireAdmin, blogSeriesHandler.SetPosts)
+			blog.DELETE("/series/:series_id", requireAuth, requireAdmin, blogSeriesHandler.Delete)
+			blog.GET("/slug/:slug", cacheRevalidate, blogHandler.GetBySlugWithTags)
 			blog.PUT("/:id", blogHandler.UpdateWithTags)
+			blog.PATCH("/:id", requireAuth, requireAdmin, blogHandler.PatchWithTags)
 			blog.DELETE("/:id", blogHandler.DeleteWithTags)
 		}
 
 		sections := v1.Group("/sections")
 		{
 			sections.POST("", sectionHandler.Create)
-			sections.GET("", sectionHandler.GetAll)
+			sections.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("sections"))
+			sections.GET("", cacheStatic, sectionHandler.GetAll)
+			sections.GET("/:id", cacheStatic, sectionHandler.GetByID)
+			sections.PUT("/:id", sectionHandler.Update)
+			sections.PATCH("/:id", requireAuth, requireAdmin, sectionHandler.Patch)
+			sections.PUT("/:id/active", requireAuth, requireAdmin, sectionHandler.SetActive)
 			sections.DELETE("/:id", sectionHandler.Delete)
 		}
 
 		socialLinks := v1.Group("/social-links")
 		{
 			socialLinks.POST("", socialLinkHandler.Create)
-			socialLinks.GET("", socialLinkHandler.GetAll)
+			socialLinks.POST("/reorder", requireAuth, requireAdmin, reorderHandler.Reorder("social-links"))
+			socialLinks.GET("", cacheStatic, socialLinkHandler.GetAll)
+			socialLinks.GET("/:id", cacheStatic, socialLinkHandler.GetByID)
+			socialLinks.PUT("/:id", socialLinkHandler.Update)
+			socialLinks.PATCH("/:id", requireAuth, requireAdmin, socialLinkHandler.Patch)
+			socialLinks.PUT("/:id/active", requireAuth, requireAdmin, socialLinkHandler.SetActive)
 			socialLinks.DELETE("/:id", socialLinkHandler.Delete)
 		}
 
 		settings := v1.Group("/settings")
 		{
-			settings.POST("", settingHandler.Create)
-			settings.GET("", settingHandler.GetAll)
-			settings.DELETE("/:id", settingHandler.Delete)
+			settings.POST("", requireAuth, requireAdmin, settingHandler.Create)
+			settings.GET("", cacheStatic, settingHandler.GetAll)
+			settings.GET("/:key", cacheStatic, settingHandler.GetByKey)
+			settings.PUT("/:key", requireAuth, requireAdmin, settingHandler.Upsert)
+			settings.DELETE("/:key", requireAuth, requireAdmin, settingHandler.Delete)
 		}
+
+		// CONTENT TRANSLATIONS (admin)
+		translations := v1.Group("/translations", requireAuth, requireAdmin)
+		{
+			translations.GET("", translationHandler.GetAll)
+			translations.GET("/missing", translationHandler.GetMissing)
+			translations.GET("/:entity_type/:entity_id/:locale", translationHandler.GetEntity)
+			translations.PUT("/:entity_type/:entity_id/:locale", translationHandler.Upsert)
+			translations.DELETE("/:entity_type/:entity_id/:locale", translationHandler.Delete)
+		}
+
+		// FORM TOKEN (signed timestamp untuk minimum waktu isi form)
+		v1.GET("/forms/token", httpmiddleware.FormTokenHandler(spamConfig))
+
+		// CONTACT ROUTES (form publik + inbox admin)
+		v1.POST("/contact", contactGuard, contactHandler.Submit)
+		contact := v1.Group("/contact/messages", requireAuth, requireAdmin)
+		{
+			contact.GET("", contactHandler.GetAll)
+			contact.GET("/counts", contactHandler.CountByStatus)
+			contact.GET("/:message_id", contactHandler.GetByID)
+			contact.PUT("/:message_id/status", contactHandler.UpdateStatus)
+			contact.POST("/:message_id/reply", contactHandler.Reply)
+			contact.DELETE("/:message_id", contactHandler.Delete)
+		}
+
+		// SEARCH ROUTES
+		v1.GET("/search", cacheContent, searchHandler.Search)
+
+		// PORTFOLIO SNAPSHOT (semua section aktif dalam satu request)
+		v1.GET("/portfolio", cacheContent, snapshotHandler.GetSnapshot)
+
+		// RESUME (di-generate dari data portfolio)
+		v1.GET("/resume.pdf", cacheContent, resumeHandler.GetPDF)
+		v1.GET("/resume.md", cacheContent, resumeHandler.GetMarkdown)
+		v1.GET("/resume.html", cacheContent, resumeHandler.GetHTML)
+		v1.GET("/resume.json", cacheContent, resumeHandler.GetJSON)
+		v1.GET("/resume/templates", cacheStatic, resumeHandler.GetTemplates)
+		v1.POST("/resume/import", requireAuth, requireAdmin, resumeHandler.Import) // (admin) JSON Resume, ?dry_run=true
+
+		// READ CACHE METRICS (hit/miss per repository)
+		v1.GET("/cache/stats", requireAuth, requireAdmin, handlers.GetReadCacheStats)
 	}
 
 	// SERVE STATIC FILES (Development only)
@@ -312,6 +552,60 @@ func Initiator(router *gin.Engine, db *sql.DB, gormDB *gorm.DB) {
 	}
 }
 
+// resumeTransaction membuat repo resume di atas satu transaksi gorm. Repo
+// transaksi tidak lewat read cache, jadi semua cache dikosongkan sesudahnya.
+func resumeTransaction(gormDB *gorm.DB) func(func(portfolioService.ResumeRepos) error) error {
+	return func(fn func(portfolioService.ResumeRepos) error) error {
+		defer portfolioRepo.InvalidateReadCaches()
+
+		return gormDB.Transaction(func(tx *gorm.DB) error {
+			return fn(portfolioService.ResumeRepos{
+				Experiences:  repo.NewExpeGormRepository(tx),
+				Education:    portfolioRepo.NewEducationRepository(tx),
+				Skills:       portfolioRepo.NewSkillRepository(tx),
+				Certificates: portfolioRepo.NewCertificateRepository(tx),
+				SocialLinks:  portfolioRepo.NewSocialLinkRepository(tx),
+				Settings:     portfolioRepo.NewSettingRepository(tx),
+			})
+		})
+	}
+}
+
+// configureTrustedProxies membaca TRUSTED_PLATFORM (cloudflare, vercel,
+// google, atau nama header seperti X-Real-IP) dan TRUSTED_PROXIES (daftar
+// IP/CIDR dipisah koma). Tanpa keduanya tidak ada proxy yang dipercaya dan
+// ClientIP selalu RemoteAddr; di Vercel (env VERCEL=1) default-nya header
+// X-Real-IP yang di-set edge Vercel.
+func configureTrustedProxies(router *gin.Engine) {
+	platform := strings.TrimSpace(os.Getenv("TRUSTED_PLATFORM"))
+	if platform == "" && os.Getenv("VERCEL") == "1" {
+		platform = "vercel"
+	}
+	switch strings.ToLower(platform) {
+	case "":
+	case "cloudflare":
+		router.TrustedPlatform = gin.PlatformCloudflare
+	case "google":
+		router.TrustedPlatform = gin.PlatformGoogleAppEngine
+	case "vercel":
+		router.TrustedPlatform = "X-Real-IP"
+	default:
+		router.TrustedPlatform = platform
+	}
+
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	if err := router.SetTrustedProxies(proxies); err != nil {
+		fmt.Printf("⚠️ Warning: TRUSTED_PROXIES tidak valid (%v), tidak ada proxy yang dipercaya\n", err)
+		_ = router.SetTrustedProxies(nil)
+	}
+	log.Printf("🛡️ Trusted platform: %q, trusted proxies: %v", router.TrustedPlatform, proxies)
+}
+
 func getUploadPath() string {
 	if os.Getenv("GIN_MODE") == "release" {
 		if path := os.Getenv("UPLOAD_PATH"); path != "" {
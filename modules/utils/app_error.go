@@ -0,0 +1,283 @@
+package utils
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// ============================
+// APP ERROR
+// ============================
+// Error aplikasi yang dibawa dari service/repository sampai ke middleware
+// ErrorHandler, lalu dirender sebagai RFC 7807 problem+json. Code dipakai
+// client (machine-readable), Message aman ditampilkan ke user, Cause hanya
+// untuk log dan tidak pernah dikirim ke client.
+//
+// Pesan yang dibuat lewat katalog (LocalizedError, BadRequestKey, NotFound,
+// dst.) menyimpan key + parameter sehingga ErrorHandler bisa merender ulang
+// dalam bahasa request (id/en); Message selalu berisi versi default (en).
+
+const (
+	CodeBadRequest      = "bad_request"
+	CodeInvalidID       = "invalid_id"
+	CodeValidation      = "validation_failed"
+	CodeUnauthorized    = "unauthorized"
+	CodeForbidden       = "forbidden"
+	CodeNotFound        = "not_found"
+	CodeConflict        = "conflict"
+	CodeTooManyRequests = "too_many_requests"
+	CodeInternal        = "internal_error"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+
+	key    string
+	params []string
+}
+
+// NewFieldError membuat FieldError dari key katalog; placeholder {field}
+// otomatis diisi nama field
+func NewFieldError(field, rule, key string, params ...string) FieldError {
+	params = append([]string{"field", field}, params...)
+	return FieldError{Field: field, Rule: rule, Message: renderMessage(DefaultLocale, key, params), key: key, params: params}
+}
+
+type AppError struct {
+	Code    string
+	Status  int
+	Message string
+	Fields  []FieldError
+	Cause   error
+
+	key    string
+	params []string // pasangan nama/nilai placeholder, misalnya "resource", "skill"
+}
+
+func (e *AppError) Error() string {
+	if e.Cause != nil && e.Status >= http.StatusInternalServerError {
+		return e.Message + ": " + e.Cause.Error()
+	}
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Cause
+}
+
+func NewAppError(status int, code, message string) *AppError {
+	return &AppError{Code: code, Status: status, Message: message}
+}
+
+// LocalizedError membuat AppError dari key katalog pesan (lihat ruleMessages),
+// params berupa pasangan nama/nilai: LocalizedError(400, CodeBadRequest,
+// "limit_range", "max", "100")
+func LocalizedError(status int, code, key string, params ...string) *AppError {
+	return &AppError{
+		Code:    code,
+		Status:  status,
+		Message: renderMessage(DefaultLocale, key, params),
+		key:     key,
+		params:  params,
+	}
+}
+
+// Localize mengembalikan pesan dalam locale yang diminta. Error yang tidak
+// dibuat dari katalog dikembalikan apa adanya.
+func (e *AppError) Localize(locale string) string {
+	if e.key == "" {
+		return e.Message
+	}
+	return renderMessage(locale, e.key, e.params)
+}
+
+// LocalizedFields sama seperti Localize untuk tiap FieldError
+func (e *AppError) LocalizedFields(locale string) []FieldError {
+	if len(e.Fields) == 0 {
+		return e.Fields
+	}
+	fields := make([]FieldError, len(e.Fields))
+	for i, field := range e.Fields {
+		if field.key != "" {
+			field.Message = renderMessage(locale, field.key, field.params)
+		}
+		fields[i] = field
+	}
+	return fields
+}
+
+// WithMessage mengganti pesan utama dengan key katalog lain, misalnya
+// ValidationFailed(fields).WithMessage("reorder_set")
+func (e *AppError) WithMessage(key string, params ...string) *AppError {
+	e.key, e.params = key, params
+	e.Message = renderMessage(DefaultLocale, key, params)
+	return e
+}
+
+func BadRequest(message string) *AppError {
+	return NewAppError(http.StatusBadRequest, CodeBadRequest, message)
+}
+
+// BadRequestKey adalah BadRequest dengan pesan dari katalog
+func BadRequestKey(key string, params ...string) *AppError {
+	return LocalizedError(http.StatusBadRequest, CodeBadRequest, key, params...)
+}
+
+// RequireFields menerima pasangan nama/nilai dan mengembalikan 422 berisi
+// semua field yang kosong, misalnya RequireFields("name", req.Name, "message", req.Message)
+func RequireFields(pairs ...string) error {
+	var fields []FieldError
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if strings.TrimSpace(pairs[i+1]) == "" {
+			fields = append(fields, NewFieldError(pairs[i], "required", "required"))
+		}
+	}
+	if len(fields) > 0 {
+		return ValidationFailed(fields)
+	}
+	return nil
+}
+
+// InvalidID dipakai untuk path param yang bukan UUID, misalnya InvalidID("skill")
+func InvalidID(resource string) *AppError {
+	return LocalizedError(http.StatusBadRequest, CodeInvalidID, "invalid_id", "resource", resource)
+}
+
+func NotFound(resource string) *AppError {
+	return LocalizedError(http.StatusNotFound, CodeNotFound, "not_found", "resource", resource)
+}
+
+func Conflict(message string) *AppError {
+	return NewAppError(http.StatusConflict, CodeConflict, message)
+}
+
+// ConflictKey adalah Conflict dengan pesan dari katalog
+func ConflictKey(key string, params ...string) *AppError {
+	return LocalizedError(http.StatusConflict, CodeConflict, key, params...)
+}
+
+func Internal(cause error) *AppError {
+	appErr := LocalizedError(http.StatusInternalServerError, CodeInternal, "internal_error")
+	appErr.Cause = cause
+	return appErr
+}
+
+func ValidationFailed(fields []FieldError) *AppError {
+	appErr := LocalizedError(http.StatusUnprocessableEntity, CodeValidation, "validation_failed")
+	appErr.Fields = fields
+	return appErr
+}
+
+// WithCause menyimpan error asli untuk log
+func (e *AppError) WithCause(cause error) *AppError {
+	e.Cause = cause
+	return e
+}
+
+// AsAppError mengubah error apa pun menjadi AppError. Error dari GORM dan
+// driver Postgres dipetakan ke status yang sesuai tanpa membawa pesan aslinya;
+// error biasa memakai fallbackStatus yang dipilih handler dengan pesan umum,
+// error aslinya hanya disimpan di Cause.
+func AsAppError(err error, fallbackStatus int) *AppError {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, sql.ErrNoRows):
+		return NotFound("resource").WithCause(err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ConflictKey("resource_exists").WithCause(err)
+	}
+
+	if code, constraint, ok := postgresError(err); ok {
+		switch code {
+		case "23505": // unique_violation
+			return conflictError(constraint).WithCause(err)
+		case "23503": // foreign_key_violation
+			return ConflictKey("resource_referenced").WithCause(err)
+		case "22P02", "23502", "23514": // invalid_text_representation, not_null, check
+			return BadRequestKey("invalid_value").WithCause(err)
+		}
+		return Internal(err)
+	}
+
+	if fallbackStatus >= http.StatusInternalServerError || fallbackStatus < http.StatusBadRequest {
+		return Internal(err)
+	}
+	// Pesan error biasa bisa berisi detail internal (query, path file, dst.),
+	// jadi client hanya menerima pesan umum sesuai status
+	key, params := fallbackMessage(fallbackStatus)
+	return LocalizedError(fallbackStatus, codeForStatus(fallbackStatus), key, params...).WithCause(err)
+}
+
+// fallbackMessage memilih key katalog umum untuk status 4xx
+func fallbackMessage(status int) (string, []string) {
+	switch status {
+	case http.StatusUnauthorized:
+		return "unauthorized", nil
+	case http.StatusForbidden:
+		return "forbidden", nil
+	case http.StatusNotFound:
+		return "not_found", []string{"resource", "resource"}
+	case http.StatusConflict:
+		return "conflict", nil
+	case http.StatusUnprocessableEntity:
+		return "validation_failed", nil
+	case http.StatusTooManyRequests:
+		return "too_many_requests", nil
+	}
+	return "bad_request", nil
+}
+
+func postgresError(err error) (code, constraint string, ok bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code, pgErr.ConstraintName, true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code), pqErr.Constraint, true
+	}
+	return "", "", false
+}
+
+// conflictError memakai nama constraint (misalnya skills_name_key) supaya
+// user tahu field mana yang bentrok tanpa membocorkan pesan database
+func conflictError(constraint string) *AppError {
+	if constraint == "" {
+		return ConflictKey("resource_exists")
+	}
+	name := strings.TrimSuffix(strings.TrimSuffix(constraint, "_key"), "_unique")
+	if i := strings.Index(name, "_"); i >= 0 {
+		name = name[i+1:]
+	}
+	return ConflictKey("resource_exists_field", "field", strings.ReplaceAll(name, "_", " "))
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	}
+	return CodeBadRequest
+}
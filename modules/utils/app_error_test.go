@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestAppErrorLocalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *AppError
+		locale string
+		want   string
+	}{
+		{"not found en", NotFound("blog post"), LocaleEN, "blog post not found"},
+		{"not found id", NotFound("blog post"), LocaleID, "blog post tidak ditemukan"},
+		{"range id", BadRequestKey("range", "field", "days", "min", "1", "max", "365"), LocaleID, "days harus antara 1-365"},
+		{"unknown locale falls back to en", InvalidID("skill"), "fr", "invalid skill ID"},
+		{"free text is kept", BadRequest("custom message"), LocaleID, "custom message"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Localize(tt.locale); got != tt.want {
+				t.Fatalf("Localize(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppErrorMessageUsesDefaultLocale(t *testing.T) {
+	if got := NotFound("comment").Error(); got != "comment not found" {
+		t.Fatalf("Error() = %q", got)
+	}
+}
+
+func TestRequireFields(t *testing.T) {
+	if err := RequireFields("name", "Budi", "message", "halo"); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	var appErr *AppError
+	if !errors.As(RequireFields("name", " ", "message", ""), &appErr) {
+		t.Fatal("expected AppError")
+	}
+	if appErr.Status != http.StatusUnprocessableEntity || len(appErr.Fields) != 2 {
+		t.Fatalf("status = %d, fields = %v", appErr.Status, appErr.Fields)
+	}
+
+	fields := appErr.LocalizedFields(LocaleID)
+	if fields[0].Field != "name" || fields[0].Message != "name wajib diisi" {
+		t.Fatalf("fields[0] = %+v", fields[0])
+	}
+	if appErr.Fields[0].Message != "name is required" {
+		t.Fatalf("LocalizedFields must not modify the original fields, got %q", appErr.Fields[0].Message)
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	appErr := ValidationFailed(nil).WithMessage("invalid_value")
+	if got := appErr.Localize(LocaleID); got != "nilai tidak valid" {
+		t.Fatalf("Localize = %q", got)
+	}
+}
+
+func TestAsAppError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		fallback int
+		status   int
+		code     string
+	}{
+		{"record not found", fmt.Errorf("wrapped: %w", gorm.ErrRecordNotFound), http.StatusBadRequest, http.StatusNotFound, CodeNotFound},
+		{"duplicated key", gorm.ErrDuplicatedKey, http.StatusBadRequest, http.StatusConflict, CodeConflict},
+		{"app error passes through", NotFound("tag"), http.StatusInternalServerError, http.StatusNotFound, CodeNotFound},
+		{"plain error with 4xx fallback", errors.New("bad input"), http.StatusBadRequest, http.StatusBadRequest, CodeBadRequest},
+		{"plain error with 5xx fallback", errors.New("db down"), http.StatusInternalServerError, http.StatusInternalServerError, CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appErr := AsAppError(tt.err, tt.fallback)
+			if appErr.Status != tt.status || appErr.Code != tt.code {
+				t.Fatalf("got %d/%s, want %d/%s", appErr.Status, appErr.Code, tt.status, tt.code)
+			}
+		})
+	}
+}
+
+func TestAsAppErrorHidesPlainErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		fallback int
+		want     string
+	}{
+		{"bad request", http.StatusBadRequest, "request tidak dapat diproses"},
+		{"not found", http.StatusNotFound, "resource tidak ditemukan"},
+		{"forbidden", http.StatusForbidden, "tidak punya akses ke data ini"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cause := errors.New(`pq: column "secret" does not exist`)
+			appErr := AsAppError(cause, tt.fallback)
+			if got := appErr.Localize(LocaleID); got != tt.want {
+				t.Fatalf("Localize = %q, want %q", got, tt.want)
+			}
+			if strings.Contains(appErr.Error(), "secret") {
+				t.Fatalf("Error() leaks the cause: %q", appErr.Error())
+			}
+			if !errors.Is(appErr, cause) {
+				t.Fatal("the original error must be kept as Cause")
+			}
+		})
+	}
+}
+
+func TestBindingErrorHidesUnknownError(t *testing.T) {
+	cause := &strconv.NumError{Func: "ParseInt", Num: "abc", Err: strconv.ErrSyntax}
+
+	var appErr *AppError
+	if !errors.As(BindingError(cause, LocaleEN), &appErr) {
+		t.Fatal("expected AppError")
+	}
+	if appErr.Status != http.StatusBadRequest || appErr.Localize(LocaleEN) != "invalid value" {
+		t.Fatalf("got %d %q", appErr.Status, appErr.Localize(LocaleEN))
+	}
+	if !errors.Is(appErr, cause) {
+		t.Fatal("the original error must be kept as Cause")
+	}
+}
+
+func TestInternalHidesCause(t *testing.T) {
+	appErr := Internal(errors.New("pq: password authentication failed"))
+	if got := appErr.Localize(LocaleEN); got != "internal server error" {
+		t.Fatalf("Localize = %q", got)
+	}
+}
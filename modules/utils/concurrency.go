@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================
+// OPTIMISTIC CONCURRENCY
+// ============================
+// Setiap resource yang bisa diubah punya kolom version. Response detail dan
+// hasil PUT/PATCH membawa header X-Resource-Version, dan HTTPCache memberi
+// response detail ETag "v<version>-<hash body>". Client cukup mengirim ulang
+// ETag dari GET (atau nilai X-Resource-Version: "v3", "3", 3) lewat If-Match
+// dan akan mendapat 412 jika resource sudah diubah orang lain. Hash body
+// tetap ada di ETag karena satu version bisa punya banyak representasi
+// (terjemahan, with_tags, timezone) yang tidak boleh saling memberi 304.
+// If-Match bersifat opsional, tetapi update dan delete di repository selalu
+// bersyarat pada version yang dibaca service sehingga dua write yang
+// bersamaan tidak saling menimpa.
+
+const CodePreconditionFailed = "precondition_failed"
+
+func PreconditionFailed() *AppError {
+	return LocalizedError(http.StatusPreconditionFailed, CodePreconditionFailed, "precondition_failed")
+}
+
+const ResourceVersionHeader = "X-Resource-Version"
+
+// SetResourceVersion dipakai handler untuk response satu resource
+func SetResourceVersion(ctx *gin.Context, version int) {
+	if version > 0 {
+		ctx.Header(ResourceVersionHeader, strconv.Itoa(version))
+	}
+}
+
+// ResourceETag membentuk ETag kuat untuk response satu resource
+func ResourceETag(version int, bodyHash string) string {
+	return `"v` + strconv.Itoa(version) + "-" + bodyHash + `"`
+}
+
+// CheckIfMatch membandingkan header If-Match dengan version saat ini.
+// Tanpa header atau dengan "*" selalu lolos. ETag dari ResourceETag cocok
+// selama version-nya sama, apa pun representasi yang dipakai saat GET.
+func CheckIfMatch(ctx *gin.Context, version int) error {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		if matchesVersion(strings.TrimSpace(candidate), version) {
+			return nil
+		}
+	}
+	return PreconditionFailed()
+}
+
+// matchesVersion menerima "v3-<hash>", "v3", "3" dan 3. Weak validator
+// (W/...) tidak pernah cocok untuk If-Match (RFC 9110 strong comparison).
+func matchesVersion(candidate string, version int) bool {
+	if strings.HasPrefix(candidate, "W/") {
+		return false
+	}
+	candidate = strings.TrimPrefix(strings.Trim(candidate, `"`), "v")
+	candidate, _, _ = strings.Cut(candidate, "-")
+	n, err := strconv.Atoi(candidate)
+	return err == nil && n == version
+}
+
+// SaveVersioned menulis seluruh kolom value (termasuk nilai kosong) hanya jika
+// version di database masih sama, lalu menaikkan version. Relasi tidak ikut
+// disimpan; repository mengurusnya sendiri.
+func SaveVersioned(tx *gorm.DB, value interface{}, version *int, omit ...string) error {
+	expected := *version
+	*version = expected + 1
+
+	omit = append(omit, "id", "created_at", clause.Associations)
+	result := tx.Model(value).
+		Where("version = ?", expected).
+		Select("*").
+		Omit(omit...).
+		Updates(value)
+	if result.Error != nil {
+		*version = expected
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		*version = expected
+		return PreconditionFailed()
+	}
+	return nil
+}
+
+// DeleteVersioned menghapus baris id hanya jika version di database masih sama
+// dengan yang dibaca service, supaya update yang masuk di antara pengecekan
+// If-Match dan delete tidak ikut terhapus diam-diam
+func DeleteVersioned(tx *gorm.DB, model interface{}, id uuid.UUID, version int) error {
+	result := tx.Where("id = ? AND version = ?", id, version).Delete(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return PreconditionFailed()
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestCheckIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantErr bool
+	}{
+		{"no header", "", false},
+		{"wildcard", "*", false},
+		{"quoted v prefix", `"v3"`, false},
+		{"quoted number", `"3"`, false},
+		{"bare number", "3", false},
+		{"one of several", `"v2", "v3"`, false},
+		{"etag from GET", `"v3-9f86d081884c7d659a2feaa0c55ad015"`, false},
+		{"stale version", `"v2"`, true},
+		{"stale etag", `"v2-9f86d081884c7d659a2feaa0c55ad015"`, true},
+		{"weak validator", `W/"v3"`, true},
+		{"body hash etag", `"9f86d081884c7d659a2feaa0c55ad015"`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest("PUT", "/items/1", nil)
+			if tt.ifMatch != "" {
+				ctx.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+			if err := CheckIfMatch(ctx, 3); (err != nil) != tt.wantErr {
+				t.Fatalf("CheckIfMatch(%q) error = %v, wantErr %v", tt.ifMatch, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetResourceVersion(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	SetResourceVersion(ctx, 7)
+	if got := recorder.Header().Get(ResourceVersionHeader); got != "7" {
+		t.Fatalf("%s = %q", ResourceVersionHeader, got)
+	}
+	if recorder.Header().Get("ETag") != "" {
+		t.Fatal("version must not be sent as ETag")
+	}
+}
+
+type versionedTestRow struct {
+	ID      uuid.UUID
+	Version int
+}
+
+func TestDeleteVersioned(t *testing.T) {
+	// DryRun tidak pernah menyentuh database, jadi RowsAffected selalu 0
+	sqlDB, _ := sql.Open("postgres", "host=127.0.0.1 port=1 sslmode=disable")
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+
+	var statement string
+	db.Callback().Delete().After("gorm:delete").Register("test:capture", func(tx *gorm.DB) {
+		statement = tx.Statement.SQL.String()
+	})
+
+	err = DeleteVersioned(db, &versionedTestRow{}, uuid.New(), 3)
+	if !strings.Contains(statement, "WHERE id = $1 AND version = $2") {
+		t.Fatalf("delete is not conditional on version: %s", statement)
+	}
+
+	var appErr *AppError
+	if !errors.As(err, &appErr) || appErr.Status != http.StatusPreconditionFailed {
+		t.Fatalf("err = %v, want 412 when no row matches", err)
+	}
+}
@@ -0,0 +1,286 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // timezone pengunjung tetap bisa di-load di image tanpa zoneinfo
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// MONTH DATES & PERIODS
+// ============================
+// Tanggal experience/education disimpan sebagai DATE hari pertama bulannya.
+// Presisi "year" dipakai untuk data yang hanya diketahui tahunnya. Period
+// (label tanggal dan durasi) dirender per request: nama bulan mengikuti
+// locale konten dan "bulan ini" untuk periode yang masih berjalan dihitung
+// di timezone pengunjung.
+
+const (
+	DatePrecisionMonth = "month"
+	DatePrecisionYear  = "year"
+
+	DefaultTimeZone = "Asia/Jakarta"
+)
+
+// DateRange adalah rentang tanggal yang sudah divalidasi. Start nil untuk
+// data lama tanpa tanggal mulai; End nil jika Ongoing atau tidak diketahui.
+type DateRange struct {
+	Start     *time.Time
+	End       *time.Time
+	Ongoing   bool
+	Precision string
+}
+
+// MonthDate mengubah "2021-06" atau "2021" menjadi tanggal 1 bulan tersebut
+func MonthDate(value string) (time.Time, string, bool) {
+	ym, ok := ParseYearMonth(value)
+	if !ok {
+		return time.Time{}, "", false
+	}
+	precision := DatePrecisionMonth
+	if !strings.Contains(value, "-") {
+		precision = DatePrecisionYear
+	}
+	return time.Date(ym/12, time.Month(ym%12+1), 1, 0, 0, 0, 0, time.UTC), precision, true
+}
+
+// FormatMonthDate kebalikan MonthDate; dipakai untuk response dan merge patch
+func FormatMonthDate(date *time.Time, precision string) string {
+	if date == nil {
+		return ""
+	}
+	if precision == DatePrecisionYear {
+		return date.Format("2006")
+	}
+	return date.Format("2006-01")
+}
+
+// ParseDateRange memvalidasi kombinasi start/end/ongoing dari request.
+// Format tiap nilai dan urutan start <= end sudah dicek tag binding
+// (year, year_end, yearrange); end "present"/"sekarang" berarti ongoing.
+func ParseDateRange(start, end string, ongoing bool, startField, endField string) (DateRange, error) {
+	start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+	if IsPresentValue(end) {
+		ongoing, end = true, ""
+	}
+
+	dates := DateRange{Ongoing: ongoing, Precision: DatePrecisionMonth}
+	var fields []FieldError
+
+	if start != "" {
+		if date, precision, ok := MonthDate(start); ok {
+			dates.Start, dates.Precision = &date, precision
+		} else {
+			fields = append(fields, NewFieldError(startField, "year", "year"))
+		}
+	}
+
+	switch {
+	case end != "" && ongoing:
+		fields = append(fields, NewFieldError(endField, "ongoing", "date_ongoing"))
+	case end != "":
+		date, precision, ok := MonthDate(end)
+		if !ok {
+			fields = append(fields, NewFieldError(endField, "year", "year"))
+		} else if dates.Start != nil && precision != dates.Precision {
+			fields = append(fields, NewFieldError(endField, "precision", "date_precision", "param", startField))
+		}
+		dates.End, dates.Precision = &date, precision
+	case !ongoing && start != "":
+		fields = append(fields, NewFieldError(endField, "required_unless_ongoing", "date_required_unless_ongoing"))
+	}
+
+	if len(fields) > 0 {
+		return DateRange{}, ValidationFailed(fields)
+	}
+	return dates, nil
+}
+
+// ============================
+// LOCALIZED RENDERING
+// ============================
+
+// Period adalah representasi DateRange untuk ditampilkan apa adanya
+type Period struct {
+	Start          string `json:"start"`
+	End            string `json:"end"`
+	Duration       string `json:"duration"`
+	DurationMonths int    `json:"duration_months"`
+	Label          string `json:"label"`
+}
+
+type periodWords struct {
+	months                [12]string
+	present               string
+	yearUnit, yearsUnit   string
+	monthUnit, monthsUnit string
+}
+
+var periodLocales = map[string]periodWords{
+	LocaleEN: {
+		months:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		present:    "Present",
+		yearUnit:   "yr",
+		yearsUnit:  "yrs",
+		monthUnit:  "mo",
+		monthsUnit: "mos",
+	},
+	LocaleID: {
+		months:     [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agu", "Sep", "Okt", "Nov", "Des"},
+		present:    "Sekarang",
+		yearUnit:   "thn",
+		yearsUnit:  "thn",
+		monthUnit:  "bln",
+		monthsUnit: "bln",
+	},
+}
+
+// DateFormatter merender tanggal sesuai locale dan timezone request
+type DateFormatter struct {
+	Locale   string
+	Location *time.Location
+	Now      time.Time
+}
+
+// NewDateFormatter membaca locale dan timezone yang di-resolve middleware
+// ContentLocale; tanpa middleware dipakai bahasa Inggris dan DefaultTimeZone
+func NewDateFormatter(ctx *gin.Context) DateFormatter {
+	formatter := DateFormatter{Locale: LocaleEN, Now: time.Now()}
+	if ctx != nil {
+		if locale, ok := GetContentLocale(ctx); ok {
+			formatter.Locale = locale.Locale
+			formatter.Location = locale.TimeZone
+		}
+	}
+	if formatter.Location == nil {
+		formatter.Location, _ = LoadTimeZone(DefaultTimeZone)
+	}
+	return formatter
+}
+
+// Time mengonversi timestamp ke timezone pengunjung
+func (f DateFormatter) Time(t time.Time) time.Time {
+	if f.Location == nil || t.IsZero() {
+		return t
+	}
+	return t.In(f.Location)
+}
+
+func (f DateFormatter) words() periodWords {
+	if words, ok := periodLocales[f.Locale]; ok {
+		return words
+	}
+	return periodLocales[LocaleEN]
+}
+
+// MonthLabel misalnya "Jun 2021" / "Agu 2021", atau "2021" untuk presisi tahun
+func (f DateFormatter) MonthLabel(date *time.Time, precision string) string {
+	if date == nil {
+		return ""
+	}
+	if precision == DatePrecisionYear {
+		return strconv.Itoa(date.Year())
+	}
+	return f.words().months[date.Month()-1] + " " + strconv.Itoa(date.Year())
+}
+
+// Period menghitung durasi inklusif (Jan–Mar = 3 bulan, seperti LinkedIn).
+// Periode ongoing dihitung sampai bulan berjalan di timezone pengunjung.
+func (f DateFormatter) Period(dates DateRange) Period {
+	words := f.words()
+	period := Period{
+		Start: f.MonthLabel(dates.Start, dates.Precision),
+		End:   f.MonthLabel(dates.End, dates.Precision),
+	}
+	if dates.Ongoing {
+		period.End = words.present
+	}
+
+	end := dates.End
+	if dates.Ongoing {
+		now := f.Now
+		if f.Location != nil {
+			now = now.In(f.Location)
+		}
+		current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end = &current
+	}
+
+	if dates.Start != nil && end != nil {
+		if dates.Precision == DatePrecisionYear {
+			// Inklusif juga: 2021–2021 = 1 thn, 2018–2021 = 4 thn
+			period.DurationMonths = (end.Year()-dates.Start.Year())*12 + 12
+		} else {
+			period.DurationMonths = (end.Year()-dates.Start.Year())*12 + int(end.Month()-dates.Start.Month()) + 1
+		}
+		if period.DurationMonths < 0 {
+			period.DurationMonths = 0
+		}
+		period.Duration = formatDuration(period.DurationMonths, words)
+	}
+
+	var label []string
+	switch {
+	case period.Start != "" && period.End != "" && period.Start != period.End:
+		label = append(label, period.Start+" – "+period.End)
+	case period.Start != "":
+		label = append(label, period.Start)
+	case period.End != "":
+		label = append(label, period.End)
+	}
+	if period.Duration != "" {
+		label = append(label, period.Duration)
+	}
+	period.Label = strings.Join(label, " · ")
+
+	return period
+}
+
+// formatDuration: 27 -> "2 yrs 3 mos" / "2 thn 3 bln"
+func formatDuration(months int, words periodWords) string {
+	years, rest := months/12, months%12
+	var parts []string
+	if years > 0 {
+		unit := words.yearsUnit
+		if years == 1 {
+			unit = words.yearUnit
+		}
+		parts = append(parts, strconv.Itoa(years)+" "+unit)
+	}
+	if rest > 0 {
+		unit := words.monthsUnit
+		if rest == 1 {
+			unit = words.monthUnit
+		}
+		parts = append(parts, strconv.Itoa(rest)+" "+unit)
+	}
+	return strings.Join(parts, " ")
+}
+
+// ============================
+// TIMEZONES
+// ============================
+
+var timeZones sync.Map // nama IANA -> *time.Location
+
+// LoadTimeZone seperti time.LoadLocation, dengan cache karena dipanggil di
+// setiap request
+func LoadTimeZone(name string) (*time.Location, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, false
+	}
+	if cached, ok := timeZones.Load(name); ok {
+		return cached.(*time.Location), true
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, false
+	}
+	timeZones.Store(name, location)
+	return location, true
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func monthPtr(year int, month time.Month) *time.Time {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestMonthDate(t *testing.T) {
+	tests := []struct {
+		value     string
+		want      time.Time
+		precision string
+		ok        bool
+	}{
+		{"2021-06", *monthPtr(2021, time.June), DatePrecisionMonth, true},
+		{"2021", *monthPtr(2021, time.January), DatePrecisionYear, true},
+		{" 2021-12 ", *monthPtr(2021, time.December), DatePrecisionMonth, true},
+		{"2021-13", time.Time{}, "", false},
+		{"June 2021", time.Time{}, "", false},
+		{"1800", time.Time{}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, precision, ok := MonthDate(tt.value)
+			if ok != tt.ok || !got.Equal(tt.want) || precision != tt.precision {
+				t.Fatalf("MonthDate(%q) = %v, %q, %v", tt.value, got, precision, ok)
+			}
+			if ok && FormatMonthDate(&got, precision) != strings.TrimSpace(tt.value) {
+				t.Fatalf("FormatMonthDate round trip = %q", FormatMonthDate(&got, precision))
+			}
+		})
+	}
+}
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   string
+		end     string
+		ongoing bool
+		want    DateRange
+		rules   []string
+	}{
+		{"month range", "2021-06", "2023-02", false, DateRange{Start: monthPtr(2021, time.June), End: monthPtr(2023, time.February), Precision: DatePrecisionMonth}, nil},
+		{"year range", "2019", "2021", false, DateRange{Start: monthPtr(2019, time.January), End: monthPtr(2021, time.January), Precision: DatePrecisionYear}, nil},
+		{"ongoing flag", "2022-01", "", true, DateRange{Start: monthPtr(2022, time.January), Ongoing: true, Precision: DatePrecisionMonth}, nil},
+		{"present keyword", "2022-01", "sekarang", false, DateRange{Start: monthPtr(2022, time.January), Ongoing: true, Precision: DatePrecisionMonth}, nil},
+		{"empty", "", "", false, DateRange{Precision: DatePrecisionMonth}, nil},
+		{"bad start", "2021-13", "2022-01", false, DateRange{}, []string{"year"}},
+		{"end with ongoing", "2021-01", "2022-01", true, DateRange{}, []string{"ongoing"}},
+		{"mixed precision", "2021-06", "2022", false, DateRange{}, []string{"precision"}},
+		{"missing end", "2021-06", "", false, DateRange{}, []string{"required_unless_ongoing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateRange(tt.start, tt.end, tt.ongoing, "start_date", "end_date")
+			if tt.rules != nil {
+				var appErr *AppError
+				if !errors.As(err, &appErr) || len(appErr.Fields) != len(tt.rules) {
+					t.Fatalf("err = %v, want rules %v", err, tt.rules)
+				}
+				for i, rule := range tt.rules {
+					if appErr.Fields[i].Rule != rule {
+						t.Fatalf("fields[%d] = %+v, want rule %q", i, appErr.Fields[i], rule)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !sameMonth(got.Start, tt.want.Start) || !sameMonth(got.End, tt.want.End) ||
+				got.Ongoing != tt.want.Ongoing || got.Precision != tt.want.Precision {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func sameMonth(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func TestDateFormatterPeriod(t *testing.T) {
+	jakarta, _ := LoadTimeZone("Asia/Jakarta")
+	// 31 Mei 20:00 UTC = 1 Juni 03:00 WIB
+	now := time.Date(2024, time.May, 31, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		formatter DateFormatter
+		dates     DateRange
+		label     string
+		months    int
+	}{
+		{
+			"closed range en",
+			DateFormatter{Locale: LocaleEN, Now: now},
+			DateRange{Start: monthPtr(2021, time.January), End: monthPtr(2023, time.March), Precision: DatePrecisionMonth},
+			"Jan 2021 – Mar 2023 · 2 yrs 3 mos", 27,
+		},
+		{
+			"single month id",
+			DateFormatter{Locale: LocaleID, Now: now},
+			DateRange{Start: monthPtr(2021, time.August), End: monthPtr(2021, time.August), Precision: DatePrecisionMonth},
+			"Agu 2021 · 1 bln", 1,
+		},
+		{
+			"ongoing uses visitor timezone",
+			DateFormatter{Locale: LocaleID, Location: jakarta, Now: now},
+			DateRange{Start: monthPtr(2024, time.January), Ongoing: true, Precision: DatePrecisionMonth},
+			"Jan 2024 – Sekarang · 6 bln", 6,
+		},
+		{
+			"ongoing in UTC",
+			DateFormatter{Locale: LocaleEN, Location: time.UTC, Now: now},
+			DateRange{Start: monthPtr(2024, time.January), Ongoing: true, Precision: DatePrecisionMonth},
+			"Jan 2024 – Present · 5 mos", 5,
+		},
+		{
+			"year precision",
+			DateFormatter{Locale: LocaleEN, Now: now},
+			DateRange{Start: monthPtr(2018, time.January), End: monthPtr(2021, time.January), Precision: DatePrecisionYear},
+			"2018 – 2021 · 4 yrs", 48,
+		},
+		{
+			"year precision single year",
+			DateFormatter{Locale: LocaleEN, Now: now},
+			DateRange{Start: monthPtr(2021, time.January), End: monthPtr(2021, time.January), Precision: DatePrecisionYear},
+			"2021 · 1 yr", 12,
+		},
+		{
+			"start only",
+			DateFormatter{Locale: LocaleEN, Now: now},
+			DateRange{Start: monthPtr(2020, time.May), Precision: DatePrecisionMonth},
+			"May 2020", 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period := tt.formatter.Period(tt.dates)
+			if period.Label != tt.label || period.DurationMonths != tt.months {
+				t.Fatalf("Period = %q (%d months), want %q (%d months)", period.Label, period.DurationMonths, tt.label, tt.months)
+			}
+		})
+	}
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	for _, name := range []string{"", "Local", "Mars/Olympus"} {
+		if _, ok := LoadTimeZone(name); ok {
+			t.Fatalf("LoadTimeZone(%q) should fail", name)
+		}
+	}
+	if location, ok := LoadTimeZone("Asia/Makassar"); !ok || location.String() != "Asia/Makassar" {
+		t.Fatalf("LoadTimeZone(Asia/Makassar) = %v, %v", location, ok)
+	}
+}
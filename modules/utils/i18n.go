@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================
+// CONTENT LOCALE
+// ============================
+// Locale konten di-resolve sekali per request oleh middleware ContentLocale
+// (?lang= > Accept-Language > default_locale) lalu dibaca service lewat
+// Localizer untuk menimpa field yang punya terjemahan. Timezone pengunjung
+// (?tz= > X-Timezone > default_timezone) ikut di-resolve di middleware yang
+// sama untuk DateFormatter.
+
+const contentLocaleKey = "content_locale"
+
+// Sumber locale konten
+const (
+	LocaleSourceQuery   = "query"
+	LocaleSourceHeader  = "header"
+	LocaleSourceDefault = "default"
+)
+
+// Entity yang field-nya bisa diterjemahkan
+const (
+	TranslatableProject    = "project"
+	TranslatableBlogPost   = "blog_post"
+	TranslatableExperience = "experience"
+	TranslatableEducation  = "education"
+	TranslatableSection    = "section"
+)
+
+type ContentLocale struct {
+	Locale   string
+	Default  string
+	Source   string
+	TimeZone *time.Location
+}
+
+// LocaleConfig berasal dari settings (default_locale, supported_locales,
+// default_timezone)
+type LocaleConfig struct {
+	DefaultLocale string
+	Supported     []string
+	TimeZone      *time.Location
+}
+
+// IsDefault true jika konten dasar dipakai apa adanya
+func (l ContentLocale) IsDefault() bool {
+	return l.Locale == "" || l.Locale == l.Default
+}
+
+func SetContentLocale(ctx *gin.Context, locale ContentLocale) {
+	ctx.Set(contentLocaleKey, locale)
+}
+
+func GetContentLocale(ctx *gin.Context) (ContentLocale, bool) {
+	value, ok := ctx.Get(contentLocaleKey)
+	if !ok {
+		return ContentLocale{}, false
+	}
+	locale, ok := value.(ContentLocale)
+	return locale, ok
+}
+
+// Translations berisi terjemahan satu entity type untuk satu locale:
+// entity id -> field -> value. Nil berarti tidak ada yang perlu diganti.
+type Translations map[uuid.UUID]map[string]string
+
+// Apply mengganti target dengan terjemahan field jika ada
+func (t Translations) Apply(id uuid.UUID, field string, target *string) {
+	if value := t[id][field]; value != "" {
+		*target = value
+	}
+}
+
+type Localizer interface {
+	Translations(ctx *gin.Context, entityType string) Translations
+}
+
+// Localize aman dipanggil dengan localizer nil (misalnya di service yang
+// dibuat tanpa dukungan i18n)
+func Localize(localizer Localizer, ctx *gin.Context, entityType string) Translations {
+	if localizer == nil || ctx == nil {
+		return nil
+	}
+	return localizer.Translations(ctx, entityType)
+}
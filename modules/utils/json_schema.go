@@ -0,0 +1,342 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ============================
+// JSON SCHEMA (subset)
+// ============================
+// Validator kecil untuk setting bertipe json. Keyword yang didukung:
+//   type (string atau array), enum, const
+//   properties, required, additionalProperties (bool atau schema)
+//   items, minItems, maxItems, uniqueItems
+//   minLength, maxLength, pattern, format (email, uri)
+//   minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf
+//   anyOf, oneOf, allOf, not
+// Keyword lain ($ref, dsb.) diabaikan supaya schema dari tool lain tetap bisa
+// dipakai tanpa membuat semua dokumen ditolak.
+
+type JSONSchema map[string]interface{}
+
+// ParseJSONSchema memastikan schema adalah object JSON dengan keyword yang
+// bentuknya valid, misalnya pattern bisa dikompilasi. Error berupa AppError
+// 422 untuk field json_schema (field yang dikirim client di request setting).
+func ParseJSONSchema(raw []byte) (JSONSchema, error) {
+	var schema JSONSchema
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&schema); err != nil || schema == nil {
+		return nil, schemaError("#", "schema_object")
+	}
+	if err := checkSchema(schema, "#"); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func checkSchema(schema JSONSchema, path string) error {
+	if pattern, ok := schema["pattern"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return schemaError(path+"/pattern", "schema_regex")
+		}
+	}
+	for _, keyword := range []string{"items", "additionalProperties", "not"} {
+		if child, ok := asSchema(schema[keyword]); ok {
+			if err := checkSchema(child, path+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, value := range properties {
+			child, ok := asSchema(value)
+			if !ok {
+				return schemaError(path+"/properties/"+name, "schema_object")
+			}
+			if err := checkSchema(child, path+"/properties/"+name); err != nil {
+				return err
+			}
+		}
+	}
+	for _, keyword := range []string{"anyOf", "oneOf", "allOf"} {
+		if list, ok := schema[keyword].([]interface{}); ok {
+			for i, value := range list {
+				child, ok := asSchema(value)
+				if !ok {
+					return schemaError(fmt.Sprintf("%s/%s/%d", path, keyword, i), "schema_object")
+				}
+				if err := checkSchema(child, fmt.Sprintf("%s/%s/%d", path, keyword, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func schemaError(pointer, key string) error {
+	return ValidationFailed([]FieldError{NewFieldError("json_schema", "json_schema", key, "path", pointer)})
+}
+
+// Validate memeriksa dokumen JSON terhadap schema. Field pada FieldError
+// memakai prefix root, misalnya "value.social[0].url".
+func (schema JSONSchema) Validate(document []byte, root string) []FieldError {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return []FieldError{NewFieldError(root, "invalid_json", "json")}
+	}
+
+	var errs []FieldError
+	validateSchema(schema, value, root, &errs)
+	return errs
+}
+
+func validateSchema(schema JSONSchema, value interface{}, path string, errs *[]FieldError) {
+	fail := func(rule, key string, params ...string) {
+		*errs = append(*errs, NewFieldError(path, rule, key, params...))
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 {
+		actual := jsonType(value)
+		matched := false
+		for _, t := range types {
+			matched = matched || t == actual || (t == "number" && actual == "integer")
+		}
+		if !matched {
+			fail("type", "type", "param", strings.Join(types, ", "))
+			return
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, candidate := range enum {
+			found = found || jsonEqual(candidate, value)
+		}
+		if !found {
+			fail("enum", "schema_enum")
+		}
+	}
+	if constant, ok := schema["const"]; ok && !jsonEqual(constant, value) {
+		fail("const", "schema_const")
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if min, ok := schemaNumber(schema["minLength"]); ok && float64(length) < min {
+			fail("minLength", "min.string", "param", fmt.Sprint(min))
+		}
+		if max, ok := schemaNumber(schema["maxLength"]); ok && float64(length) > max {
+			fail("maxLength", "max.string", "param", fmt.Sprint(max))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(v) {
+				fail("pattern", "schema_pattern", "param", pattern)
+			}
+		}
+		switch schema["format"] {
+		case "email":
+			if !strings.Contains(v, "@") || strings.ContainsAny(v, " \t\r\n") {
+				fail("format", "email")
+			}
+		case "uri":
+			if !IsHTTPURL(v) && !strings.Contains(v, ":") {
+				fail("format", "url")
+			}
+		}
+
+	case json.Number:
+		n, _ := v.Float64()
+		if min, ok := schemaNumber(schema["minimum"]); ok && n < min {
+			fail("minimum", "min", "param", fmt.Sprint(min))
+		}
+		if max, ok := schemaNumber(schema["maximum"]); ok && n > max {
+			fail("maximum", "max", "param", fmt.Sprint(max))
+		}
+		if min, ok := schemaNumber(schema["exclusiveMinimum"]); ok && n <= min {
+			fail("exclusiveMinimum", "gt", "param", fmt.Sprint(min))
+		}
+		if max, ok := schemaNumber(schema["exclusiveMaximum"]); ok && n >= max {
+			fail("exclusiveMaximum", "lt", "param", fmt.Sprint(max))
+		}
+		if step, ok := schemaNumber(schema["multipleOf"]); ok && step > 0 {
+			if q := n / step; math.Abs(q-math.Round(q)) > 1e-9 {
+				fail("multipleOf", "schema_multiple_of", "param", fmt.Sprint(step))
+			}
+		}
+
+	case []interface{}:
+		if min, ok := schemaNumber(schema["minItems"]); ok && float64(len(v)) < min {
+			fail("minItems", "min.slice", "param", fmt.Sprint(min))
+		}
+		if max, ok := schemaNumber(schema["maxItems"]); ok && float64(len(v)) > max {
+			fail("maxItems", "max.slice", "param", fmt.Sprint(max))
+		}
+		if unique, _ := schema["uniqueItems"].(bool); unique {
+		duplicates:
+			for i := range v {
+				for j := 0; j < i; j++ {
+					if jsonEqual(v[i], v[j]) {
+						fail("uniqueItems", "schema_unique")
+						break duplicates
+					}
+				}
+			}
+		}
+		if items, ok := asSchema(schema["items"]); ok {
+			for i, item := range v {
+				validateSchema(items, item, path+"["+strconv.Itoa(i)+"]", errs)
+			}
+		}
+
+	case map[string]interface{}:
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, name := range required {
+				if key, ok := name.(string); ok {
+					if _, exists := v[key]; !exists {
+						*errs = append(*errs, NewFieldError(path+"."+key, "required", "required"))
+					}
+				}
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if propSchema, ok := asSchema(properties[key]); ok {
+				validateSchema(propSchema, v[key], path+"."+key, errs)
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					*errs = append(*errs, NewFieldError(path+"."+key, "additionalProperties", "schema_additional"))
+				}
+			case map[string]interface{}:
+				validateSchema(additional, v[key], path+"."+key, errs)
+			}
+		}
+	}
+
+	if list, ok := schema["allOf"].([]interface{}); ok {
+		for _, item := range list {
+			if child, ok := asSchema(item); ok {
+				validateSchema(child, value, path, errs)
+			}
+		}
+	}
+	if list, ok := schema["anyOf"].([]interface{}); ok && countMatches(list, value, path) == 0 {
+		fail("anyOf", "schema_any_of")
+	}
+	if list, ok := schema["oneOf"].([]interface{}); ok && countMatches(list, value, path) != 1 {
+		fail("oneOf", "schema_one_of")
+	}
+	if not, ok := asSchema(schema["not"]); ok && countMatches([]interface{}{map[string]interface{}(not)}, value, path) == 1 {
+		fail("not", "schema_not")
+	}
+}
+
+func countMatches(schemas []interface{}, value interface{}, path string) int {
+	matches := 0
+	for _, item := range schemas {
+		child, ok := asSchema(item)
+		if !ok {
+			continue
+		}
+		var errs []FieldError
+		validateSchema(child, value, path, &errs)
+		if len(errs) == 0 {
+			matches++
+		}
+	}
+	return matches
+}
+
+func asSchema(value interface{}) (JSONSchema, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, true
+	case JSONSchema:
+		return v, true
+	}
+	return nil, false
+}
+
+func schemaTypes(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		types := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func schemaNumber(value interface{}) (float64, bool) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		if f, err := v.Float64(); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+// jsonEqual membandingkan dua nilai JSON; angka dibandingkan secara numerik
+// supaya 1 dan 1.0 dianggap sama
+func jsonEqual(a, b interface{}) bool {
+	an, aok := a.(json.Number)
+	bn, bok := b.(json.Number)
+	if aok && bok {
+		af, _ := an.Float64()
+		bf, _ := bn.Float64()
+		return af == bf
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseJSONSchemaRejectsInvalidSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"not an object", `[1, 2]`},
+		{"not JSON", `{type: string}`},
+		{"null", `null`},
+		{"bad pattern", `{"type": "string", "pattern": "("}`},
+		{"nested bad pattern", `{"properties": {"slug": {"pattern": "[a-"}}}`},
+		{"property not a schema", `{"properties": {"name": 5}}`},
+		{"anyOf entry not a schema", `{"anyOf": [{"type": "string"}, "x"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJSONSchema([]byte(tt.schema))
+			var appErr *AppError
+			if !errors.As(err, &appErr) || len(appErr.Fields) != 1 || appErr.Fields[0].Field != "json_schema" {
+				t.Fatalf("ParseJSONSchema(%s) error = %v", tt.schema, err)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidate(t *testing.T) {
+	schema, err := ParseJSONSchema([]byte(`{
+		"type": "object",
+		"required": ["name", "links"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 10},
+			"email": {"type": "string", "format": "email"},
+			"age": {"type": "integer", "minimum": 0, "exclusiveMaximum": 150},
+			"step": {"type": "number", "multipleOf": 0.5},
+			"level": {"enum": ["junior", "senior"]},
+			"slug": {"type": "string", "pattern": "^[a-z-]+$"},
+			"links": {
+				"type": "array",
+				"minItems": 1,
+				"uniqueItems": true,
+				"items": {"type": "object", "required": ["url"], "properties": {"url": {"type": "string", "format": "uri"}}}
+			},
+			"contact": {"oneOf": [{"type": "string"}, {"type": "object"}]}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("ParseJSONSchema: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		document string
+		fields   map[string]string // field -> rule
+	}{
+		{"valid", `{"name": "Budi", "age": 30, "step": 1.5, "level": "senior", "slug": "budi-s", "links": [{"url": "https://example.com"}], "contact": "wa"}`, nil},
+		{"integer accepts number type", `{"name": "Budi", "links": [{"url": "https://a.example"}], "step": 2}`, nil},
+		{"invalid JSON", `{"name":`, map[string]string{"value": "invalid_json"}},
+		{"wrong root type", `[]`, map[string]string{"value": "type"}},
+		{"missing required", `{"name": "Budi"}`, map[string]string{"value.links": "required"}},
+		{"additional property", `{"name": "Budi", "links": [{"url": "https://a.example"}], "extra": 1}`, map[string]string{"value.extra": "additionalProperties"}},
+		{"string bounds", `{"name": "B", "links": [{"url": "https://a.example"}]}`, map[string]string{"value.name": "minLength"}},
+		{"number bounds", `{"name": "Budi", "age": 150, "links": [{"url": "https://a.example"}]}`, map[string]string{"value.age": "exclusiveMaximum"}},
+		{"integer type", `{"name": "Budi", "age": 1.5, "links": [{"url": "https://a.example"}]}`, map[string]string{"value.age": "type"}},
+		{"multipleOf", `{"name": "Budi", "step": 0.3, "links": [{"url": "https://a.example"}]}`, map[string]string{"value.step": "multipleOf"}},
+		{"enum", `{"name": "Budi", "level": "lead", "links": [{"url": "https://a.example"}]}`, map[string]string{"value.level": "enum"}},
+		{"pattern", `{"name": "Budi", "slug": "Budi S", "links": [{"url": "https://a.example"}]}`, map[string]string{"value.slug": "pattern"}},
+		{"email format", `{"name": "Budi", "email": "budi", "links": [{"url": "https://a.example"}]}`, map[string]string{"value.email": "format"}},
+		{"empty array", `{"name": "Budi", "links": []}`, map[string]string{"value.links": "minItems"}},
+		{"duplicate items", `{"name": "Budi", "links": [{"url": "https://a.example"}, {"url": "https://a.example"}]}`, map[string]string{"value.links": "uniqueItems"}},
+		{"nested item", `{"name": "Budi", "links": [{}]}`, map[string]string{"value.links[0].url": "required"}},
+		{"oneOf", `{"name": "Budi", "links": [{"url": "https://a.example"}], "contact": 5}`, map[string]string{"value.contact": "oneOf"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := schema.Validate([]byte(tt.document), "value")
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("got %d errors %+v, want %v", len(errs), errs, tt.fields)
+			}
+			for _, e := range errs {
+				if rule, ok := tt.fields[e.Field]; !ok || rule != e.Rule {
+					t.Fatalf("unexpected error %+v, want %v", e, tt.fields)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONSchemaMessagesAreLocalized(t *testing.T) {
+	schema, err := ParseJSONSchema([]byte(`{"type": "string", "maxLength": 3}`))
+	if err != nil {
+		t.Fatalf("ParseJSONSchema: %v", err)
+	}
+	appErr := ValidationFailed(schema.Validate([]byte(`"abcd"`), "value"))
+	if got := appErr.LocalizedFields(LocaleID)[0].Message; got != "value maksimal 3 karakter" {
+		t.Fatalf("message = %q", got)
+	}
+}
@@ -0,0 +1,521 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ============================
+// LIST QUERY (pagination, sorting, filtering)
+// ============================
+// Format query string yang didukung semua endpoint list:
+//   ?limit=20&cursor=<next_cursor>&sort=-created_at,name&filter[status]=published,draft
+// Offset pagination juga tersedia lewat ?offset= (diabaikan jika cursor dipakai).
+// Nama field mengikuti json tag model dan harus ada di whitelist ListSpec.
+// Nilai NULL selalu diurutkan paling akhir (NULLS LAST) baik ASC maupun DESC,
+// dan disimpan sebagai null di cursor.
+
+// Tipe kolom dipakai untuk CAST nilai filter/cursor di query
+const (
+	FieldText      = "text"
+	FieldInt       = "integer"
+	FieldBool      = "boolean"
+	FieldDate      = "date"
+	FieldTimestamp = "timestamptz"
+	FieldUUID      = "uuid"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type ListField struct {
+	Column     string
+	Type       string
+	Sortable   bool
+	Filterable bool
+}
+
+// ListSpec whitelist field per resource
+type ListSpec struct {
+	Fields       map[string]ListField
+	DefaultSort  string
+	DefaultLimit int
+	MaxLimit     int
+}
+
+type SortField struct {
+	Name string
+	Desc bool
+	ListField
+}
+
+type ListFilter struct {
+	Name   string
+	Values []string
+	ListField
+}
+
+type ListQuery struct {
+	Limit   int
+	Offset  int
+	Sort    []SortField
+	Filters []ListFilter
+	cursor  []*string // nil = nilai NULL
+}
+
+type PageInfo struct {
+	NextCursor string `json:"next_cursor"`
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+}
+
+type listCursor struct {
+	Sort   string    `json:"s"`
+	Values []*string `json:"v"`
+}
+
+// idField selalu ditambahkan sebagai tie-breaker supaya urutan stabil
+var idField = ListField{Column: "id", Type: FieldUUID}
+
+func ParseListQuery(ctx *gin.Context, spec ListSpec) (*ListQuery, error) {
+	q := &ListQuery{Limit: spec.DefaultLimit}
+	if q.Limit <= 0 {
+		q.Limit = defaultListLimit
+	}
+	maxLimit := spec.MaxLimit
+	if maxLimit <= 0 {
+		maxLimit = maxListLimit
+	}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return nil, BadRequestKey("range", "field", "limit", "min", "1", "max", strconv.Itoa(maxLimit))
+		}
+		q.Limit = limit
+	}
+
+	sortStr := ctx.DefaultQuery("sort", spec.DefaultSort)
+	for _, part := range strings.Split(sortStr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		desc := strings.HasPrefix(part, "-")
+		name := strings.TrimPrefix(part, "-")
+		field, ok := spec.Fields[name]
+		if !ok || !field.Sortable {
+			return nil, BadRequestKey("sort_unsupported", "field", name)
+		}
+		q.Sort = append(q.Sort, SortField{Name: name, Desc: desc, ListField: field})
+	}
+
+	for name, value := range ctx.QueryMap("filter") {
+		field, ok := spec.Fields[name]
+		if !ok || !field.Filterable {
+			return nil, BadRequestKey("filter_unsupported", "field", name)
+		}
+
+		var values []string
+		for _, v := range strings.Split(value, ",") {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			if err := validateListValue(field.Type, v); err != nil {
+				return nil, BadRequestKey("filter_invalid", "field", name).WithCause(err)
+			}
+			values = append(values, v)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		q.Filters = append(q.Filters, ListFilter{Name: name, Values: values, ListField: field})
+	}
+
+	if cursorStr := ctx.Query("cursor"); cursorStr != "" {
+		if err := q.decodeCursor(cursorStr); err != nil {
+			return nil, err
+		}
+	} else if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return nil, BadRequestKey("invalid_field", "field", "offset")
+		}
+		q.Offset = offset
+	}
+
+	return q, nil
+}
+
+// ForceFilter mengganti filter field dengan nilai yang ditentukan server,
+// misalnya membatasi user anonim ke status approved. Filter dari client untuk
+// field yang sama diabaikan.
+func (q *ListQuery) ForceFilter(spec ListSpec, name string, values ...string) {
+	filters := q.Filters[:0]
+	for _, f := range q.Filters {
+		if f.Name != name {
+			filters = append(filters, f)
+		}
+	}
+	q.Filters = append(filters, ListFilter{Name: name, Values: values, ListField: spec.Fields[name]})
+}
+
+// CacheKey representasi stabil dari query, dipakai sebagai key read cache
+func (q *ListQuery) CacheKey() string {
+	filters := make([]string, 0, len(q.Filters))
+	for _, f := range q.Filters {
+		filters = append(filters, f.Name+"="+strings.Join(f.Values, ","))
+	}
+	sort.Strings(filters)
+
+	cursor := make([]string, 0, len(q.cursor))
+	for _, value := range q.cursor {
+		if value == nil {
+			cursor = append(cursor, "<null>")
+		} else {
+			cursor = append(cursor, strconv.Quote(*value))
+		}
+	}
+
+	return fmt.Sprintf("limit=%d&offset=%d&sort=%s&filter=%s&cursor=%s",
+		q.Limit, q.Offset, q.sortSignature(), strings.Join(filters, "&"), strings.Join(cursor, ","))
+}
+
+// ============================
+// GORM
+// ============================
+
+// ApplyFilters hanya menerapkan filter (dipakai untuk menghitung total)
+func (q *ListQuery) ApplyFilters(db *gorm.DB) *gorm.DB {
+	where, args := q.filterCondition(gormPlaceholder)
+	if where == "" {
+		return db
+	}
+	return db.Where(where, args...)
+}
+
+// Apply menerapkan filter, cursor, urutan dan limit. Limit diambil satu lebih
+// banyak untuk mengetahui apakah masih ada halaman berikutnya.
+func (q *ListQuery) Apply(db *gorm.DB) *gorm.DB {
+	db = q.ApplyFilters(db)
+
+	if q.cursor != nil {
+		where, args := q.cursorCondition(gormPlaceholder)
+		db = db.Where(where, args...)
+	}
+
+	return db.Order(q.orderBy()).Limit(q.Limit + 1).Offset(q.Offset)
+}
+
+// FindPage menjalankan COUNT(*) dengan filter lalu mengambil satu halaman.
+// scopes dipakai untuk Preload yang tidak boleh ikut ke query COUNT.
+func FindPage[T any](db *gorm.DB, q *ListQuery, scopes ...func(*gorm.DB) *gorm.DB) ([]T, PageInfo, error) {
+	// Session supaya kondisi dari db (misalnya status published) bisa dipakai
+	// ulang untuk dua query tanpa saling menumpuk
+	base := db.Session(&gorm.Session{})
+
+	var total int64
+	if err := q.ApplyFilters(base.Model(new(T))).Count(&total).Error; err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	var items []T
+	if err := q.Apply(base.Scopes(scopes...)).Find(&items).Error; err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	items, page := BuildPage(items, q, total)
+	return items, page, nil
+}
+
+func gormPlaceholder() string {
+	return "?"
+}
+
+// ============================
+// DATABASE/SQL
+// ============================
+
+// SQL menghasilkan kondisi WHERE (tanpa kata WHERE, kosong jika tidak ada)
+// dan ORDER BY/LIMIT/OFFSET dengan placeholder $n mulai dari argStart
+func (q *ListQuery) SQL(argStart int) (where string, tail string, args []interface{}) {
+	placeholder := postgresPlaceholder(argStart)
+
+	var conditions []string
+	if cond, filterArgs := q.filterCondition(placeholder); cond != "" {
+		conditions = append(conditions, cond)
+		args = append(args, filterArgs...)
+	}
+	if q.cursor != nil {
+		cond, cursorArgs := q.cursorCondition(placeholder)
+		conditions = append(conditions, cond)
+		args = append(args, cursorArgs...)
+	}
+
+	where = strings.Join(conditions, " AND ")
+	tail = fmt.Sprintf("ORDER BY %s LIMIT %d OFFSET %d", q.orderBy(), q.Limit+1, q.Offset)
+	return where, tail, args
+}
+
+// FilterSQL hanya kondisi filter, untuk query COUNT(*)
+func (q *ListQuery) FilterSQL(argStart int) (string, []interface{}) {
+	return q.filterCondition(postgresPlaceholder(argStart))
+}
+
+func postgresPlaceholder(start int) func() string {
+	n := start - 1
+	return func() string {
+		n++
+		return "$" + strconv.Itoa(n)
+	}
+}
+
+// ============================
+// PAGE RESULT
+// ============================
+
+// BuildPage membuang item ekstra hasil Limit+1 dan membuat next_cursor
+// dari item terakhir
+func BuildPage[T any](items []T, q *ListQuery, total int64) ([]T, PageInfo) {
+	page := PageInfo{Total: total, Limit: q.Limit}
+	if items == nil {
+		items = []T{}
+	}
+
+	if len(items) > q.Limit {
+		items = items[:q.Limit]
+		page.NextCursor = q.encodeCursor(items[len(items)-1])
+	}
+
+	return items, page
+}
+
+// ============================
+// HELPERS
+// ============================
+
+func (q *ListQuery) orderBy() string {
+	var parts []string
+	for _, s := range q.Sort {
+		direction := "ASC"
+		if s.Desc {
+			direction = "DESC"
+		}
+		parts = append(parts, s.Column+" "+direction+" NULLS LAST")
+	}
+	parts = append(parts, idField.Column+" ASC")
+	return strings.Join(parts, ", ")
+}
+
+func (q *ListQuery) sortSignature() string {
+	var parts []string
+	for _, s := range q.Sort {
+		if s.Desc {
+			parts = append(parts, "-"+s.Name)
+		} else {
+			parts = append(parts, s.Name)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// filterCondition menggabungkan semua filter dengan AND, beberapa nilai
+// dalam satu filter menjadi IN (...)
+func (q *ListQuery) filterCondition(placeholder func() string) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	for _, f := range q.Filters {
+		var holders []string
+		for _, v := range f.Values {
+			holders = append(holders, fmt.Sprintf("CAST(%s AS %s)", placeholder(), f.Type))
+			args = append(args, v)
+		}
+		if len(holders) == 1 {
+			conditions = append(conditions, fmt.Sprintf("%s = %s", f.Column, holders[0]))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s IN (%s)", f.Column, strings.Join(holders, ", ")))
+		}
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// cursorCondition membuat kondisi keyset:
+// (a > va) OR (a = va AND b > vb) OR ... OR (a = va AND b = vb AND id > vid)
+// Karena NULL diurutkan paling akhir, "setelah va" berarti a > va OR a IS NULL;
+// jika va sendiri NULL tidak ada nilai a sesudahnya, jadi cabang itu dilewati
+// dan kesamaan ditulis a IS NULL.
+func (q *ListQuery) cursorCondition(placeholder func() string) (string, []interface{}) {
+	fields := append(append([]SortField{}, q.Sort...), SortField{Name: "id", ListField: idField})
+
+	var (
+		ors  []string
+		args []interface{}
+	)
+	for i, field := range fields {
+		if q.cursor[i] == nil {
+			continue
+		}
+
+		var ands []string
+		for j := 0; j < i; j++ {
+			if q.cursor[j] == nil {
+				ands = append(ands, fields[j].Column+" IS NULL")
+				continue
+			}
+			ands = append(ands, fmt.Sprintf("%s = CAST(%s AS %s)", fields[j].Column, placeholder(), fields[j].Type))
+			args = append(args, *q.cursor[j])
+		}
+		op := ">"
+		if field.Desc {
+			op = "<"
+		}
+		after := fmt.Sprintf("%s %s CAST(%s AS %s)", field.Column, op, placeholder(), field.Type)
+		args = append(args, *q.cursor[i])
+		if field.Column != idField.Column {
+			after = "(" + after + " OR " + field.Column + " IS NULL)"
+		}
+		ands = append(ands, after)
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+
+	return "(" + strings.Join(ors, " OR ") + ")", args
+}
+
+func (q *ListQuery) encodeCursor(item interface{}) string {
+	var values []*string
+	for _, s := range q.Sort {
+		values = append(values, formatListValue(jsonFieldValue(item, s.Name), s.Type))
+	}
+	values = append(values, formatListValue(jsonFieldValue(item, "id"), FieldUUID))
+
+	data, err := json.Marshal(listCursor{Sort: q.sortSignature(), Values: values})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (q *ListQuery) decodeCursor(cursorStr string) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return BadRequestKey("invalid_field", "field", "cursor")
+	}
+
+	var c listCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return BadRequestKey("invalid_field", "field", "cursor")
+	}
+
+	if c.Sort != q.sortSignature() || len(c.Values) != len(q.Sort)+1 {
+		return BadRequestKey("cursor_sort_mismatch")
+	}
+
+	for i, s := range q.Sort {
+		if c.Values[i] == nil {
+			continue
+		}
+		if err := validateListValue(s.Type, *c.Values[i]); err != nil {
+			return BadRequestKey("invalid_field", "field", "cursor")
+		}
+	}
+	if id := c.Values[len(q.Sort)]; id == nil || validateListValue(FieldUUID, *id) != nil {
+		return BadRequestKey("invalid_field", "field", "cursor")
+	}
+
+	q.cursor = c.Values
+	return nil
+}
+
+func validateListValue(fieldType, value string) error {
+	var err error
+	switch fieldType {
+	case FieldInt:
+		_, err = strconv.Atoi(value)
+	case FieldBool:
+		_, err = strconv.ParseBool(value)
+	case FieldDate:
+		_, err = time.Parse("2006-01-02", value)
+	case FieldTimestamp:
+		_, err = time.Parse(time.RFC3339Nano, value)
+	case FieldUUID:
+		_, err = uuid.Parse(value)
+	}
+	return err
+}
+
+// formatListValue mengembalikan nil untuk NULL (nil, pointer nil atau
+// time.Time kosong hasil scan kolom tanggal NULL ke field non-pointer)
+func formatListValue(value interface{}, fieldType string) *string {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return nil
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		return formatListValue(rv.Elem().Interface(), fieldType)
+	}
+
+	var formatted string
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return nil
+		}
+		if fieldType == FieldDate {
+			formatted = v.Format("2006-01-02")
+		} else {
+			formatted = v.Format(time.RFC3339Nano)
+		}
+	default:
+		formatted = fmt.Sprint(v)
+	}
+	return &formatted
+}
+
+// jsonFieldValue mengambil nilai field struct berdasarkan json tag,
+// termasuk field dari struct yang di-embed
+func jsonFieldValue(item interface{}, name string) interface{} {
+	v := reflect.ValueOf(item)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			if value := jsonFieldValue(v.Field(i).Interface(), name); value != nil {
+				return value
+			}
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == name && field.IsExported() {
+			return v.Field(i).Interface()
+		}
+	}
+	return nil
+}
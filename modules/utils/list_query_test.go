@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var testListSpec = ListSpec{
+	DefaultSort: "-created_at",
+	Fields: map[string]ListField{
+		"name":       {Column: "name", Type: FieldText, Sortable: true, Filterable: true},
+		"end_date":   {Column: "end_date", Type: FieldDate, Sortable: true},
+		"status":     {Column: "status", Type: FieldText, Filterable: true},
+		"created_at": {Column: "created_at", Type: FieldTimestamp, Sortable: true},
+	},
+}
+
+type testListItem struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	EndDate   *time.Time `json:"end_date"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func testListContext(rawQuery string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/items?"+rawQuery, nil)
+	return ctx
+}
+
+func parseTestListQuery(t *testing.T, rawQuery string) *ListQuery {
+	t.Helper()
+	q, err := ParseListQuery(testListContext(rawQuery), testListSpec)
+	if err != nil {
+		t.Fatalf("ParseListQuery(%q): %v", rawQuery, err)
+	}
+	return q
+}
+
+func TestParseListQueryRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"limit too large", "limit=1000"},
+		{"limit not a number", "limit=abc"},
+		{"unknown sort field", "sort=password"},
+		{"field not sortable", "sort=status"},
+		{"field not filterable", "filter[end_date]=2024-01-01"},
+		{"negative offset", "offset=-1"},
+		{"garbage cursor", "cursor=bm90LWpzb24"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseListQuery(testListContext(tt.query), testListSpec); err == nil {
+				t.Fatalf("expected error for %q", tt.query)
+			}
+		})
+	}
+}
+
+func TestForceFilterReplacesClientFilter(t *testing.T) {
+	q := parseTestListQuery(t, "filter[status]=draft,published")
+	q.ForceFilter(testListSpec, "status", "published")
+
+	where, args := q.FilterSQL(1)
+	if where != "status = CAST($1 AS text)" {
+		t.Fatalf("where = %q", where)
+	}
+	if len(args) != 1 || args[0] != "published" {
+		t.Fatalf("args = %v", args)
+	}
+}
+
+func TestOrderByPutsNullsLast(t *testing.T) {
+	q := parseTestListQuery(t, "sort=-end_date,name")
+	if got, want := q.orderBy(), "end_date DESC NULLS LAST, name ASC NULLS LAST, id ASC"; got != want {
+		t.Fatalf("orderBy = %q, want %q", got, want)
+	}
+}
+
+func TestCursorRoundTripWithNullSortValue(t *testing.T) {
+	q := parseTestListQuery(t, "sort=-end_date&limit=1")
+
+	last := testListItem{ID: uuid.New(), Name: "ongoing"}
+	items, page := BuildPage([]testListItem{last, {ID: uuid.New()}}, q, 2)
+	if len(items) != 1 || page.NextCursor == "" {
+		t.Fatalf("expected one item and a next cursor, got %d items, cursor %q", len(items), page.NextCursor)
+	}
+
+	next := parseTestListQuery(t, "sort=-end_date&limit=1&cursor="+page.NextCursor)
+	if next.cursor[0] != nil {
+		t.Fatalf("end_date should decode as NULL, got %q", *next.cursor[0])
+	}
+
+	where, args := next.cursorCondition(postgresPlaceholder(1))
+	if want := "((end_date IS NULL AND id > CAST($1 AS uuid)))"; where != want {
+		t.Fatalf("where = %q, want %q", where, want)
+	}
+	if len(args) != 1 || args[0] != last.ID.String() {
+		t.Fatalf("args = %v", args)
+	}
+}
+
+func TestCursorConditionIncludesTrailingNulls(t *testing.T) {
+	q := parseTestListQuery(t, "sort=end_date&limit=1")
+
+	end := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	last := testListItem{ID: uuid.New(), EndDate: &end}
+	_, page := BuildPage([]testListItem{last, {ID: uuid.New()}}, q, 2)
+
+	next := parseTestListQuery(t, "sort=end_date&limit=1&cursor="+page.NextCursor)
+	where, args := next.cursorCondition(postgresPlaceholder(1))
+
+	want := "((end_date > CAST($1 AS date) OR end_date IS NULL)) OR (end_date = CAST($2 AS date) AND id > CAST($3 AS uuid))"
+	if where != "("+want+")" {
+		t.Fatalf("where = %q, want %q", where, want)
+	}
+	if len(args) != 3 || args[0] != "2024-03-01" || args[1] != "2024-03-01" {
+		t.Fatalf("args = %v", args)
+	}
+}
+
+func TestCursorMustMatchSort(t *testing.T) {
+	q := parseTestListQuery(t, "sort=name&limit=1")
+	_, page := BuildPage([]testListItem{{ID: uuid.New(), Name: "a"}, {ID: uuid.New()}}, q, 2)
+
+	if _, err := ParseListQuery(testListContext("sort=-name&cursor="+page.NextCursor), testListSpec); err == nil {
+		t.Fatal("cursor from a different sort should be rejected")
+	}
+}
+
+func TestCacheKeyDistinguishesNullFromEmptyCursor(t *testing.T) {
+	empty := ""
+	a := &ListQuery{Limit: 10, cursor: []*string{nil}}
+	b := &ListQuery{Limit: 10, cursor: []*string{&empty}}
+	if a.CacheKey() == b.CacheKey() {
+		t.Fatal("NULL and empty cursor values must not share a cache key")
+	}
+	if !strings.Contains(a.CacheKey(), "<null>") {
+		t.Fatalf("cache key = %q", a.CacheKey())
+	}
+}
+
+func TestFormatListValue(t *testing.T) {
+	day := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name      string
+		value     interface{}
+		fieldType string
+		want      *string
+	}{
+		{"nil", nil, FieldText, nil},
+		{"nil time pointer", (*time.Time)(nil), FieldDate, nil},
+		{"zero time", time.Time{}, FieldDate, nil},
+		{"date", day, FieldDate, strPtr("2024-01-02")},
+		{"timestamp pointer", &day, FieldTimestamp, strPtr("2024-01-02T15:04:05Z")},
+		{"int", 42, FieldInt, strPtr("42")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatListValue(tt.value, tt.fieldType)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("formatListValue = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================
+// JSON MERGE PATCH (RFC 7396)
+// ============================
+// PATCH memakai DTO request yang sama dengan PUT: service mengisi target dengan
+// representasi resource saat ini, BindMergePatch menimpa field yang dikirim
+// client (null = kosongkan, field yang tidak dikirim = tetap), lalu hasilnya
+// divalidasi dengan tag binding yang sama seperti PUT.
+
+const MergePatchContentType = "application/merge-patch+json"
+
+func BindMergePatch(ctx *gin.Context, target interface{}) error {
+	if contentType := ctx.GetHeader("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != MergePatchContentType && mediaType != gin.MIMEJSON) {
+			return LocalizedError(http.StatusUnsupportedMediaType, "unsupported_media_type",
+				"merge_patch_media_type", "param", MergePatchContentType)
+		}
+	}
+
+	patch, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		return BadRequestKey("read_body_failed").WithCause(err)
+	}
+
+	original, err := json.Marshal(target)
+	if err != nil {
+		return Internal(err)
+	}
+
+	merged, err := MergePatch(original, patch)
+	if err != nil {
+		return err
+	}
+
+	// Kosongkan target dulu supaya field yang di-set null benar-benar hilang
+	value := reflect.ValueOf(target).Elem()
+	value.Set(reflect.Zero(value.Type()))
+
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	if err := decoder.Decode(target); err != nil {
+		return BindingError(err, RequestLocale(ctx))
+	}
+
+	return ValidateStruct(ctx, target)
+}
+
+// MergePatch menerapkan patch ke dokumen JSON original. Patch untuk resource
+// harus berupa object; patch selain object akan ditolak.
+func MergePatch(original, patch []byte) ([]byte, error) {
+	var patchDoc interface{}
+	if err := json.Unmarshal(patch, &patchDoc); err != nil {
+		return nil, BadRequestKey("invalid_json").WithCause(err)
+	}
+	patchObj, ok := patchDoc.(map[string]interface{})
+	if !ok {
+		return nil, BadRequestKey("merge_patch_object")
+	}
+
+	var originalDoc interface{}
+	if err := json.Unmarshal(original, &originalDoc); err != nil {
+		return nil, Internal(err)
+	}
+
+	return json.Marshal(mergeValue(originalDoc, patchObj))
+}
+
+func mergeValue(target interface{}, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		// Array dan nilai skalar selalu menggantikan nilai lama
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergeValue(targetObj[key], value)
+	}
+	return targetObj
+}
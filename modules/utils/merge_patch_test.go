@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Contoh dari RFC 7396 Appendix A (yang patch-nya berupa object)
+	tests := []struct {
+		original string
+		patch    string
+		want     string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.original+" + "+tt.patch, func(t *testing.T) {
+			merged, err := MergePatch([]byte(tt.original), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("MergePatch: %v", err)
+			}
+
+			var got, want interface{}
+			_ = json.Unmarshal(merged, &got)
+			_ = json.Unmarshal([]byte(tt.want), &want)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("MergePatch = %s, want %s", merged, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePatchRejectsNonObject(t *testing.T) {
+	tests := []struct {
+		patch string
+		key   string
+	}{
+		{`["a"]`, "merge_patch_object"},
+		{`null`, "merge_patch_object"},
+		{`"text"`, "merge_patch_object"},
+		{`{"a":`, "invalid_json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.patch, func(t *testing.T) {
+			_, err := MergePatch([]byte(`{"a":"b"}`), []byte(tt.patch))
+
+			var appErr *AppError
+			if !errors.As(err, &appErr) || appErr.Status != http.StatusBadRequest || appErr.key != tt.key {
+				t.Fatalf("err = %v, want 400 %s", err, tt.key)
+			}
+		})
+	}
+}
+
+type mergePatchTestRequest struct {
+	Name     string   `json:"name" binding:"required,max=10"`
+	Bio      *string  `json:"bio"`
+	Tags     []string `json:"tags"`
+	Featured bool     `json:"featured"`
+}
+
+func mergePatchTestContext(contentType, body string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPatch, "/items/1", strings.NewReader(body))
+	if contentType != "" {
+		ctx.Request.Header.Set("Content-Type", contentType)
+	}
+	return ctx
+}
+
+func TestBindMergePatch(t *testing.T) {
+	bio := "halo"
+	current := mergePatchTestRequest{Name: "Budi", Bio: &bio, Tags: []string{"go", "sql"}, Featured: true}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        mergePatchTestRequest
+		status      int
+	}{
+		{"missing field keeps value", MergePatchContentType, `{"featured":false}`,
+			mergePatchTestRequest{Name: "Budi", Bio: &bio, Tags: []string{"go", "sql"}}, 0},
+		{"null clears field", MergePatchContentType, `{"bio":null,"tags":null}`,
+			mergePatchTestRequest{Name: "Budi", Featured: true}, 0},
+		{"array replaces", "application/json; charset=utf-8", `{"tags":["rust"]}`,
+			mergePatchTestRequest{Name: "Budi", Bio: &bio, Tags: []string{"rust"}, Featured: true}, 0},
+		{"result is validated", MergePatchContentType, `{"name":null}`, mergePatchTestRequest{}, http.StatusUnprocessableEntity},
+		{"max rule applies", MergePatchContentType, `{"name":"nama yang kepanjangan"}`, mergePatchTestRequest{}, http.StatusUnprocessableEntity},
+		{"wrong media type", "text/plain", `{"name":"Ani"}`, mergePatchTestRequest{}, http.StatusUnsupportedMediaType},
+		{"type mismatch", MergePatchContentType, `{"featured":"yes"}`, mergePatchTestRequest{}, http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := current
+			target.Tags = append([]string(nil), current.Tags...)
+
+			err := BindMergePatch(mergePatchTestContext(tt.contentType, tt.body), &target)
+			if tt.status != 0 {
+				if appErr := AsAppError(err, http.StatusInternalServerError); err == nil || appErr.Status != tt.status {
+					t.Fatalf("err = %v, want status %d", err, tt.status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BindMergePatch: %v", err)
+			}
+			if !reflect.DeepEqual(target, tt.want) {
+				t.Fatalf("target = %+v, want %+v", target, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package utils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ============================
+// MINIMAL PDF WRITER
+// ============================
+// Penulis PDF kecil tanpa dependency: hanya font standar Type1 (Helvetica,
+// tidak perlu embed font), teks, garis dan link. Cukup untuk dokumen
+// sederhana seperti resume tanpa perlu headless browser. Koordinat API
+// memakai titik (1/72 inch) dengan origin di kiri atas halaman.
+
+const (
+	PDFPageWidth  = 595.28 // A4
+	PDFPageHeight = 841.89
+)
+
+type PDFFont string
+
+const (
+	PDFRegular PDFFont = "F1"
+	PDFBold    PDFFont = "F2"
+	PDFItalic  PDFFont = "F3"
+)
+
+var pdfBaseFonts = []struct {
+	name PDFFont
+	base string
+}{
+	{PDFRegular, "Helvetica"},
+	{PDFBold, "Helvetica-Bold"},
+	{PDFItalic, "Helvetica-Oblique"},
+}
+
+// PDFColor komponen RGB 0-255
+type PDFColor struct {
+	R, G, B uint8
+}
+
+func (c PDFColor) operands() string {
+	return fmt.Sprintf("%.3f %.3f %.3f", float64(c.R)/255, float64(c.G)/255, float64(c.B)/255)
+}
+
+type pdfLink struct {
+	x1, y1, x2, y2 float64
+	url            string
+}
+
+type pdfPage struct {
+	content bytes.Buffer
+	links   []pdfLink
+}
+
+type PDFDocument struct {
+	Title   string
+	Author  string
+	Created time.Time
+	pages   []*pdfPage
+}
+
+func NewPDFDocument(title, author string, created time.Time) *PDFDocument {
+	return &PDFDocument{Title: title, Author: author, Created: created}
+}
+
+func (d *PDFDocument) AddPage() {
+	d.pages = append(d.pages, &pdfPage{})
+}
+
+func (d *PDFDocument) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *PDFDocument) page() *pdfPage {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text menulis satu baris teks; y adalah baseline
+func (d *PDFDocument) Text(x, y float64, font PDFFont, size float64, color PDFColor, text string) {
+	fmt.Fprintf(&d.page().content, "BT %s rg /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		color.operands(), font, size, x, PDFPageHeight-y, pdfEscape(text))
+}
+
+func (d *PDFDocument) Line(x1, y1, x2, y2, width float64, color PDFColor) {
+	fmt.Fprintf(&d.page().content, "%s RG %.2f w %.2f %.2f m %.2f %.2f l S\n",
+		color.operands(), width, x1, PDFPageHeight-y1, x2, PDFPageHeight-y2)
+}
+
+// Link membuat area klik (x, y kiri atas) yang membuka url
+func (d *PDFDocument) Link(x, y, width, height float64, url string) {
+	page := d.page()
+	page.links = append(page.links, pdfLink{
+		x1: x, y1: PDFPageHeight - y - height,
+		x2: x + width, y2: PDFPageHeight - y,
+		url: url,
+	})
+}
+
+// Bytes menyusun file PDF lengkap (catalog, pages, fonts, content, xref)
+func (d *PDFDocument) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var objects []string
+	add := func(body string) int {
+		objects = append(objects, body)
+		return len(objects)
+	}
+
+	catalog := add("") // diisi setelah nomor pages diketahui
+	pagesObj := add("")
+
+	fontRefs := make([]string, 0, len(pdfBaseFonts))
+	for _, font := range pdfBaseFonts {
+		ref := add(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.base))
+		fontRefs = append(fontRefs, fmt.Sprintf("/%s %d 0 R", font.name, ref))
+	}
+	resources := "<< /Font << " + strings.Join(fontRefs, " ") + " >> >>"
+
+	kids := make([]string, 0, len(d.pages))
+	for _, page := range d.pages {
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(page.content.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		content := add(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()))
+
+		annots := ""
+		if len(page.links) > 0 {
+			refs := make([]string, 0, len(page.links))
+			for _, link := range page.links {
+				ref := add(fmt.Sprintf("<< /Type /Annot /Subtype /Link /Rect [%.2f %.2f %.2f %.2f] /Border [0 0 0] /A << /S /URI /URI (%s) >> >>",
+					link.x1, link.y1, link.x2, link.y2, pdfEscape(link.url)))
+				refs = append(refs, fmt.Sprintf("%d 0 R", ref))
+			}
+			annots = " /Annots [" + strings.Join(refs, " ") + "]"
+		}
+
+		ref := add(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources %s /Contents %d 0 R%s >>",
+			pagesObj, PDFPageWidth, PDFPageHeight, resources, content, annots))
+		kids = append(kids, fmt.Sprintf("%d 0 R", ref))
+	}
+
+	objects[catalog-1] = fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObj)
+	objects[pagesObj-1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	created := d.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+	info := add(fmt.Sprintf("<< /Title (%s) /Author (%s) /Producer (gintugas) /CreationDate (D:%s) >>",
+		pdfEscape(d.Title), pdfEscape(d.Author), created.UTC().Format("20060102150405Z")))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, body := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, catalog, info, xref)
+
+	return out.Bytes(), nil
+}
+
+// ============================
+// TEXT ENCODING & METRICS
+// ============================
+
+// Karakter di luar Latin-1 yang punya slot di WinAnsiEncoding
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91,
+	'’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98,
+	'™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+func winAnsiByte(r rune) (byte, bool) {
+	switch {
+	case r >= 0x20 && r < 0x7F, r >= 0xA0 && r <= 0xFF:
+		return byte(r), true
+	case r == '\t':
+		return ' ', true
+	}
+	b, ok := winAnsiExtra[r]
+	return b, ok
+}
+
+// pdfEscape mengubah teks ke WinAnsi sebagai literal string PDF; karakter
+// yang tidak bisa direpresentasikan font standar diganti "?"
+func pdfEscape(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		b, ok := winAnsiByte(r)
+		if !ok {
+			if unicode.IsControl(r) {
+				continue
+			}
+			b = '?'
+		}
+		switch b {
+		case '\\', '(', ')':
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(b)
+	}
+	return sb.String()
+}
+
+// Lebar glyph ASCII 32..126 dari AFM standar (satuan 1/1000 em)
+var pdfWidths = map[PDFFont][95]int{
+	PDFRegular: {
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	},
+	PDFBold: {
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	},
+}
+
+var pdfExtraWidths = map[rune]int{
+	'•': 350, '–': 556, '—': 1000, '…': 1000, '·': 278, '‘': 222, '’': 222, '“': 333, '”': 333,
+}
+
+func pdfGlyphWidth(font PDFFont, r rune) int {
+	widths, ok := pdfWidths[font]
+	if !ok {
+		widths = pdfWidths[PDFRegular] // Oblique memakai metrik Helvetica biasa
+	}
+	if r >= 32 && r <= 126 {
+		return widths[r-32]
+	}
+	if r == '\t' {
+		return widths[0]
+	}
+	if width, ok := pdfExtraWidths[r]; ok {
+		return width
+	}
+	return 556
+}
+
+// PDFTextWidth lebar teks dalam titik
+func PDFTextWidth(font PDFFont, size float64, text string) float64 {
+	total := 0
+	for _, r := range text {
+		total += pdfGlyphWidth(font, r)
+	}
+	return float64(total) * size / 1000
+}
+
+// PDFWrapText memecah teks per kata supaya setiap baris muat di maxWidth;
+// kata yang lebih panjang dari satu baris dipotong per karakter
+func PDFWrapText(font PDFFont, size float64, text string, maxWidth float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		current := ""
+		for _, word := range words {
+			candidate := word
+			if current != "" {
+				candidate = current + " " + word
+			}
+			if PDFTextWidth(font, size, candidate) <= maxWidth {
+				current = candidate
+				continue
+			}
+			if current != "" {
+				lines = append(lines, current)
+			}
+			for PDFTextWidth(font, size, word) > maxWidth {
+				cut := len(word)
+				for cut > 1 && PDFTextWidth(font, size, word[:cut]) > maxWidth {
+					_, width := utf8.DecodeLastRuneInString(word[:cut])
+					cut -= width
+				}
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+			current = word
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPDFEscape(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Hello", "Hello"},
+		{"a (b) \\ c", `a \(b\) \\ c`},
+		{"Café", "Caf\xe9"},
+		{"2021 – 2023 • €", "2021 \x96 2023 \x95 \x80"},
+		{"tab\there", "tab here"},
+		{"line\nbreak", "linebreak"},
+		{"日本", "??"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := pdfEscape(tt.in); got != tt.want {
+				t.Fatalf("pdfEscape(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPDFTextWidth(t *testing.T) {
+	// H=722 e=556 l=222 l=222 o=556 -> 2278/1000 em
+	if got := PDFTextWidth(PDFRegular, 10, "Hello"); got != 22.78 {
+		t.Fatalf("regular width = %v", got)
+	}
+	if PDFTextWidth(PDFBold, 10, "Hello") <= PDFTextWidth(PDFRegular, 10, "Hello") {
+		t.Fatal("bold text should be wider than regular")
+	}
+	if PDFTextWidth(PDFItalic, 10, "Hello") != PDFTextWidth(PDFRegular, 10, "Hello") {
+		t.Fatal("oblique uses regular metrics")
+	}
+}
+
+func TestPDFWrapText(t *testing.T) {
+	// Lebar "aaaa" pada 10pt = 4 * 5.56 = 22.24
+	tests := []struct {
+		name     string
+		text     string
+		maxWidth float64
+		want     []string
+	}{
+		{"fits", "aa aa", 100, []string{"aa aa"}},
+		{"wraps on words", "aaaa aaaa aaaa", 50, []string{"aaaa aaaa", "aaaa"}},
+		{"keeps paragraphs", "aa\n\naa", 100, []string{"aa", "", "aa"}},
+		{"splits long word", "aaaaaaaaaa", 23, []string{"aaaa", "aaaa", "aa"}},
+		{"splits multibyte word on rune boundary", "ééééé", 23, []string{"éééé", "é"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PDFWrapText(PDFRegular, 10, tt.text, tt.maxWidth)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("PDFWrapText = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPDFDocumentBytes(t *testing.T) {
+	doc := NewPDFDocument("Resume (Budi)", "Budi", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	doc.Text(50, 60, PDFBold, 18, PDFColor{R: 255}, "Budi (Backend)")
+	doc.Line(50, 70, 545, 70, 0.5, PDFColor{})
+	doc.Link(50, 80, 100, 12, "https://example.com/a(b)")
+	doc.AddPage()
+	doc.Text(50, 60, PDFRegular, 10, PDFColor{}, "Page two")
+
+	data, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	if doc.PageCount() != 2 {
+		t.Fatalf("PageCount = %d", doc.PageCount())
+	}
+
+	pdf := string(data)
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatal("missing PDF header or trailer")
+	}
+	for _, want := range []string{
+		"/Type /Pages /Kids [", "/Count 2",
+		"/Title (Resume \\(Budi\\))", "/CreationDate (D:20240102030405Z)",
+		"/URI (https://example.com/a\\(b\\))",
+		"/BaseFont /Helvetica-Bold",
+	} {
+		if !strings.Contains(pdf, want) {
+			t.Fatalf("PDF does not contain %q", want)
+		}
+	}
+
+	// Setiap offset di tabel xref harus menunjuk ke awal objeknya
+	xrefAt := strings.LastIndex(pdf, "startxref\n")
+	start, _ := strconv.Atoi(strings.TrimSpace(strings.Split(pdf[xrefAt+len("startxref\n"):], "\n")[0]))
+	if !strings.HasPrefix(pdf[start:], "xref\n") {
+		t.Fatalf("startxref %d does not point at the xref table", start)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[start:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		if prefix := strconv.Itoa(i+1) + " 0 obj\n"; !strings.HasPrefix(pdf[offset:], prefix) {
+			t.Fatalf("xref entry %d points at %q", i+1, pdf[offset:offset+10])
+		}
+	}
+
+	// Content stream halaman pertama berisi teks yang sudah di-escape
+	streams := regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllStringSubmatch(pdf, -1)
+	if len(streams) != 2 {
+		t.Fatalf("got %d content streams", len(streams))
+	}
+	zr, err := zlib.NewReader(bytes.NewReader([]byte(streams[0][1])))
+	if err != nil {
+		t.Fatalf("zlib: %v", err)
+	}
+	content, _ := io.ReadAll(zr)
+	if !strings.Contains(string(content), `/F2 18.00 Tf 50.00 781.89 Td (Budi \(Backend\)) Tj`) {
+		t.Fatalf("unexpected content stream: %s", content)
+	}
+}
+
+func TestPDFDocumentWithoutPages(t *testing.T) {
+	data, err := NewPDFDocument("", "", time.Time{}).Bytes()
+	if err != nil || !bytes.Contains(data, []byte("/Count 1")) {
+		t.Fatalf("empty document should still produce one page, err = %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================
+// BULK REORDER
+// ============================
+// Client mengirim seluruh ID resource dalam urutan yang diinginkan. Urutan
+// baru ditulis ke display_order (mulai dari 1) dalam satu transaksi, dan
+// hanya jika daftar ID persis sama dengan isi tabel: tanpa duplikat, tanpa
+// ID yang hilang, dan tanpa ID asing.
+
+type ReorderRequest struct {
+	IDs []uuid.UUID `json:"ids" binding:"required,min=1"`
+}
+
+// CheckReorderSet membandingkan ID dari client dengan ID yang ada di tabel
+func CheckReorderSet(current, ids []uuid.UUID) error {
+	var fields []FieldError
+
+	known := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		known[id] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for i, id := range ids {
+		field := "ids[" + strconv.Itoa(i) + "]"
+		switch {
+		case seen[id]:
+			fields = append(fields, NewFieldError(field, "duplicate", "id_duplicate", "id", id.String()))
+		case !known[id]:
+			fields = append(fields, NewFieldError(field, "unknown", "id_unknown", "id", id.String()))
+		}
+		seen[id] = true
+	}
+
+	for _, id := range current {
+		if !seen[id] {
+			fields = append(fields, NewFieldError("ids", "missing", "id_missing", "id", id.String()))
+		}
+	}
+
+	if len(fields) > 0 {
+		return ValidationFailed(fields).WithMessage("reorder_set")
+	}
+	return nil
+}
+
+// ReorderByIDs mengunci semua baris tabel milik model, memvalidasi daftar ID,
+// lalu menulis display_order baru. Version ikut naik karena representasi
+// resource berubah.
+func ReorderByIDs(db *gorm.DB, model interface{}, ids []uuid.UUID) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var current []uuid.UUID
+		if err := tx.Model(model).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Order("display_order ASC").
+			Pluck("id", &current).Error; err != nil {
+			return err
+		}
+
+		if err := CheckReorderSet(current, ids); err != nil {
+			return err
+		}
+
+		for i, id := range ids {
+			if err := tx.Model(model).
+				Where("id = ?", id).
+				UpdateColumns(map[string]interface{}{
+					"display_order": i + 1,
+					"version":       gorm.Expr("version + 1"),
+					"updated_at":    gorm.Expr("NOW()"),
+				}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
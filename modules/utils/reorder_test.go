@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCheckReorderSet(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	current := []uuid.UUID{a, b, c}
+
+	tests := []struct {
+		name  string
+		ids   []uuid.UUID
+		rules []string
+	}{
+		{"same set in new order", []uuid.UUID{c, a, b}, nil},
+		{"duplicate", []uuid.UUID{a, a, b, c}, []string{"duplicate"}},
+		{"unknown", []uuid.UUID{a, b, c, uuid.New()}, []string{"unknown"}},
+		{"missing", []uuid.UUID{a, b}, []string{"missing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckReorderSet(current, tt.ids)
+			if tt.rules == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var appErr *AppError
+			if !errors.As(err, &appErr) || len(appErr.Fields) != len(tt.rules) {
+				t.Fatalf("err = %v", err)
+			}
+			for i, rule := range tt.rules {
+				if appErr.Fields[i].Rule != rule {
+					t.Fatalf("fields[%d].Rule = %q, want %q", i, appErr.Fields[i].Rule, rule)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,640 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ============================
+// REQUEST VALIDATION
+// ============================
+// Semua DTO di-bind lewat BindJSON/Bind supaya error validasi keluar sebagai
+// daftar FieldError (field, rule, message) dan bukan satu string dari
+// validator. Pesan diterjemahkan ke id/en sesuai Accept-Language.
+//
+// Validator tambahan yang bisa dipakai di tag binding:
+//   httpurl   URL absolut http/https
+//   hexcolor  warna HEX (#fff / #ffffff), bawaan validator
+//   slug      huruf kecil, angka dan tanda hubung (contoh: belajar-golang)
+//   year      tahun YYYY atau YYYY-MM
+//   year_end  seperti year, atau "present"/"sekarang"
+//   yearrange=Field  tidak boleh lebih awal dari field tahun mulai (nama field Go)
+
+const (
+	LocaleID      = "id"
+	LocaleEN      = "en"
+	DefaultLocale = LocaleEN
+)
+
+var (
+	slugPattern   = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	yearPattern   = regexp.MustCompile(`^(\d{4})(?:-(0[1-9]|1[0-2]))?$`)
+	presentValues = map[string]bool{"present": true, "sekarang": true, "now": true}
+
+	registerOnce sync.Once
+)
+
+// RegisterValidators mendaftarkan validator custom ke engine binding gin.
+// Aman dipanggil berkali-kali.
+func RegisterValidators() {
+	registerOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		// Nama field di error mengikuti tag json/form, bukan nama struct Go
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			for _, tag := range []string{"json", "form"} {
+				name := strings.Split(field.Tag.Get(tag), ",")[0]
+				if name == "-" {
+					return ""
+				}
+				if name != "" {
+					return name
+				}
+			}
+			return field.Name
+		})
+
+		_ = v.RegisterValidation("httpurl", validateHTTPURL)
+		_ = v.RegisterValidation("slug", validateSlug)
+		_ = v.RegisterValidation("year", validateYear)
+		_ = v.RegisterValidation("year_end", validateYearEnd)
+		_ = v.RegisterValidation("yearrange", validateYearRange)
+	})
+}
+
+func validateHTTPURL(fl validator.FieldLevel) bool {
+	return IsHTTPURL(fl.Field().String())
+}
+
+func validateSlug(fl validator.FieldLevel) bool {
+	return IsSlug(fl.Field().String())
+}
+
+func validateYear(fl validator.FieldLevel) bool {
+	_, ok := ParseYearMonth(fl.Field().String())
+	return ok
+}
+
+func validateYearEnd(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if IsPresentValue(value) {
+		return true
+	}
+	_, ok := ParseYearMonth(value)
+	return ok
+}
+
+// validateYearRange hanya membandingkan jika kedua nilai berupa tahun yang
+// valid; format yang salah sudah ditangani rule year/year_end
+func validateYearRange(fl validator.FieldLevel) bool {
+	start, _, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !ok || start.Kind() != reflect.String {
+		return true
+	}
+	return YearRangeValid(start.String(), fl.Field().String())
+}
+
+func IsHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func IsSlug(value string) bool {
+	return len(value) <= 200 && slugPattern.MatchString(value)
+}
+
+func IsPresentValue(value string) bool {
+	return presentValues[strings.ToLower(strings.TrimSpace(value))]
+}
+
+// ParseYearMonth menerima "2021" atau "2021-06" dan mengembalikan nilai
+// yang bisa dibandingkan (tahun*12 + bulan-1)
+func ParseYearMonth(value string) (int, bool) {
+	match := yearPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, false
+	}
+	year, _ := strconv.Atoi(match[1])
+	if year < 1900 || year > time.Now().Year()+10 {
+		return 0, false
+	}
+	month := 1
+	if match[2] != "" {
+		month, _ = strconv.Atoi(match[2])
+	}
+	return year*12 + month - 1, true
+}
+
+// YearRangeValid memastikan end tidak lebih awal dari start
+func YearRangeValid(start, end string) bool {
+	from, ok := ParseYearMonth(start)
+	if !ok {
+		return true
+	}
+	to, ok := ParseYearMonth(end)
+	if !ok {
+		return true
+	}
+	if len(strings.TrimSpace(start)) != len(strings.TrimSpace(end)) {
+		// "2021" vs "2021-03": bandingkan per tahun saja
+		return to/12 >= from/12
+	}
+	return to >= from
+}
+
+// ============================
+// BINDING HELPERS
+// ============================
+
+// BindJSON pengganti ctx.ShouldBindJSON yang mengembalikan AppError
+func BindJSON(ctx *gin.Context, obj interface{}) error {
+	RegisterValidators()
+	if err := ctx.ShouldBindJSON(obj); err != nil {
+		return BindingError(err, RequestLocale(ctx))
+	}
+	return nil
+}
+
+// Bind pengganti ctx.ShouldBind (form-data / multipart)
+func Bind(ctx *gin.Context, obj interface{}) error {
+	RegisterValidators()
+	if err := ctx.ShouldBind(obj); err != nil {
+		return BindingError(err, RequestLocale(ctx))
+	}
+	return nil
+}
+
+// ValidateStruct menjalankan tag binding pada struct yang sudah terisi
+// (misalnya hasil merge sebelum disimpan)
+func ValidateStruct(ctx *gin.Context, obj interface{}) error {
+	RegisterValidators()
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		return BindingError(err, RequestLocale(ctx))
+	}
+	return nil
+}
+
+// FieldValidationError membuat AppError 422 untuk validasi manual di service
+func FieldValidationError(ctx *gin.Context, field, rule, param string) *AppError {
+	return ValidationFailed([]FieldError{{
+		Field:   field,
+		Rule:    rule,
+		Message: TranslateRule(RequestLocale(ctx), field, rule, param, reflect.String),
+	}})
+}
+
+// BindingError mengubah error dari binding gin menjadi AppError
+func BindingError(err error, locale string) error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			field := fieldPath(fe)
+			fields = append(fields, FieldError{
+				Field:   field,
+				Rule:    fe.Tag(),
+				Message: TranslateRule(locale, fe.Field(), fe.Tag(), fe.Param(), fe.Kind()),
+			})
+		}
+		return ValidationFailed(fields)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		field := typeErr.Field
+		if field == "" {
+			field = "body"
+		}
+		return ValidationFailed([]FieldError{{
+			Field:   field,
+			Rule:    "type",
+			Message: TranslateRule(locale, field, "type", typeErr.Type.String(), reflect.Invalid),
+		}})
+	}
+
+	var timeErr *time.ParseError
+	if errors.As(err, &timeErr) {
+		return BadRequestKey("invalid_date").WithCause(err)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return BadRequestKey("invalid_json").WithCause(err)
+	}
+
+	// Sisanya (misalnya strconv dari query/form) tidak dikirim apa adanya
+	return BadRequestKey("invalid_value").WithCause(err)
+}
+
+// fieldPath membuang nama struct di depan namespace:
+// "ExperienceRequest.responsibilities[0].description" -> "responsibilities[0].description"
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+// ============================
+// TRANSLATIONS
+// ============================
+
+// RequestLocale memilih id/en untuk pesan validasi. Locale konten yang
+// diminta eksplisit (?lang= atau Accept-Language) didahulukan; selain itu
+// Accept-Language (menghormati q-value) dengan fallback bahasa Inggris.
+func RequestLocale(ctx *gin.Context) string {
+	if content, ok := GetContentLocale(ctx); ok && content.Source != LocaleSourceDefault {
+		if content.Locale == LocaleEN || content.Locale == LocaleID {
+			return content.Locale
+		}
+	}
+	return PreferredLocale(ctx.GetHeader("Accept-Language"), []string{LocaleEN, LocaleID}, DefaultLocale)
+}
+
+// PreferredLocale mem-parsing Accept-Language, misalnya "id-ID,id;q=0.9,en;q=0.8"
+func PreferredLocale(header string, supported []string, fallback string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		lang := strings.ToLower(strings.Split(tag, "-")[0])
+		candidates = append(candidates, candidate{lang: lang, q: q})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	for _, c := range candidates {
+		for _, s := range supported {
+			if c.lang == s && c.q > 0 {
+				return s
+			}
+		}
+	}
+	return fallback
+}
+
+var ruleMessages = map[string]map[string]string{
+	LocaleEN: {
+		"required":     "{field} is required",
+		"required_if":  "{field} is required when {param}",
+		"min.string":   "{field} must be at least {param} characters",
+		"max.string":   "{field} must be at most {param} characters",
+		"min.slice":    "{field} must contain at least {param} items",
+		"max.slice":    "{field} must contain at most {param} items",
+		"min":          "{field} must be at least {param}",
+		"max":          "{field} must be at most {param}",
+		"gte":          "{field} must be at least {param}",
+		"lte":          "{field} must be at most {param}",
+		"len":          "{field} must be {param} characters long",
+		"email":        "{field} must be a valid email address",
+		"uuid":         "{field} must be a valid UUID",
+		"oneof":        "{field} must be one of: {param}",
+		"url":          "{field} must be a valid URL",
+		"httpurl":      "{field} must be a valid http(s) URL",
+		"hexcolor":     "{field} must be a HEX color such as #1a2b3c",
+		"slug":         "{field} may only contain lowercase letters, numbers and hyphens",
+		"year":         "{field} must be a year (YYYY or YYYY-MM)",
+		"year_end":     "{field} must be a year (YYYY or YYYY-MM) or \"present\"",
+		"yearrange":    "{field} must not be earlier than the start year",
+		"type":         "{field} must be of type {param}",
+		"invalid_json": "request body must be valid JSON",
+		"invalid_date": "invalid date format, use RFC 3339 (2006-01-02T15:04:05Z)",
+		"default":      "{field} is invalid ({rule})",
+
+		// pesan AppError (lihat LocalizedError)
+		"not_found":             "{resource} not found",
+		"invalid_id":            "invalid {resource} ID",
+		"internal_error":        "internal server error",
+		"validation_failed":     "validation failed",
+		"resource_exists":       "resource already exists",
+		"resource_exists_field": "resource with the same {field} already exists",
+		"resource_referenced":   "resource is still referenced or references a missing resource",
+		"invalid_value":         "invalid value",
+		"project_tag_exists":    "tag is already added to this project",
+		"unauthorized":          "authentication is required",
+		"forbidden":             "you do not have access to this resource",
+		"conflict":              "request conflicts with the current state of the resource",
+		"too_many_requests":     "too many requests, try again later",
+		"bad_request":           "request could not be processed",
+
+		// aturan di luar tag binding, dipakai lewat BadRequestKey
+		"range":          "{field} must be between {min} and {max}",
+		"date_ymd":       "{field} must use the YYYY-MM-DD format",
+		"file_required":  "{field} file is required",
+		"file_too_large": "file must be at most {max}MB",
+		"file_type":      "file type is not allowed, allowed types: {param}",
+
+		// blog series
+		"id_duplicate": "ID {id} is listed more than once",
+
+		// tag blog
+		"tag_exists":     "tag \"{name}\" already exists, use merge to combine tags",
+		"tag_merge_self": "the target tag must not be listed in source_ids",
+
+		// list query (sort, filter, cursor)
+		"invalid_field":        "{field} is invalid",
+		"sort_unsupported":     "sorting by {field} is not supported",
+		"filter_unsupported":   "filtering by {field} is not supported",
+		"filter_invalid":       "invalid value for filter {field}",
+		"cursor_sort_mismatch": "cursor does not match the sort parameter",
+
+		// pencarian
+		"unsupported_value": "{field} {value} is not supported",
+
+		// JSON merge patch
+		"read_body_failed":       "failed to read request body",
+		"merge_patch_object":     "merge patch must be a JSON object",
+		"merge_patch_media_type": "PATCH requires Content-Type {param}",
+
+		// optimistic concurrency
+		"precondition_failed": "resource has been modified by another request, reload and try again",
+
+		// reorder
+		"id_unknown":  "unknown ID {id}",
+		"id_missing":  "missing ID {id}",
+		"reorder_set": "ids must contain every existing ID exactly once",
+
+		// spam protection form publik
+		"invalid_form":         "invalid form data",
+		"payload_too_large":    "request body is too large",
+		"form_token_invalid":   "invalid form token, reload the form",
+		"form_token_expired":   "form token has expired, reload the form",
+		"form_too_fast":        "form was submitted too quickly, try again",
+		"too_many_submissions": "too many submissions, try again later",
+		"too_many_links":       "message contains too many links",
+		"captcha_failed":       "CAPTCHA verification failed",
+
+		// avatar testimonial
+		"file_read_failed": "failed to read the {field} file",
+		"image_required":   "{field} must be an image",
+
+		// invite testimonial
+		"invite_unavailable": "invite has already been used or is no longer valid",
+
+		// settings dan json_schema
+		"gt":                 "{field} must be greater than {param}",
+		"lt":                 "{field} must be less than {param}",
+		"json":               "{field} must be valid JSON",
+		"setting_key":        "{field} may only contain lowercase letters, numbers, '_', '.' and '-'",
+		"schema_data_type":   "{field} is only supported for data_type json",
+		"schema_mismatch":    "value does not match json_schema",
+		"schema_object":      "{field}: {path} must be a JSON schema object",
+		"schema_regex":       "{field}: {path} is not a valid regular expression",
+		"schema_enum":        "{field} must be one of the allowed values",
+		"schema_const":       "{field} must equal the constant value",
+		"schema_pattern":     "{field} must match pattern {param}",
+		"schema_multiple_of": "{field} must be a multiple of {param}",
+		"schema_unique":      "{field} must not contain duplicate items",
+		"schema_additional":  "{field} is not allowed",
+		"schema_any_of":      "{field} must match at least one of the allowed schemas",
+		"schema_one_of":      "{field} must match exactly one of the allowed schemas",
+		"schema_not":         "{field} must not match the disallowed schema",
+
+		// URL social link
+		"url_mailto_platform": "{field} must start with mailto: for {platform}",
+		"url_mailto":          "{field} must be mailto: followed by a valid email address",
+		"url_tel":             "{field} must be tel: followed by a phone number, e.g. tel:+628123456789",
+		"url_platform_host":   "{field} for {platform} must start with https://{host}/",
+		"url_whatsapp":        "{field} for {platform} must be https://wa.me/ followed by the phone number in international format",
+
+		// terjemahan konten
+		"translation_default_locale": "{field} {param} is the default locale, edit the base content instead",
+		"translation_no_locale":      "supported_locales has no locale other than default_locale",
+
+		// rentang tanggal
+		"date_ongoing":                 "{field} must be empty when is_ongoing is true",
+		"date_precision":               "{field} must use the same precision as {param}",
+		"date_required_unless_ongoing": "{field} is required unless is_ongoing is true",
+
+		// import JSON Resume
+		"resume_date":         "{field} must be YYYY-MM-DD, YYYY-MM or YYYY",
+		"resume_duplicate":    "{field} is listed more than once in the document",
+		"resume_setting_type": "setting {key} has data_type {data_type} and cannot be imported from {field}",
+		"resume_skill_level":  "{field} must be a number between 0 and 100 or one of Beginner, Intermediate, Advanced, Master",
+	},
+	LocaleID: {
+		"required":     "{field} wajib diisi",
+		"required_if":  "{field} wajib diisi jika {param}",
+		"min.string":   "{field} minimal {param} karakter",
+		"max.string":   "{field} maksimal {param} karakter",
+		"min.slice":    "{field} minimal berisi {param} item",
+		"max.slice":    "{field} maksimal berisi {param} item",
+		"min":          "{field} minimal {param}",
+		"max":          "{field} maksimal {param}",
+		"gte":          "{field} minimal {param}",
+		"lte":          "{field} maksimal {param}",
+		"len":          "{field} harus {param} karakter",
+		"email":        "{field} harus berupa alamat email yang valid",
+		"uuid":         "{field} harus berupa UUID yang valid",
+		"oneof":        "{field} harus salah satu dari: {param}",
+		"url":          "{field} harus berupa URL yang valid",
+		"httpurl":      "{field} harus berupa URL http(s) yang valid",
+		"hexcolor":     "{field} harus berupa warna HEX, contoh #1a2b3c",
+		"slug":         "{field} hanya boleh berisi huruf kecil, angka dan tanda hubung",
+		"year":         "{field} harus berupa tahun (YYYY atau YYYY-MM)",
+		"year_end":     "{field} harus berupa tahun (YYYY atau YYYY-MM) atau \"sekarang\"",
+		"yearrange":    "{field} tidak boleh lebih awal dari tahun mulai",
+		"type":         "{field} harus bertipe {param}",
+		"invalid_json": "body request harus berupa JSON yang valid",
+		"invalid_date": "format tanggal tidak valid, gunakan RFC 3339 (2006-01-02T15:04:05Z)",
+		"default":      "{field} tidak valid ({rule})",
+
+		// pesan AppError (lihat LocalizedError)
+		"not_found":             "{resource} tidak ditemukan",
+		"invalid_id":            "ID {resource} tidak valid",
+		"internal_error":        "terjadi kesalahan pada server",
+		"validation_failed":     "validasi gagal",
+		"resource_exists":       "data sudah ada",
+		"resource_exists_field": "data dengan {field} yang sama sudah ada",
+		"resource_referenced":   "data masih dipakai atau merujuk ke data yang tidak ada",
+		"invalid_value":         "nilai tidak valid",
+		"project_tag_exists":    "tag sudah ditambahkan ke project ini",
+		"unauthorized":          "autentikasi diperlukan",
+		"forbidden":             "tidak punya akses ke data ini",
+		"conflict":              "request bentrok dengan kondisi data saat ini",
+		"too_many_requests":     "terlalu banyak request, coba lagi nanti",
+		"bad_request":           "request tidak dapat diproses",
+
+		// aturan di luar tag binding, dipakai lewat BadRequestKey
+		"range":          "{field} harus antara {min}-{max}",
+		"date_ymd":       "{field} harus berformat YYYY-MM-DD",
+		"file_required":  "file {field} wajib diupload",
+		"file_too_large": "ukuran file maksimal {max}MB",
+		"file_type":      "tipe file tidak diizinkan, file yang diizinkan: {param}",
+
+		// blog series
+		"id_duplicate": "ID {id} muncul lebih dari sekali",
+
+		// tag blog
+		"tag_exists":     "tag \"{name}\" sudah ada, gunakan merge untuk menggabungkan tag",
+		"tag_merge_self": "tag tujuan tidak boleh termasuk di source_ids",
+
+		// list query (sort, filter, cursor)
+		"invalid_field":        "{field} tidak valid",
+		"sort_unsupported":     "sort tidak didukung untuk field {field}",
+		"filter_unsupported":   "filter tidak didukung untuk field {field}",
+		"filter_invalid":       "nilai filter {field} tidak valid",
+		"cursor_sort_mismatch": "cursor tidak cocok dengan parameter sort",
+
+		// pencarian
+		"unsupported_value": "{field} {value} tidak didukung",
+
+		// JSON merge patch
+		"read_body_failed":       "gagal membaca body request",
+		"merge_patch_object":     "merge patch harus berupa object JSON",
+		"merge_patch_media_type": "PATCH membutuhkan Content-Type {param}",
+
+		// optimistic concurrency
+		"precondition_failed": "data sudah diubah oleh request lain, muat ulang lalu coba lagi",
+
+		// reorder
+		"id_unknown":  "ID {id} tidak dikenal",
+		"id_missing":  "ID {id} belum ada di daftar",
+		"reorder_set": "ids harus berisi setiap ID yang ada tepat satu kali",
+
+		// spam protection form publik
+		"invalid_form":         "data form tidak valid",
+		"payload_too_large":    "body request terlalu besar",
+		"form_token_invalid":   "form token tidak valid, muat ulang form",
+		"form_token_expired":   "form token kedaluwarsa, muat ulang form",
+		"form_too_fast":        "form dikirim terlalu cepat, coba lagi",
+		"too_many_submissions": "terlalu banyak submit, coba lagi nanti",
+		"too_many_links":       "pesan berisi terlalu banyak link",
+		"captcha_failed":       "verifikasi CAPTCHA gagal",
+
+		// avatar testimonial
+		"file_read_failed": "gagal membaca file {field}",
+		"image_required":   "{field} harus berupa gambar",
+
+		// invite testimonial
+		"invite_unavailable": "invite sudah dipakai atau tidak berlaku lagi",
+
+		// settings dan json_schema
+		"gt":                 "{field} harus lebih besar dari {param}",
+		"lt":                 "{field} harus lebih kecil dari {param}",
+		"json":               "{field} harus berupa JSON yang valid",
+		"setting_key":        "{field} hanya boleh berisi huruf kecil, angka, '_', '.' dan '-'",
+		"schema_data_type":   "{field} hanya didukung untuk data_type json",
+		"schema_mismatch":    "value tidak sesuai json_schema",
+		"schema_object":      "{field}: {path} harus berupa object JSON schema",
+		"schema_regex":       "{field}: {path} bukan regular expression yang valid",
+		"schema_enum":        "{field} harus salah satu nilai yang diizinkan",
+		"schema_const":       "{field} harus sama dengan nilai konstan",
+		"schema_pattern":     "{field} harus cocok dengan pola {param}",
+		"schema_multiple_of": "{field} harus kelipatan {param}",
+		"schema_unique":      "{field} tidak boleh berisi item duplikat",
+		"schema_additional":  "{field} tidak diizinkan",
+		"schema_any_of":      "{field} harus cocok dengan minimal satu schema yang diizinkan",
+		"schema_one_of":      "{field} harus cocok dengan tepat satu schema yang diizinkan",
+		"schema_not":         "{field} tidak boleh cocok dengan schema yang dilarang",
+
+		// URL social link
+		"url_mailto_platform": "{field} untuk {platform} harus diawali mailto:",
+		"url_mailto":          "{field} harus berupa mailto: diikuti alamat email yang valid",
+		"url_tel":             "{field} harus berupa tel: diikuti nomor telepon, contoh tel:+628123456789",
+		"url_platform_host":   "{field} untuk {platform} harus diawali https://{host}/",
+		"url_whatsapp":        "{field} untuk {platform} harus berupa https://wa.me/ diikuti nomor telepon format internasional",
+
+		// terjemahan konten
+		"translation_default_locale": "{field} {param} adalah locale default, ubah konten dasarnya saja",
+		"translation_no_locale":      "tidak ada locale selain default_locale di supported_locales",
+
+		// rentang tanggal
+		"date_ongoing":                 "{field} harus kosong jika is_ongoing bernilai true",
+		"date_precision":               "{field} harus memakai presisi yang sama dengan {param}",
+		"date_required_unless_ongoing": "{field} wajib diisi kecuali is_ongoing bernilai true",
+
+		// import JSON Resume
+		"resume_date":         "{field} harus berformat YYYY-MM-DD, YYYY-MM atau YYYY",
+		"resume_duplicate":    "{field} muncul lebih dari sekali di dokumen",
+		"resume_setting_type": "setting {key} bertipe {data_type} dan tidak bisa diimpor dari {field}",
+		"resume_skill_level":  "{field} harus angka 0-100 atau salah satu dari Beginner, Intermediate, Advanced, Master",
+	},
+}
+
+// TranslateRule membuat pesan untuk satu rule validasi
+func TranslateRule(locale, field, rule, param string, kind reflect.Kind) string {
+	messages, ok := ruleMessages[locale]
+	if !ok {
+		messages = ruleMessages[DefaultLocale]
+	}
+
+	template := ""
+	switch kind {
+	case reflect.String:
+		template = messages[rule+".string"]
+	case reflect.Slice, reflect.Array, reflect.Map:
+		template = messages[rule+".slice"]
+	}
+	if template == "" {
+		template = messages[rule]
+	}
+	if template == "" {
+		template = messages["default"]
+	}
+
+	switch rule {
+	case "oneof":
+		param = strings.Join(strings.Fields(param), ", ")
+	case "required_if":
+		// "Status rejected" -> "status = rejected"
+		if parts := strings.Fields(param); len(parts) == 2 {
+			param = strings.ToLower(parts[0]) + " = " + parts[1]
+		}
+	}
+
+	return strings.NewReplacer("{field}", field, "{param}", param, "{rule}", rule).Replace(template)
+}
+
+func translate(locale, key string) string {
+	if message, ok := ruleMessages[locale][key]; ok {
+		return message
+	}
+	return ruleMessages[DefaultLocale][key]
+}
+
+// renderMessage mengisi template katalog dengan pasangan nama/nilai params,
+// misalnya renderMessage("id", "not_found", []string{"resource", "tag"})
+func renderMessage(locale, key string, params []string) string {
+	template := translate(locale, key)
+	if template == "" {
+		template = key
+	}
+	pairs := make([]string, 0, len(params))
+	for i := 0; i+1 < len(params); i += 2 {
+		pairs = append(pairs, "{"+params[i]+"}", params[i+1])
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}